@@ -63,6 +63,8 @@ func main() {
 	)
 	must.NotFail(ctxd.WrapError(ctx, err, "failed to init locator"))
 
+	deps.StartWorkers(ctx)
+
 	err = logicalservices.RunServices(ctx, deps.Locator)
 	must.NotFail(ctxd.WrapError(ctx, err, "failed to start the services"))
 }
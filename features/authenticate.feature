@@ -0,0 +1,220 @@
+Feature: Authenticate user
+  As a user, I want to authenticate with my email and password, so I can obtain a session to access the service.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "users" of database "postgres":
+      | id                                   | first_name | last_name | nickname | password_hash                                                                                     | email         | country |
+      | 26ef0140-c436-4838-a271-32652c72f6f2 | Alice      | Bob       |          | $argon2id$v=19$m=8192,t=1,p=2$tyNc+tK9PPpBPQQx1dFRNg$Gqs+6aUOs+gSIEnYfYb8/9FGZM4fTdumsisweLo8kXA | alice@bob.com | UK      |
+
+  Scenario: Authenticate successfully
+    When I request HTTP endpoint with method "POST" and URI "/v1/sessions"
+    And I request HTTP endpoint with body
+    """
+    {
+      "email": "alice@bob.com",
+      "password": "s3cr3t-passw0rd"
+    }
+    """
+
+    Then I should have response with status "OK"
+    And I should have response with body like
+    """
+    {
+      "access_token": "<ignore-diff>",
+      "token_type": "Bearer",
+      "expires_in": 900,
+      "refresh_token": "<ignore-diff>",
+      "refresh_expires_in": 2592000
+    }
+    """
+    And these rows are available in table "refresh_tokens" of database "postgres"
+      | user_id                              | revoked_at |
+      | 26ef0140-c436-4838-a271-32652c72f6f2 | NULL       |
+
+
+  Scenario: Authenticate failed, invalid credentials
+    When I request HTTP endpoint with method "POST" and URI "/v1/sessions"
+    And I request HTTP endpoint with body
+    """
+    {
+      "email": "alice@bob.com",
+      "password": "wrong-passw0rd"
+    }
+    """
+
+    Then I should have response with status "Unauthorized"
+    And I should have response with body like
+    """
+    {
+      "code": 401,
+      "message": "invalid credentials",
+      "error": "<ignore-diff>"
+    }
+    """
+    And no rows in table "refresh_tokens" of database "postgres"
+    And these rows are available in table "login_attempts" of database "postgres"
+      | email         | failures |
+      | alice@bob.com | 1        |
+
+
+  Scenario: Authenticate failed, locked out after repeated failures
+    Given these rows are stored in table "login_attempts" of database "postgres":
+      | email         | failures |
+      | alice@bob.com | 2        |
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/sessions"
+    And I request HTTP endpoint with body
+    """
+    {
+      "email": "alice@bob.com",
+      "password": "wrong-passw0rd"
+    }
+    """
+
+    Then I should have response with status "Unauthorized"
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/sessions"
+    And I request HTTP endpoint with body
+    """
+    {
+      "email": "alice@bob.com",
+      "password": "s3cr3t-passw0rd"
+    }
+    """
+
+    Then I should have response with status "Too Many Requests"
+    And I should have response with body like
+    """
+    {
+      "code": 429,
+      "message": "too many failed logins, try again later",
+      "error": "<ignore-diff>"
+    }
+    """
+    And no rows in table "refresh_tokens" of database "postgres"
+
+
+  Scenario: Refresh session and reuse the rotated refresh token
+    When I request HTTP endpoint with method "POST" and URI "/v1/sessions"
+    And I request HTTP endpoint with body
+    """
+    {
+      "email": "alice@bob.com",
+      "password": "s3cr3t-passw0rd"
+    }
+    """
+
+    Then I should have response with status "OK"
+    And I should have response with body like
+    """
+    {
+      "access_token": "<ignore-diff>",
+      "token_type": "Bearer",
+      "expires_in": 900,
+      "refresh_token": "$refresh_token",
+      "refresh_expires_in": 2592000
+    }
+    """
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/sessions:refresh"
+    And I request HTTP endpoint with body
+    """
+    {
+      "refresh_token": "$refresh_token"
+    }
+    """
+
+    Then I should have response with status "OK"
+    And I should have response with body like
+    """
+    {
+      "access_token": "<ignore-diff>",
+      "token_type": "Bearer",
+      "expires_in": 900,
+      "refresh_token": "<ignore-diff>",
+      "refresh_expires_in": 2592000
+    }
+    """
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/sessions:refresh"
+    And I request HTTP endpoint with body
+    """
+    {
+      "refresh_token": "$refresh_token"
+    }
+    """
+
+    Then I should have response with status "Unauthorized"
+    And I should have response with body like
+    """
+    {
+      "code": 401,
+      "message": "invalid refresh token",
+      "error": "<ignore-diff>"
+    }
+    """
+
+
+  Scenario: Revoke session
+    When I request HTTP endpoint with method "POST" and URI "/v1/sessions"
+    And I request HTTP endpoint with body
+    """
+    {
+      "email": "alice@bob.com",
+      "password": "s3cr3t-passw0rd"
+    }
+    """
+
+    Then I should have response with status "OK"
+    And I should have response with body like
+    """
+    {
+      "access_token": "<ignore-diff>",
+      "token_type": "Bearer",
+      "expires_in": 900,
+      "refresh_token": "$refresh_token",
+      "refresh_expires_in": 2592000
+    }
+    """
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/sessions:revoke"
+    And I request HTTP endpoint with body
+    """
+    {
+      "refresh_token": "$refresh_token"
+    }
+    """
+
+    Then I should have response with status "No Content"
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/sessions:refresh"
+    And I request HTTP endpoint with body
+    """
+    {
+      "refresh_token": "$refresh_token"
+    }
+    """
+
+    Then I should have response with status "Unauthorized"
+
+
+  Scenario: Get the keys verifying the access tokens
+    When I request HTTP endpoint with method "GET" and URI "/.well-known/jwks.json"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "keys": [
+        {
+          "kty": "OKP",
+          "crv": "Ed25519",
+          "x": "JbSGomoSy2LUBmd8xQKSmVx9_1Q8CJ74LU5rMF6GIBg",
+          "kid": "kRgMb4LePeXRlwzfJIiPyTcniuJCGRUGEqQ3CsVNgbs",
+          "use": "sig",
+          "alg": "EdDSA"
+        }
+      ]
+    }
+    """
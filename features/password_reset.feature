@@ -0,0 +1,170 @@
+Feature: Reset password
+  As a user, I want to reset my password when I forget it, so I can recover the access to my account.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "users" of database "postgres":
+      | id                                   | first_name | last_name | nickname | password_hash                                                                                     | email         | country |
+      | 26ef0140-c436-4838-a271-32652c72f6f2 | Alice      | Bob       |          | $argon2id$v=19$m=8192,t=1,p=2$tyNc+tK9PPpBPQQx1dFRNg$Gqs+6aUOs+gSIEnYfYb8/9FGZM4fTdumsisweLo8kXA | alice@bob.com | UK      |
+
+  Scenario: Request password reset successfully
+    When I request HTTP endpoint with method "POST" and URI "/v1/users:requestPasswordReset"
+    And I request HTTP endpoint with body
+    """
+    {
+      "email": "Alice@Bob.com"
+    }
+    """
+
+    Then I should have response with status "No Content"
+    And a password reset email is sent to "alice@bob.com"
+    And these rows are available in table "password_reset_tokens" of database "postgres"
+      | user_id                              | used_at |
+      | 26ef0140-c436-4838-a271-32652c72f6f2 | NULL    |
+
+  Scenario: Request password reset of an unknown email, the response does not tell the email is unknown
+    When I request HTTP endpoint with method "POST" and URI "/v1/users:requestPasswordReset"
+    And I request HTTP endpoint with body
+    """
+    {
+      "email": "carol@dan.com"
+    }
+    """
+
+    Then I should have response with status "No Content"
+    And no email is sent
+    And no rows in table "password_reset_tokens" of database "postgres"
+
+  Scenario: Request password reset failed, too many requests for the email
+    When I request HTTP endpoint with method "POST" and URI "/v1/users:requestPasswordReset"
+    And I request HTTP endpoint with body
+    """
+    {
+      "email": "alice@bob.com"
+    }
+    """
+
+    Then I should have response with status "No Content"
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/users:requestPasswordReset"
+    And I request HTTP endpoint with body
+    """
+    {
+      "email": "alice@bob.com"
+    }
+    """
+
+    Then I should have response with status "No Content"
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/users:requestPasswordReset"
+    And I request HTTP endpoint with body
+    """
+    {
+      "email": "alice@bob.com"
+    }
+    """
+
+    Then I should have response with status "Too Many Requests"
+    And I should have response with body like
+    """
+    {
+      "code": 429,
+      "message": "too many password resets requested, try again later",
+      "error": "<ignore-diff>"
+    }
+    """
+
+  Scenario: Reset password successfully
+    Given these rows are stored in table "password_reset_tokens" of database "postgres":
+      | token_hash                                                       | user_id                              | created_at           | expires_at           | used_at |
+      | cdcf58eaa08233ab71eb59c65fd4d717b4542ad73357e511b00e7707652dd35b | 26ef0140-c436-4838-a271-32652c72f6f2 | 2024-12-22T09:00:00Z | 2099-12-22T10:00:00Z | NULL    |
+    And these rows are stored in table "refresh_tokens" of database "postgres":
+      | token_hash                                                       | family_id                            | user_id                              | created_at           | expires_at           | revoked_at |
+      | 74c7dc9ca99f12c9a942e992ccaa0340d77996fa7d3a723e0346da844b2fc9f5 | 1b1d5ae4-2b35-4b64-9d5c-4f6a2b0b8f0e | 26ef0140-c436-4838-a271-32652c72f6f2 | 2024-12-22T09:00:00Z | 2099-12-22T09:00:00Z | NULL       |
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/users:resetPassword"
+    And I request HTTP endpoint with body
+    """
+    {
+      "token": "integration-test-password-reset-token",
+      "password": "n3w-s3cr3t-passw0rd"
+    }
+    """
+
+    Then I should have response with status "No Content"
+    And these rows are available in table "outbox" of database "postgres"
+      | user_id                              | event_type          |
+      | 26ef0140-c436-4838-a271-32652c72f6f2 | user.password_reset |
+    And the "com.faceit.user.password_reset.v1" event of user "26ef0140-c436-4838-a271-32652c72f6f2" is published
+
+    # The sessions of the user are revoked.
+    When I request HTTP endpoint with method "POST" and URI "/v1/sessions:refresh"
+    And I request HTTP endpoint with body
+    """
+    {
+      "refresh_token": "integration-test-refresh-token"
+    }
+    """
+
+    Then I should have response with status "Unauthorized"
+
+    # The user authenticates with the new password.
+    When I request HTTP endpoint with method "POST" and URI "/v1/sessions"
+    And I request HTTP endpoint with body
+    """
+    {
+      "email": "alice@bob.com",
+      "password": "n3w-s3cr3t-passw0rd"
+    }
+    """
+
+    Then I should have response with status "OK"
+
+    # Tokens are valid once.
+    When I request HTTP endpoint with method "POST" and URI "/v1/users:resetPassword"
+    And I request HTTP endpoint with body
+    """
+    {
+      "token": "integration-test-password-reset-token",
+      "password": "an0ther-s3cr3t-passw0rd"
+    }
+    """
+
+    Then I should have response with status "Bad Request"
+
+  Scenario: Reset password failed, expired token
+    Given these rows are stored in table "password_reset_tokens" of database "postgres":
+      | token_hash                                                       | user_id                              | created_at           | expires_at           | used_at |
+      | cdcf58eaa08233ab71eb59c65fd4d717b4542ad73357e511b00e7707652dd35b | 26ef0140-c436-4838-a271-32652c72f6f2 | 2024-12-22T09:00:00Z | 2024-12-22T10:00:00Z | NULL    |
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/users:resetPassword"
+    And I request HTTP endpoint with body
+    """
+    {
+      "token": "integration-test-password-reset-token",
+      "password": "n3w-s3cr3t-passw0rd"
+    }
+    """
+
+    Then I should have response with status "Bad Request"
+    And I should have response with body like
+    """
+    {
+      "code": 400,
+      "message": "invalid password reset token",
+      "error": "<ignore-diff>"
+    }
+    """
+    And no rows in table "outbox" of database "postgres"
+
+  Scenario: Reset password failed, password too short
+    When I request HTTP endpoint with method "POST" and URI "/v1/users:resetPassword"
+    And I request HTTP endpoint with body
+    """
+    {
+      "token": "integration-test-password-reset-token",
+      "password": "short"
+    }
+    """
+
+    Then I should have response with status "Bad Request"
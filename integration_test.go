@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/bool64/ctxd"
 	"github.com/cucumber/godog"
 	"github.com/dohernandez/faceit/internal/domain/model"
 	"github.com/dohernandez/faceit/internal/platform/app"
 	"github.com/dohernandez/faceit/internal/platform/config"
+	"github.com/dohernandez/faceit/internal/platform/notifier"
 	"github.com/dohernandez/faceit/internal/platform/storage"
 	service "github.com/dohernandez/go-grpc-service"
 	sapp "github.com/dohernandez/go-grpc-service/app"
@@ -39,6 +42,14 @@ func TestIntegration(t *testing.T) {
 	cfg.Environment = "test"
 	cfg.Logger.Output = io.Discard
 
+	// initialize the SMTP server receiving the verification emails
+	smtpServer, err := notifier.NewLocalSMTPServer()
+	must.NotFail(ctxd.WrapError(ctx, err, "failed to init SMTP server"))
+
+	defer smtpServer.Close() //nolint:errcheck
+
+	cfg.Mailer.SMTPAddr = smtpServer.Addr()
+
 	// initialize listeners
 	grpcListener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.AppGRPCPort))
 	must.NotFail(ctxd.WrapError(ctx, err, "failed to init GRPC service listener"))
@@ -66,14 +77,93 @@ func TestIntegration(t *testing.T) {
 	)
 	must.NotFail(ctxd.WrapError(ctx, err, "failed to init service locator"))
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	deps.StartWorkers(ctx)
+
 	service.RunFeatures(t, ctx, &service.FeaturesConfig{
 		FeaturePath: "features",
 		Locator:     deps.Locator,
-		FeatureContextFunc: func(_ *testing.T, _ *godog.ScenarioContext) {
+		FeatureContextFunc: func(_ *testing.T, s *godog.ScenarioContext) {
+			s.Before(func(ctx context.Context, _ *godog.Scenario) (context.Context, error) {
+				deps.InProcessBroker.Reset()
+				smtpServer.Reset()
+
+				return ctx, nil
+			})
+
 			// Add step definitions
+			s.Step(`^the "([^"]*)" event of user "([^"]*)" is published$`, func(eventType, userID string) error {
+				return eventPublished(deps.InProcessBroker, eventType, userID)
+			})
+			s.Step(`^a verification email is sent to "([^"]*)"$`, func(email string) error {
+				return emailSent(smtpServer, email, "Verify your email")
+			})
+			s.Step(`^a password reset email is sent to "([^"]*)"$`, func(email string) error {
+				return emailSent(smtpServer, email, "Reset your password")
+			})
+			s.Step(`^no email is sent$`, func() error {
+				return noEmailSent(smtpServer)
+			})
 		},
 		Tables: map[string]any{
-			storage.UserTable: new(model.User),
+			storage.UserTable:   new(model.User),
+			storage.OutboxTable: new(storage.OutboxEvent),
+
+			storage.RefreshTokenTable: new(storage.RefreshToken),
+			storage.LoginAttemptTable: new(storage.LoginAttempts),
+			storage.UserRoleTable:     new(storage.UserRole),
+
+			storage.VerificationTokenTable:  new(storage.VerificationToken),
+			storage.PasswordResetTokenTable: new(storage.PasswordResetToken),
+			storage.RateLimitTable:          new(storage.RateLimit),
+
+			storage.ErasureCertificateTable: new(storage.ErasureCertificate),
+			storage.AuditTable:              new(storage.AuditEvent),
+
+			storage.IdempotencyKeyTable: new(storage.IdempotencyKey),
 		},
 	})
 }
+
+// eventPublished waits for the event of the user to be published to the broker.
+func eventPublished(b *notifier.InProcessBroker, eventType, userID string) error {
+	deadline := time.Now().Add(5 * time.Second)
+
+	for {
+		for _, m := range b.Messages() {
+			if string(m.Key) == userID && m.Headers[notifier.BrokerHeaderPrefix+"type"] == eventType {
+				return nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("event %q of user %q not published", eventType, userID) //nolint:err113
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// emailSent checks the email with the subject and a token link was sent to the address, the emails are sent once
+// the tokens are stored.
+func emailSent(srv *notifier.LocalSMTPServer, email, subject string) error {
+	for _, m := range srv.Mails() {
+		if len(m.To) == 1 && m.To[0] == email && m.Message.Header.Get("Subject") == subject &&
+			strings.Contains(m.Body, "token=") {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%q email not sent to %q", subject, email) //nolint:err113
+}
+
+// noEmailSent checks no email was sent.
+func noEmailSent(srv *notifier.LocalSMTPServer) error {
+	if mails := srv.Mails(); len(mails) > 0 {
+		return fmt.Errorf("%d emails sent, none expected", len(mails)) //nolint:err113
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package model
+
+import "time"
+
+// AuditAction represents the mutation of a user recorded by an audit entry.
+type AuditAction string
+
+// Audit actions.
+const (
+	AuditUserAdded    AuditAction = "user.added"
+	AuditUserUpdated  AuditAction = "user.updated"
+	AuditUserDeleted  AuditAction = "user.deleted"
+	AuditUserRestored AuditAction = "user.restored"
+	AuditUserErased   AuditAction = "user.erased"
+	AuditUserPurged   AuditAction = "user.purged"
+
+	AuditUserSuspended  AuditAction = "user.suspended"
+	AuditUserBanned     AuditAction = "user.banned"
+	AuditUserReinstated AuditAction = "user.reinstated"
+
+	AuditUserEmailVerified    AuditAction = "user.email_verified"
+	AuditUserPasswordReset    AuditAction = "user.password_reset"
+	AuditUserPasswordRehashed AuditAction = "user.password_rehashed"
+)
+
+// RedactedValue stands for the values of the credentials in the audit entries, only their change is recorded.
+const RedactedValue = "[REDACTED]"
+
+// RequestInfo holds the information of the request a mutation is performed on.
+type RequestInfo struct {
+	ID       string // ID of the request, given by the client or generated
+	SourceIP string // Address of the client, empty when unknown
+}
+
+// AuditEntry represents the record of a mutation of a user, the entries are append-only.
+type AuditEntry struct {
+	ID        int64                  // Unique id of the entry, increasing in the order the entries are recorded
+	UserID    UserID                 // User mutated
+	Action    AuditAction            // Mutation performed
+	Actor     string                 // Subject of the actor of the mutation
+	RequestID string                 // ID of the request of the mutation
+	SourceIP  string                 // Address of the client of the request, empty when unknown
+	Changes   map[string]AuditChange // Changes of the attributes of the user, by attribute
+	CreatedAt time.Time              // Time the mutation was recorded
+}
+
+// AuditChange represents the change of an attribute of a user.
+type AuditChange struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// AuditFilter represents the filter of the audit entries, the zero value matches all the entries.
+type AuditFilter struct {
+	UserID UserID    // User mutated, any when uuid.Nil
+	Actor  string    // Subject of the actor, any when empty
+	Since  time.Time // Earliest time of the entries, inclusive, unbounded when zero
+	Until  time.Time // Latest time of the entries, exclusive, unbounded when zero
+}
+
+// AuditPage is a page of a list of audit entries.
+type AuditPage struct {
+	Entries []*AuditEntry
+	Next    Cursor // Position of the last entry to continue the list after, nil on the last page
+}
+
+// DiffUserState returns the changes of the attributes from the state before to the state after, by attribute.
+//
+// The zero state stands for a user not existing, before it is added or after it is deleted. The password hash is
+// redacted, the change is recorded without its values.
+func DiffUserState(before, after UserState) map[string]AuditChange {
+	changes := make(map[string]AuditChange)
+
+	for _, a := range []struct {
+		name          string
+		before, after string
+	}{
+		{"email", before.Email, after.Email},
+		{"first_name", before.FirstName, after.FirstName},
+		{"last_name", before.LastName, after.LastName},
+		{"nickname", before.Nickname, after.Nickname},
+		{"country", before.Country, after.Country},
+	} {
+		if a.before != a.after {
+			changes[a.name] = AuditChange{Before: a.before, After: a.after}
+		}
+	}
+
+	if before.PasswordHash != after.PasswordHash {
+		changes["password_hash"] = AuditChange{Before: redact(before.PasswordHash), After: redact(after.PasswordHash)}
+	}
+
+	return changes
+}
+
+// DiffUserStatus returns the changes of the status from the status change before to the status change after, by
+// attribute.
+func DiffUserStatus(before, after UserStatusChange) map[string]AuditChange {
+	changes := make(map[string]AuditChange)
+
+	for _, a := range []struct {
+		name          string
+		before, after string
+	}{
+		{"status", string(before.Status), string(after.Status)},
+		{"status_reason", before.StatusReason, after.StatusReason},
+		{"status_expires_at", formatTime(before.StatusExpiresAt), formatTime(after.StatusExpiresAt)},
+	} {
+		if a.before != a.after {
+			changes[a.name] = AuditChange{Before: a.before, After: a.after}
+		}
+	}
+
+	return changes
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func redact(v string) string {
+	if v == "" {
+		return ""
+	}
+
+	return RedactedValue
+}
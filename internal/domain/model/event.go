@@ -0,0 +1,71 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CloudEventsSpecVersion is the version of the CloudEvents specification the user events comply with.
+const CloudEventsSpecVersion = "1.0"
+
+// UserEventType represents the type of a user event.
+//
+// The type is suffixed with the version of the event data schema. A breaking change of the schema is released under a
+// new type, so subscribers keep receiving the version they understand.
+type UserEventType string
+
+// User event types.
+const (
+	UserAddedV1   UserEventType = "com.faceit.user.added.v1"
+	UserUpdatedV1 UserEventType = "com.faceit.user.updated.v1"
+	UserDeletedV1 UserEventType = "com.faceit.user.deleted.v1"
+
+	// UserRestoredV1 is the event of the deleted user restored, only when its deletion was notified.
+	UserRestoredV1 UserEventType = "com.faceit.user.restored.v1"
+
+	// UserStatusChangedV1 is the event of the user suspended, banned or reinstated.
+	UserStatusChangedV1 UserEventType = "com.faceit.user.status_changed.v1"
+
+	// UserPasswordResetV1 is a security event, the password of the user was reset and its sessions revoked.
+	UserPasswordResetV1 UserEventType = "com.faceit.user.password_reset.v1"
+)
+
+// UserEvent represents a user event, described by the CloudEvents attributes.
+type UserEvent struct {
+	ID      uuid.UUID     // Unique id of the event, stable across redeliveries
+	Source  string        // Context in which the event happened
+	Type    UserEventType // Type of the event
+	Subject UserID        // User the event is about
+	Time    time.Time     // Time the event happened
+	Data    UserEventData // Event data
+
+	Country     string // Country of the user when the event happened
+	ResumeToken string // Opaque token to resume watching the events after this one
+}
+
+// UserEventData holds the user event data, version 1 of the schema.
+//
+// It is decoupled from UserState, so the user model evolves without breaking subscribers. Credentials are never
+// part of it.
+type UserEventData struct {
+	ID        string `json:"id"`
+	Email     string `json:"email,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Nickname  string `json:"nickname,omitempty"`
+	Country   string `json:"country,omitempty"`
+	Status    string `json:"status,omitempty"` // Status of the user, only set by the status changes
+}
+
+// NewUserEventData creates the user event data from the user state.
+func NewUserEventData(id UserID, info UserState) UserEventData {
+	return UserEventData{
+		ID:        id.String(),
+		Email:     info.Email,
+		FirstName: info.FirstName,
+		LastName:  info.LastName,
+		Nickname:  info.Nickname,
+		Country:   info.Country,
+	}
+}
@@ -0,0 +1,10 @@
+package model
+
+// Cursor is the position of an item in a sorted list, the values of the sort keys of the item.
+type Cursor []string
+
+// UserPage is a page of a list of users.
+type UserPage struct {
+	Users []*User
+	Next  Cursor // Position of the last user to continue the list after, nil on the last page
+}
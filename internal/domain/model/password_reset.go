@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// PasswordResetRequested represents the request to reset the password of a user, notified to deliver the token to
+// the email of the user.
+type PasswordResetRequested struct {
+	UserID    UserID    // User the password belongs to
+	Email     string    // Email the token is delivered to
+	Token     string    // Single-use token allowing to reset the password
+	ExpiresAt time.Time // Expiration of the token
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PersonalData holds every record stored about a user, as exported to the user exercising its right of access.
+//
+// Secrets are never part of it, the password hash is left out and only the metadata of the tokens is kept.
+type PersonalData struct {
+	User            User                 // Stored attributes of the user
+	Roles           []RoleAssignment     // Roles assigned to the user
+	Sessions        []SessionRecord      // Refresh tokens issued to the user
+	Verifications   []TokenRecord        // Email verification tokens sent to the user
+	PasswordResets  []TokenRecord        // Password reset tokens sent to the user
+	LoginAttempts   *LoginAttemptsRecord // Failed logins of the email of the user, nil when there is none
+	Events          []EventRecord        // Events recorded about the user
+	AuditEntries    []*AuditEntry        // Audit entries of the mutations of the user
+	IdempotencyKeys []IdempotencyRecord  // Mutations of the user replayed to their retries
+}
+
+// RoleAssignment represents a role assigned to a user.
+type RoleAssignment struct {
+	Role       Role      // Role assigned
+	AssignedAt time.Time // Time the role was assigned
+}
+
+// SessionRecord represents the metadata of a refresh token issued to a user.
+type SessionRecord struct {
+	FamilyID  uuid.UUID  // Login the token was rotated from
+	CreatedAt time.Time  // Time the token was issued
+	ExpiresAt time.Time  // Expiration of the token
+	RevokedAt *time.Time // Time the token was rotated or revoked, nil while valid
+}
+
+// TokenRecord represents the metadata of a single-use token sent to a user.
+type TokenRecord struct {
+	Email     string     // Email the token was sent to, empty when the token is not bound to an email
+	CreatedAt time.Time  // Time the token was issued
+	ExpiresAt time.Time  // Expiration of the token
+	UsedAt    *time.Time // Time the token was used, nil while not used
+}
+
+// LoginAttemptsRecord represents the failed logins of the email of a user.
+type LoginAttemptsRecord struct {
+	Failures    int        // Failed logins since the last successful login or lockout
+	LockedUntil *time.Time // End of the lockout, nil when not locked out
+	UpdatedAt   time.Time  // Time of the last failed login
+}
+
+// EventRecord represents an event recorded about a user.
+type EventRecord struct {
+	ID          uuid.UUID       // Unique id of the event
+	Type        string          // Type of the event
+	Payload     json.RawMessage // Information relevant to the event
+	CreatedAt   time.Time       // Time the event was recorded
+	PublishedAt *time.Time      // Time the event was published, nil while not published
+}
+
+// IdempotencyRecord represents a mutation of a user replayed to the retries with its idempotency key.
+type IdempotencyRecord struct {
+	Key       string    // Idempotency key the mutation was identified with
+	Method    string    // Method of the mutation
+	Response  []byte    // Response of the mutation replayed, as stored
+	CreatedAt time.Time // Time the mutation was performed
+	ExpiresAt time.Time // Time the response stops being replayed
+}
+
+// Erasure represents the outcome of erasing the personal data of a user.
+type Erasure struct {
+	Deleted bool             // Whether the user was already deleted before the erasure
+	Records map[string]int64 // Number of records erased or anonymized, by table
+}
+
+// ErasureCertificate records the erasure of the personal data of a user.
+//
+// It holds no personal data, the user is only referenced by its id, kept pseudonymous.
+type ErasureCertificate struct {
+	ID       uuid.UUID        // Unique id of the certificate
+	UserID   UserID           // User whose personal data was erased
+	ErasedBy string           // Subject of the actor who requested the erasure
+	ErasedAt time.Time        // Time the personal data was erased
+	Records  map[string]int64 // Number of records erased or anonymized, by table
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// Role represents a role assigned to a user, granting permissions.
+type Role string
+
+// Roles.
+const (
+	// RoleAdmin manages all the users.
+	RoleAdmin Role = "admin"
+	// RoleSupport reads all the users, without their credentials.
+	RoleSupport Role = "support"
+	// RoleUser manages its own user, every authenticated user has it.
+	RoleUser Role = "user"
+)
+
+// Permission represents an action a role is allowed to perform.
+type Permission string
+
+// Permissions.
+const (
+	PermissionReadUsers     Permission = "users.read"      // Read any user
+	PermissionWriteUsers    Permission = "users.write"     // Add, update and delete any user
+	PermissionReadOwnUser   Permission = "users.own.read"  // Read its own user
+	PermissionWriteOwnUser  Permission = "users.own.write" // Update and delete its own user
+	PermissionModerateUsers Permission = "users.moderate"  // Suspend, ban and reinstate any user
+	PermissionReadAudit     Permission = "audit.read"      // Read the audit log of the user mutations
+)
+
+// rolePermissions are the permissions granted to the roles.
+var rolePermissions = map[Role][]Permission{
+	RoleAdmin:   {PermissionReadUsers, PermissionWriteUsers, PermissionModerateUsers, PermissionReadAudit},
+	RoleSupport: {PermissionReadUsers},
+	RoleUser:    {PermissionReadOwnUser, PermissionWriteOwnUser},
+}
+
+// Valid reports whether the role is known.
+func (r Role) Valid() bool {
+	_, ok := rolePermissions[r]
+
+	return ok
+}
+
+// Can reports whether the role is granted the permission.
+func (r Role) Can(p Permission) bool {
+	return slices.Contains(rolePermissions[r], p)
+}
+
+// Actor represents the caller performing an action, a user or a service.
+type Actor struct {
+	Subject string // ID of the user, or name of the service
+	UserID  UserID // ID of the user, uuid.Nil for a service
+	Roles   []Role // Roles assigned to the caller
+}
+
+// Can reports whether any role of the actor is granted the permission.
+func (a Actor) Can(p Permission) bool {
+	return slices.ContainsFunc(a.Roles, func(r Role) bool {
+		return r.Can(p)
+	})
+}
+
+// Is reports whether the actor is the user.
+func (a Actor) Is(id UserID) bool {
+	return a.UserID != uuid.Nil && a.UserID == id
+}
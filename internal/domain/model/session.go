@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// Session represents the tokens issued to an authenticated user.
+type Session struct {
+	AccessToken          string    // Signed JWT authenticating the requests of the user
+	AccessTokenExpiresAt time.Time // Expiration of the access token
+
+	RefreshToken          string    // Opaque token to obtain a new session, valid once
+	RefreshTokenExpiresAt time.Time // Expiration of the refresh token
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// UserStatus represents the status of the account of a user.
+type UserStatus string
+
+// User statuses.
+const (
+	// StatusActive is the status of the users in good standing.
+	StatusActive UserStatus = "active"
+	// StatusSuspended is the status of the users temporarily restricted, until reinstated or the suspension expires.
+	StatusSuspended UserStatus = "suspended"
+	// StatusBanned is the status of the users banned, permanently unless the ban expires.
+	StatusBanned UserStatus = "banned"
+)
+
+// statusTransitions are the statuses each status can change to.
+//
+// Suspensions and bans change to themselves to update their reason or expiry, a ban is only lifted by reinstating
+// the user.
+var statusTransitions = map[UserStatus][]UserStatus{
+	StatusActive:    {StatusSuspended, StatusBanned},
+	StatusSuspended: {StatusActive, StatusSuspended, StatusBanned},
+	StatusBanned:    {StatusActive, StatusBanned},
+}
+
+// Valid reports whether the status is known.
+func (s UserStatus) Valid() bool {
+	_, ok := statusTransitions[s]
+
+	return ok
+}
+
+// CanChangeTo reports whether the status can change to the given status.
+func (s UserStatus) CanChangeTo(to UserStatus) bool {
+	return slices.Contains(statusTransitions[s], to)
+}
+
+// Restricted reports whether the users of the status are denied access, they can not authenticate.
+func (s UserStatus) Restricted() bool {
+	return s == StatusSuspended || s == StatusBanned
+}
+
+// Expirable reports whether the status can be given an expiry, it is lifted once expired.
+func (s UserStatus) Expirable() bool {
+	return s == StatusSuspended || s == StatusBanned
+}
+
+// UserStatusChange holds the status of a user along with its last change.
+type UserStatusChange struct {
+	Status          UserStatus `db:"status" json:",omitempty"`            // Account status
+	StatusReason    string     `db:"status_reason" json:",omitempty"`     // Reason of the last change
+	StatusChangedBy string     `db:"status_changed_by" json:",omitempty"` // Subject of the actor of the last change
+	StatusChangedAt *time.Time `db:"status_changed_at" json:",omitempty"` // Time of the last change, nil when never changed
+	StatusExpiresAt *time.Time `db:"status_expires_at" json:",omitempty"` // Time the status is lifted, nil when permanent
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,11 +15,23 @@ type User struct {
 	ID UserID `db:"id"` // User ID
 
 	UserState
+	UserStatusChange
+
+	EmailVerifiedAt *time.Time `db:"email_verified_at" json:",omitempty"` // Time the email was verified, nil while not verified
+	DeletedAt       *time.Time `db:"deleted_at" json:",omitempty"`        // Time the user was deleted, nil while not deleted
+	ErasedAt        *time.Time `db:"erased_at" json:",omitempty"`         // Time the personal data was erased, nil while not erased
+
+	Version int64 `db:"version,omitempty" json:",omitempty"` // Version of the user, incremented on every update
 
 	CreatedAt time.Time `db:"created_at"` // Creation timestamp
 	UpdatedAt time.Time `db:"updated_at"` // Last update timestamp
 }
 
+// EmailVerified reports whether the user verified the ownership of its email.
+func (u *User) EmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
 // UserState holds the user state.
 type UserState struct {
 	PasswordHash string `db:"password_hash,omitempty"` // Hashed password
@@ -28,3 +41,24 @@ type UserState struct {
 	Nickname     string `db:"nickname"`             // Optional nickname
 	Country      string `db:"country,omitempty"`    // 2-character country code
 }
+
+// UserField is an attribute of the user state a user update sets.
+type UserField string
+
+// Fields of the user state.
+const (
+	UserFieldEmail     UserField = "email"
+	UserFieldFirstName UserField = "first_name"
+	UserFieldLastName  UserField = "last_name"
+	UserFieldNickname  UserField = "nickname"
+	UserFieldCountry   UserField = "country"
+	UserFieldPassword  UserField = "password" // Sets the password hash
+)
+
+// UserFields is the set of attributes a user update sets, to their value in the state, zero values included.
+type UserFields []UserField
+
+// Has reports whether the field is set.
+func (fs UserFields) Has(f UserField) bool {
+	return slices.Contains(fs, f)
+}
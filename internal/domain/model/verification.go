@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// VerificationRequested represents the request to verify the email of a user, notified to deliver the token to the
+// email.
+type VerificationRequested struct {
+	UserID    UserID    // User the email belongs to
+	Email     string    // Email to verify
+	Token     string    // Single-use token proving the ownership of the email
+	ExpiresAt time.Time // Expiration of the token
+}
@@ -5,11 +5,13 @@ import (
 
 	"github.com/bool64/ctxd"
 	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/google/uuid"
 )
 
 //go:generate mockery --name=UserAdder --outpkg=mocks --output=mocks --filename=user_adder.go --with-expecter
 
-// UserAdder defines functionality to add a user to the data layer.
+// UserAdder defines functionality to add a user to the data layer, setting the status, the version and the timestamps
+// of the user.
 type UserAdder interface {
 	AddUser(ctx context.Context, u *model.User) error
 }
@@ -23,37 +25,86 @@ type UserAddedNotifier interface {
 
 // AddUser is a use case to add a user.
 type AddUser struct {
-	adder    UserAdder
-	notifier UserAddedNotifier
+	tx           Transactor
+	adder        UserAdder
+	hasher       PasswordHasher
+	notifier     UserAddedNotifier
+	auditor      AuditRecorder
+	verification VerificationRequester
 
 	logger ctxd.Logger
 }
 
 // NewAddUser creates a new AddUser use case.
-func NewAddUser(userAdder UserAdder, notifier UserAddedNotifier, logger ctxd.Logger) *AddUser {
+//
+// The notifier and the auditor are called within the same transaction as the adder, so the user is only added when
+// the notification and the audit entry are recorded as well. The verification of the email is requested once the
+// user is added.
+func NewAddUser(tx Transactor, userAdder UserAdder, hasher PasswordHasher, notifier UserAddedNotifier, auditor AuditRecorder, verification VerificationRequester, logger ctxd.Logger) *AddUser {
 	return &AddUser{
-		adder:    userAdder,
-		notifier: notifier,
-		logger:   logger,
+		tx:           tx,
+		adder:        userAdder,
+		hasher:       hasher,
+		notifier:     notifier,
+		auditor:      auditor,
+		verification: verification,
+		logger:       logger,
 	}
 }
 
-// AddUser executes the add user use case.
-func (a *AddUser) AddUser(ctx context.Context, u *model.User) error {
+// AddUser executes the add user use case, returning the user added.
+//
+// The user is given a time-ordered id, a UUIDv7, when it has none. The password is hashed before the user is stored,
+// the plaintext password is never stored.
+func (a *AddUser) AddUser(ctx context.Context, u *model.User, password string) (*model.User, error) {
+	if u.ID == uuid.Nil {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, ctxd.WrapError(ctx, err, "generate user id")
+		}
+
+		u.ID = id
+	}
+
 	ctx = ctxd.AddFields(ctx, "use_case", "AddUser", "user_id", u.ID)
 
-	err := a.adder.AddUser(ctx, u)
+	if _, err := authorize(ctx, model.PermissionWriteUsers); err != nil {
+		return nil, ctxd.WrapError(ctx, err, "authorize")
+	}
+
+	hash, err := a.hasher.Hash(password)
 	if err != nil {
-		return ctxd.WrapError(ctx, err, "add user") // error contains the context fields added
+		return nil, ctxd.WrapError(ctx, err, "hash password")
 	}
 
-	a.logger.Debug(ctx, "user added")
+	u.PasswordHash = hash
+
+	err = a.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := a.adder.AddUser(ctx, u); err != nil {
+			return ctxd.WrapError(ctx, err, "add user") // error contains the context fields added
+		}
 
-	if err = a.notifier.NotifyUserAdded(ctx, u); err != nil {
-		return ctxd.WrapError(ctx, err, "notify user added")
+		if err := a.notifier.NotifyUserAdded(ctx, u); err != nil {
+			return ctxd.WrapError(ctx, err, "notify user added")
+		}
+
+		if err := audit(ctx, a.auditor, u.ID, model.AuditUserAdded, model.UserState{}, u.UserState); err != nil {
+			return ctxd.WrapError(ctx, err, "audit user added")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	a.logger.Debug(ctx, "user added notification sent")
+	a.logger.Debug(ctx, "user added")
+
+	// The user is added unverified whether the verification is requested or not, it is requested again with
+	// ResendVerification.
+	if err := a.verification.RequestVerification(ctx, u.ID); err != nil {
+		a.logger.Warn(ctx, "request email verification", "error", err)
+	}
 
-	return nil
+	return u, nil
 }
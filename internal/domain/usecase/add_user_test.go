@@ -14,51 +14,162 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// adminCtx is the context of an admin, allowed to add users.
+var adminCtx = usecase.ContextWithActor(context.Background(), model.Actor{Subject: "admin", Roles: []model.Role{model.RoleAdmin}})
+
 func TestAddUser_AddUser(t *testing.T) {
 	t.Parallel()
 
-	user := &model.User{
-		ID: uuid.New(),
-		UserState: model.UserState{
-			PasswordHash: "supersecurepassword",
-			Email:        "alice@bob.com",
-			FirstName:    "Alice",
-			LastName:     "Bob",
-			Nickname:     "AB123",
-			Country:      "UK",
-		},
+	id := uuid.New()
+
+	// newUser returns the user to add, each subtest gets its own as the use case sets the password hash.
+	newUser := func() *model.User {
+		return &model.User{
+			ID: id,
+			UserState: model.UserState{
+				Email:     "alice@bob.com",
+				FirstName: "Alice",
+				LastName:  "Bob",
+				Nickname:  "AB123",
+				Country:   "UK",
+			},
+		}
 	}
 
+	hash := "$argon2id$v=19$m=65536,t=3,p=2$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"
+
+	hashed := newUser()
+	hashed.PasswordHash = hash
+
 	t.Run("success", func(t *testing.T) {
 		t.Parallel()
 
+		user := newUser()
+
+		hasher := mocks.NewPasswordHasher(t)
+		hasher.EXPECT().Hash("s3cr3t-passw0rd").Return(hash, nil)
+
 		adder := mocks.NewUserAdder(t)
-		adder.EXPECT().AddUser(mock.Anything, user).Return(nil)
+		adder.EXPECT().AddUser(mock.Anything, hashed).Return(nil)
 
 		notifier := mocks.NewUserAddedNotifier(t)
-		notifier.EXPECT().NotifyUserAdded(mock.Anything, user).Return(nil)
+		notifier.EXPECT().NotifyUserAdded(mock.Anything, hashed).Return(nil)
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, &model.AuditEntry{
+			UserID: id,
+			Action: model.AuditUserAdded,
+			Actor:  "admin",
+			Changes: map[string]model.AuditChange{
+				"email":         {After: "alice@bob.com"},
+				"first_name":    {After: "Alice"},
+				"last_name":     {After: "Bob"},
+				"nickname":      {After: "AB123"},
+				"country":       {After: "UK"},
+				"password_hash": {After: model.RedactedValue},
+			},
+		}).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		verification := mocks.NewVerificationRequester(t)
+		verification.EXPECT().RequestVerification(mock.Anything, id).Return(nil)
 
 		logger := &ctxd.LoggerMock{}
 
-		uc := usecase.NewAddUser(adder, notifier, logger)
+		uc := usecase.NewAddUser(tx, adder, hasher, notifier, auditor, verification, logger)
 
-		err := uc.AddUser(context.Background(), user)
+		u, err := uc.AddUser(adminCtx, user, "s3cr3t-passw0rd")
 		require.NoError(t, err)
+		require.Equal(t, hashed, u)
+	})
+
+	t.Run("generated id", func(t *testing.T) {
+		t.Parallel()
+
+		user := newUser()
+		user.ID = uuid.Nil
+
+		hasher := mocks.NewPasswordHasher(t)
+		hasher.EXPECT().Hash("s3cr3t-passw0rd").Return(hash, nil)
+
+		adder := mocks.NewUserAdder(t)
+		adder.EXPECT().AddUser(mock.Anything, mock.Anything).Return(nil)
+
+		notifier := mocks.NewUserAddedNotifier(t)
+		notifier.EXPECT().NotifyUserAdded(mock.Anything, mock.Anything).Return(nil)
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, mock.Anything).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		verification := mocks.NewVerificationRequester(t)
+		verification.EXPECT().RequestVerification(mock.Anything, mock.Anything).Return(nil)
+
+		uc := usecase.NewAddUser(tx, adder, hasher, notifier, auditor, verification, &ctxd.LoggerMock{})
+
+		u, err := uc.AddUser(adminCtx, user, "s3cr3t-passw0rd")
+		require.NoError(t, err)
+		require.Equal(t, uuid.Version(7), u.ID.Version())
+	})
+
+	t.Run("error verification", func(t *testing.T) {
+		t.Parallel()
+
+		user := newUser()
+
+		hasher := mocks.NewPasswordHasher(t)
+		hasher.EXPECT().Hash("s3cr3t-passw0rd").Return(hash, nil)
+
+		adder := mocks.NewUserAdder(t)
+		adder.EXPECT().AddUser(mock.Anything, hashed).Return(nil)
+
+		notifier := mocks.NewUserAddedNotifier(t)
+		notifier.EXPECT().NotifyUserAdded(mock.Anything, hashed).Return(nil)
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, mock.Anything).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		verification := mocks.NewVerificationRequester(t)
+		verification.EXPECT().RequestVerification(mock.Anything, id).Return(assert.AnError)
+
+		logger := &ctxd.LoggerMock{}
+
+		uc := usecase.NewAddUser(tx, adder, hasher, notifier, auditor, verification, logger)
+
+		// The user is added, the verification is requested again later.
+		_, err := uc.AddUser(adminCtx, user, "s3cr3t-passw0rd")
+		require.NoError(t, err)
+		require.Contains(t, logger.String(), "request email verification")
 	})
 
 	t.Run("error adder", func(t *testing.T) {
 		t.Parallel()
 
+		user := newUser()
+
+		hasher := mocks.NewPasswordHasher(t)
+		hasher.EXPECT().Hash("s3cr3t-passw0rd").Return(hash, nil)
+
 		adder := mocks.NewUserAdder(t)
-		adder.EXPECT().AddUser(mock.Anything, user).Return(assert.AnError)
+		adder.EXPECT().AddUser(mock.Anything, hashed).Return(assert.AnError)
 
 		notifier := mocks.NewUserAddedNotifier(t)
 
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
 		logger := &ctxd.LoggerMock{}
 
-		uc := usecase.NewAddUser(adder, notifier, logger)
+		uc := usecase.NewAddUser(tx, adder, hasher, notifier, mocks.NewAuditRecorder(t), mocks.NewVerificationRequester(t), logger)
 
-		err := uc.AddUser(context.Background(), user)
+		_, err := uc.AddUser(adminCtx, user, "s3cr3t-passw0rd")
 		require.Error(t, err)
 		require.ErrorIs(t, err, assert.AnError)
 	})
@@ -66,18 +177,55 @@ func TestAddUser_AddUser(t *testing.T) {
 	t.Run("error notifier", func(t *testing.T) {
 		t.Parallel()
 
+		user := newUser()
+
+		hasher := mocks.NewPasswordHasher(t)
+		hasher.EXPECT().Hash("s3cr3t-passw0rd").Return(hash, nil)
+
 		adder := mocks.NewUserAdder(t)
-		adder.EXPECT().AddUser(mock.Anything, user).Return(nil)
+		adder.EXPECT().AddUser(mock.Anything, hashed).Return(nil)
 
 		notifier := mocks.NewUserAddedNotifier(t)
-		notifier.EXPECT().NotifyUserAdded(mock.Anything, user).Return(assert.AnError)
+		notifier.EXPECT().NotifyUserAdded(mock.Anything, hashed).Return(assert.AnError)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
 
 		logger := &ctxd.LoggerMock{}
 
-		uc := usecase.NewAddUser(adder, notifier, logger)
+		uc := usecase.NewAddUser(tx, adder, hasher, notifier, mocks.NewAuditRecorder(t), mocks.NewVerificationRequester(t), logger)
 
-		err := uc.AddUser(context.Background(), user)
+		_, err := uc.AddUser(adminCtx, user, "s3cr3t-passw0rd")
 		require.Error(t, err)
 		require.ErrorIs(t, err, assert.AnError)
 	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		t.Parallel()
+
+		uc := usecase.NewAddUser(mocks.NewTransactor(t), mocks.NewUserAdder(t), mocks.NewPasswordHasher(t), mocks.NewUserAddedNotifier(t), mocks.NewAuditRecorder(t), mocks.NewVerificationRequester(t), &ctxd.LoggerMock{})
+
+		ctx := usecase.ContextWithActor(context.Background(), model.Actor{Subject: "backoffice", Roles: []model.Role{model.RoleSupport}})
+
+		_, err := uc.AddUser(ctx, newUser(), "s3cr3t-passw0rd")
+		require.ErrorIs(t, err, usecase.ErrPermissionDenied)
+	})
+}
+
+func TestAddUser_AddUser_errorHasher(t *testing.T) {
+	t.Parallel()
+
+	hasher := mocks.NewPasswordHasher(t)
+	hasher.EXPECT().Hash("s3cr3t-passw0rd").Return("", assert.AnError)
+
+	uc := usecase.NewAddUser(mocks.NewTransactor(t), mocks.NewUserAdder(t), hasher, mocks.NewUserAddedNotifier(t), mocks.NewAuditRecorder(t), mocks.NewVerificationRequester(t), &ctxd.LoggerMock{})
+
+	_, err := uc.AddUser(adminCtx, &model.User{ID: uuid.New()}, "s3cr3t-passw0rd")
+	require.Error(t, err)
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+// inTx runs the function as a transaction would do, used to mock usecase.Transactor.
+func inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
 }
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+//go:generate mockery --name=AuditRecorder --outpkg=mocks --output=mocks --filename=audit_recorder.go --with-expecter
+
+// AuditRecorder defines functionality to record the audit entries in the data layer.
+type AuditRecorder interface {
+	// RecordAudit appends the audit entry, the time of the entry is set by the data layer.
+	RecordAudit(ctx context.Context, e *model.AuditEntry) error
+}
+
+// systemActor is the subject recorded on the mutations the service performs on its own, e.g. on schedule.
+const systemActor = "system"
+
+type requestCtxKey struct{}
+
+// ContextWithRequest returns a context carrying the information of the request the use cases are performed on.
+func ContextWithRequest(ctx context.Context, r model.RequestInfo) context.Context {
+	return context.WithValue(ctx, requestCtxKey{}, r)
+}
+
+// RequestFromContext returns the information of the request carried by the context, false when the context carries
+// none.
+func RequestFromContext(ctx context.Context) (model.RequestInfo, bool) {
+	r, ok := ctx.Value(requestCtxKey{}).(model.RequestInfo)
+
+	return r, ok
+}
+
+// audit records the mutation of the user from the state before to the state after, by the actor and on the request
+// carried by the context.
+//
+// It must be called within the transaction of the mutation, so the mutation is only performed when recorded.
+func audit(ctx context.Context, recorder AuditRecorder, id model.UserID, action model.AuditAction, before, after model.UserState) error {
+	return record(ctx, recorder, &model.AuditEntry{
+		UserID:  id,
+		Action:  action,
+		Changes: model.DiffUserState(before, after),
+	})
+}
+
+// auditStatus records the change of the status of the user from the status change before to the status change after,
+// by the actor of the change.
+//
+// It must be called within the transaction of the change.
+func auditStatus(ctx context.Context, recorder AuditRecorder, id model.UserID, before, after model.UserStatusChange) error {
+	action := model.AuditUserReinstated
+
+	switch after.Status {
+	case model.StatusSuspended:
+		action = model.AuditUserSuspended
+	case model.StatusBanned:
+		action = model.AuditUserBanned
+	}
+
+	return record(ctx, recorder, &model.AuditEntry{
+		UserID:  id,
+		Action:  action,
+		Actor:   after.StatusChangedBy,
+		Changes: model.DiffUserStatus(before, after),
+	})
+}
+
+// auditPasswordHash records the password hash of the user replaced by the user itself, proving it is the user with
+// a token or its password rather than as an actor. Only the change of the hash is recorded.
+//
+// It must be called within the transaction of the change.
+func auditPasswordHash(ctx context.Context, recorder AuditRecorder, id model.UserID, action model.AuditAction) error {
+	return record(ctx, recorder, &model.AuditEntry{
+		UserID: id,
+		Action: action,
+		Actor:  id.String(),
+		Changes: map[string]model.AuditChange{
+			"password_hash": {Before: model.RedactedValue, After: model.RedactedValue},
+		},
+	})
+}
+
+// record appends the entry on the request carried by the context, by the actor carried by the context unless the
+// entry has one.
+func record(ctx context.Context, recorder AuditRecorder, e *model.AuditEntry) error {
+	if e.Actor == "" {
+		actor, _ := ActorFromContext(ctx)
+		e.Actor = actor.Subject
+	}
+
+	req, _ := RequestFromContext(ctx)
+	e.RequestID, e.SourceIP = req.ID, req.SourceIP
+
+	if e.Changes == nil {
+		e.Changes = map[string]model.AuditChange{}
+	}
+
+	return recorder.RecordAudit(ctx, e)
+}
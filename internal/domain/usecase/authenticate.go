@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+// ErrAccountLocked occurs when the logins of an email are locked out after repeated failures.
+var ErrAccountLocked = errors.New("account temporarily locked")
+
+// ErrAccountRestricted occurs when the user is suspended or banned.
+var ErrAccountRestricted = errors.New("account suspended or banned")
+
+//go:generate mockery --name=PasswordVerifier --outpkg=mocks --output=mocks --filename=password_verifier.go --with-expecter
+
+// PasswordVerifier defines functionality to verify the password of a user.
+type PasswordVerifier interface {
+	VerifyPassword(ctx context.Context, email, password string) (*model.User, error)
+}
+
+//go:generate mockery --name=LoginThrottler --outpkg=mocks --output=mocks --filename=login_throttler.go --with-expecter
+
+// LoginThrottler defines functionality to lock out the logins of an email after repeated failures.
+type LoginThrottler interface {
+	// Locked reports whether the logins of the email are locked out.
+	Locked(ctx context.Context, email string) (bool, error)
+	// Failed records a failed login of the email.
+	Failed(ctx context.Context, email string) error
+	// Succeeded clears the failed logins of the email.
+	Succeeded(ctx context.Context, email string) error
+}
+
+//go:generate mockery --name=SessionIssuer --outpkg=mocks --output=mocks --filename=session_issuer.go --with-expecter
+
+// SessionIssuer defines functionality to issue the sessions of the users.
+type SessionIssuer interface {
+	Issue(ctx context.Context, u *model.User) (model.Session, error)
+}
+
+// Authenticate is a use case to authenticate a user by email and password.
+type Authenticate struct {
+	verifier  PasswordVerifier
+	throttler LoginThrottler
+	issuer    SessionIssuer
+
+	logger ctxd.Logger
+}
+
+// NewAuthenticate creates a new Authenticate use case.
+func NewAuthenticate(verifier PasswordVerifier, throttler LoginThrottler, issuer SessionIssuer, logger ctxd.Logger) *Authenticate {
+	return &Authenticate{
+		verifier:  verifier,
+		throttler: throttler,
+		issuer:    issuer,
+		logger:    logger,
+	}
+}
+
+// Authenticate executes the authenticate use case.
+//
+// The logins of the email are locked out after repeated failures, whether the email is registered or not, so the
+// lockout does not reveal which emails are registered either. Suspended and banned users are only told so once
+// their password is verified.
+func (a *Authenticate) Authenticate(ctx context.Context, email, password string) (model.Session, error) {
+	ctx = ctxd.AddFields(ctx, "use_case", "Authenticate")
+
+	locked, err := a.throttler.Locked(ctx, email)
+	if err != nil {
+		return model.Session{}, ctxd.WrapError(ctx, err, "check lockout")
+	}
+
+	if locked {
+		return model.Session{}, ErrAccountLocked
+	}
+
+	u, err := a.verifier.VerifyPassword(ctx, email, password)
+	if err != nil {
+		if !errors.Is(err, ErrInvalidCredentials) {
+			return model.Session{}, ctxd.WrapError(ctx, err, "verify password")
+		}
+
+		if err := a.throttler.Failed(ctx, email); err != nil {
+			return model.Session{}, ctxd.WrapError(ctx, err, "record failed login")
+		}
+
+		return model.Session{}, err
+	}
+
+	ctx = ctxd.AddFields(ctx, "user_id", u.ID)
+
+	if err := a.throttler.Succeeded(ctx, email); err != nil {
+		return model.Session{}, ctxd.WrapError(ctx, err, "clear failed logins")
+	}
+
+	if u.Status.Restricted() {
+		return model.Session{}, fmt.Errorf("%w: %s", ErrAccountRestricted, u.Status)
+	}
+
+	s, err := a.issuer.Issue(ctx, u)
+	if err != nil {
+		return model.Session{}, ctxd.WrapError(ctx, err, "issue session")
+	}
+
+	a.logger.Debug(ctx, "user authenticated")
+
+	return s, nil
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticate_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	email := "alice@bob.com"
+	password := "s3cr3t-passw0rd"
+	user := &model.User{ID: uuid.New(), UserState: model.UserState{Email: email}}
+
+	session := model.Session{
+		AccessToken:           "access",
+		AccessTokenExpiresAt:  time.Date(2024, 12, 19, 9, 15, 0, 0, time.UTC),
+		RefreshToken:          "refresh",
+		RefreshTokenExpiresAt: time.Date(2025, 1, 18, 9, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		throttler := mocks.NewLoginThrottler(t)
+		throttler.EXPECT().Locked(mock.Anything, email).Return(false, nil)
+		throttler.EXPECT().Succeeded(mock.Anything, email).Return(nil)
+
+		verifier := mocks.NewPasswordVerifier(t)
+		verifier.EXPECT().VerifyPassword(mock.Anything, email, password).Return(user, nil)
+
+		issuer := mocks.NewSessionIssuer(t)
+		issuer.EXPECT().Issue(mock.Anything, user).Return(session, nil)
+
+		uc := NewAuthenticate(verifier, throttler, issuer, &ctxd.LoggerMock{})
+
+		s, err := uc.Authenticate(context.Background(), email, password)
+		require.NoError(t, err)
+		require.Equal(t, session, s)
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		t.Parallel()
+
+		throttler := mocks.NewLoginThrottler(t)
+		throttler.EXPECT().Locked(mock.Anything, email).Return(false, nil)
+		throttler.EXPECT().Failed(mock.Anything, email).Return(nil)
+
+		verifier := mocks.NewPasswordVerifier(t)
+		verifier.EXPECT().VerifyPassword(mock.Anything, email, "wrong").Return(nil, ErrInvalidCredentials)
+
+		uc := NewAuthenticate(verifier, throttler, mocks.NewSessionIssuer(t), &ctxd.LoggerMock{})
+
+		_, err := uc.Authenticate(context.Background(), email, "wrong")
+		require.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+
+	t.Run("suspended", func(t *testing.T) {
+		t.Parallel()
+
+		suspended := &model.User{ID: user.ID, UserState: user.UserState}
+		suspended.Status = model.StatusSuspended
+
+		throttler := mocks.NewLoginThrottler(t)
+		throttler.EXPECT().Locked(mock.Anything, email).Return(false, nil)
+		throttler.EXPECT().Succeeded(mock.Anything, email).Return(nil)
+
+		verifier := mocks.NewPasswordVerifier(t)
+		verifier.EXPECT().VerifyPassword(mock.Anything, email, password).Return(suspended, nil)
+
+		uc := NewAuthenticate(verifier, throttler, mocks.NewSessionIssuer(t), &ctxd.LoggerMock{})
+
+		_, err := uc.Authenticate(context.Background(), email, password)
+		require.ErrorIs(t, err, ErrAccountRestricted)
+	})
+
+	t.Run("locked", func(t *testing.T) {
+		t.Parallel()
+
+		throttler := mocks.NewLoginThrottler(t)
+		throttler.EXPECT().Locked(mock.Anything, email).Return(true, nil)
+
+		uc := NewAuthenticate(mocks.NewPasswordVerifier(t), throttler, mocks.NewSessionIssuer(t), &ctxd.LoggerMock{})
+
+		_, err := uc.Authenticate(context.Background(), email, password)
+		require.ErrorIs(t, err, ErrAccountLocked)
+	})
+
+	t.Run("error verifier", func(t *testing.T) {
+		t.Parallel()
+
+		throttler := mocks.NewLoginThrottler(t)
+		throttler.EXPECT().Locked(mock.Anything, email).Return(false, nil)
+
+		verifier := mocks.NewPasswordVerifier(t)
+		verifier.EXPECT().VerifyPassword(mock.Anything, email, password).Return(nil, assert.AnError)
+
+		uc := NewAuthenticate(verifier, throttler, mocks.NewSessionIssuer(t), &ctxd.LoggerMock{})
+
+		_, err := uc.Authenticate(context.Background(), email, password)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("error issuer", func(t *testing.T) {
+		t.Parallel()
+
+		throttler := mocks.NewLoginThrottler(t)
+		throttler.EXPECT().Locked(mock.Anything, email).Return(false, nil)
+		throttler.EXPECT().Succeeded(mock.Anything, email).Return(nil)
+
+		verifier := mocks.NewPasswordVerifier(t)
+		verifier.EXPECT().VerifyPassword(mock.Anything, email, password).Return(user, nil)
+
+		issuer := mocks.NewSessionIssuer(t)
+		issuer.EXPECT().Issue(mock.Anything, user).Return(model.Session{}, assert.AnError)
+
+		uc := NewAuthenticate(verifier, throttler, issuer, &ctxd.LoggerMock{})
+
+		_, err := uc.Authenticate(context.Background(), email, password)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+// ErrPermissionDenied occurs when the actor is not allowed to perform the action.
+var ErrPermissionDenied = errors.New("permission denied")
+
+type actorCtxKey struct{}
+
+// ContextWithActor returns a context carrying the actor performing the use cases.
+func ContextWithActor(ctx context.Context, a model.Actor) context.Context {
+	return context.WithValue(ctx, actorCtxKey{}, a)
+}
+
+// ActorFromContext returns the actor carried by the context, false when the context carries none.
+func ActorFromContext(ctx context.Context) (model.Actor, bool) {
+	a, ok := ctx.Value(actorCtxKey{}).(model.Actor)
+
+	return a, ok
+}
+
+// authorize checks the actor carried by the context is granted the permission.
+//
+// A context without actor is denied, the use cases are never performed anonymously.
+func authorize(ctx context.Context, p model.Permission) (model.Actor, error) {
+	a, ok := ActorFromContext(ctx)
+	if !ok {
+		return model.Actor{}, fmt.Errorf("%w: no actor", ErrPermissionDenied)
+	}
+
+	if !a.Can(p) {
+		return a, fmt.Errorf("%w: %s not granted to %s", ErrPermissionDenied, p, a.Subject)
+	}
+
+	return a, nil
+}
+
+// authorizeOnUser checks the actor carried by the context is granted the permission, or the own permission when
+// acting on its own user.
+func authorizeOnUser(ctx context.Context, p, own model.Permission, id model.UserID) (model.Actor, error) {
+	a, err := authorize(ctx, p)
+	if err == nil || (a.Is(id) && a.Can(own)) {
+		return a, nil
+	}
+
+	return a, err
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// actingAs returns a context carrying the user with the roles as actor.
+func actingAs(id model.UserID, roles ...model.Role) context.Context {
+	return ContextWithActor(context.Background(), model.Actor{Subject: id.String(), UserID: id, Roles: roles})
+}
+
+// asAdmin returns a context carrying an admin as actor.
+func asAdmin() context.Context {
+	return ContextWithActor(context.Background(), model.Actor{Subject: "admin", Roles: []model.Role{model.RoleAdmin}})
+}
+
+func TestAuthorizeOnUser(t *testing.T) {
+	t.Parallel()
+
+	self := uuid.New()
+	other := uuid.New()
+
+	for _, tc := range []struct {
+		name    string
+		ctx     context.Context //nolint:containedctx
+		id      model.UserID
+		granted bool
+	}{
+		{name: "admin on other", ctx: asAdmin(), id: other, granted: true},
+		{name: "user on self", ctx: actingAs(self, model.RoleUser), id: self, granted: true},
+		{name: "user on other", ctx: actingAs(self, model.RoleUser), id: other},
+		{name: "support on other", ctx: actingAs(self, model.RoleSupport), id: other},
+		{name: "service without role", ctx: ContextWithActor(context.Background(), model.Actor{Subject: "backoffice"}), id: other},
+		{name: "anonymous", ctx: context.Background(), id: other},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := authorizeOnUser(tc.ctx, model.PermissionWriteUsers, model.PermissionWriteOwnUser, tc.id)
+			if tc.granted {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorIs(t, err, ErrPermissionDenied)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+// ErrInvalidStatusTransition occurs when the status of the user can not change to the requested status.
+var ErrInvalidStatusTransition = errors.New("invalid status transition")
+
+//go:generate mockery --name=UserStatusChanger --outpkg=mocks --output=mocks --filename=user_status_changer.go --with-expecter
+
+// UserStatusChanger defines functionality to change the status of a user in the data layer.
+type UserStatusChanger interface {
+	// ChangeStatus changes the status of the user, provided its last status change is still the one it changes
+	// from, ErrInvalidStatusTransition otherwise.
+	ChangeStatus(ctx context.Context, id model.UserID, from, to model.UserStatusChange) error
+}
+
+//go:generate mockery --name=UserStatusChangedNotifier --outpkg=mocks --output=mocks --filename=user_status_changed_notifier.go --with-expecter
+
+// UserStatusChangedNotifier defines functionality to notify about the status of a user changed.
+type UserStatusChangedNotifier interface {
+	NotifyUserStatusChanged(ctx context.Context, id model.UserID, status model.UserStatus) error
+}
+
+// ChangeUserStatus is a use case to suspend, ban and reinstate a user.
+type ChangeUserStatus struct {
+	tx       Transactor
+	finder   UserFinder
+	changer  UserStatusChanger
+	revoker  SessionsRevoker
+	notifier UserStatusChangedNotifier
+	auditor  AuditRecorder
+
+	logger ctxd.Logger
+}
+
+// NewChangeUserStatus creates a new ChangeUserStatus use case.
+func NewChangeUserStatus(tx Transactor, finder UserFinder, changer UserStatusChanger, revoker SessionsRevoker, notifier UserStatusChangedNotifier, auditor AuditRecorder, logger ctxd.Logger) *ChangeUserStatus {
+	return &ChangeUserStatus{
+		tx:       tx,
+		finder:   finder,
+		changer:  changer,
+		revoker:  revoker,
+		notifier: notifier,
+		auditor:  auditor,
+		logger:   logger,
+	}
+}
+
+// ChangeUserStatus executes the change user status use case, returning the user with its new status.
+//
+// The change is recorded along with the actor performing it, and audited. Suspending or banning the user revokes its
+// sessions, it can not authenticate until reinstated or the status expires. Only suspensions and bans expire.
+func (c *ChangeUserStatus) ChangeUserStatus(ctx context.Context, id model.UserID, change model.UserStatusChange) (*model.User, error) {
+	ctx = ctxd.AddFields(ctx, "use_case", "ChangeUserStatus", "user_id", id, "status", change.Status)
+
+	actor, err := authorize(ctx, model.PermissionModerateUsers)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "authorize")
+	}
+
+	if !change.Status.Expirable() && change.StatusExpiresAt != nil {
+		return nil, ctxd.WrapError(ctx, fmt.Errorf("%w: %s does not expire", ErrInvalidStatusTransition, change.Status),
+			"check status expiry")
+	}
+
+	change.StatusChangedBy = actor.Subject
+
+	var u *model.User
+
+	err = c.tx.InTx(ctx, func(ctx context.Context) error {
+		u, err = c.finder.FindByID(ctx, id)
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "find user")
+		}
+
+		if !u.Status.CanChangeTo(change.Status) {
+			return ctxd.WrapError(ctx, fmt.Errorf("%w: from %s", ErrInvalidStatusTransition, u.Status),
+				"check status transition")
+		}
+
+		if err := c.changer.ChangeStatus(ctx, id, u.UserStatusChange, change); err != nil {
+			return ctxd.WrapError(ctx, err, "change status")
+		}
+
+		if change.Status.Restricted() {
+			if err := c.revoker.RevokeAll(ctx, id); err != nil {
+				return ctxd.WrapError(ctx, err, "revoke sessions")
+			}
+		}
+
+		if err := c.notifier.NotifyUserStatusChanged(ctx, id, change.Status); err != nil {
+			return ctxd.WrapError(ctx, err, "notify user status changed")
+		}
+
+		if err := auditStatus(ctx, c.auditor, id, u.UserStatusChange, change); err != nil {
+			return ctxd.WrapError(ctx, err, "audit user status changed")
+		}
+
+		u, err = c.finder.FindByID(ctx, id)
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "find user")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "user status changed")
+
+	return u, nil
+}
@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeUserStatus_ChangeUserStatus(t *testing.T) {
+	t.Parallel()
+
+	uID := uuid.New()
+	until := time.Date(2024, 12, 30, 9, 0, 0, 0, time.UTC)
+
+	active := &model.User{ID: uID, UserState: model.UserState{PasswordHash: "hash"}}
+	active.Status = model.StatusActive
+
+	suspend := model.UserStatusChange{
+		Status:          model.StatusSuspended,
+		StatusReason:    "cheating",
+		StatusExpiresAt: &until,
+	}
+
+	suspended := func() *model.User {
+		u := &model.User{ID: uID, UserState: model.UserState{PasswordHash: "hash"}}
+		u.UserStatusChange = suspend
+		u.StatusChangedBy = "admin"
+
+		return u
+	}
+
+	t.Run("suspend", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(active, nil).Once()
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(suspended(), nil).Once()
+
+		recorded := suspend
+		recorded.StatusChangedBy = "admin"
+
+		changer := mocks.NewUserStatusChanger(t)
+		changer.EXPECT().ChangeStatus(mock.Anything, uID, active.UserStatusChange, recorded).Return(nil)
+
+		revoker := mocks.NewSessionsRevoker(t)
+		revoker.EXPECT().RevokeAll(mock.Anything, uID).Return(nil)
+
+		notifier := mocks.NewUserStatusChangedNotifier(t)
+		notifier.EXPECT().NotifyUserStatusChanged(mock.Anything, uID, model.StatusSuspended).Return(nil)
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, &model.AuditEntry{
+			UserID: uID,
+			Action: model.AuditUserSuspended,
+			Actor:  "admin",
+			Changes: map[string]model.AuditChange{
+				"status":            {Before: "active", After: "suspended"},
+				"status_reason":     {After: "cheating"},
+				"status_expires_at": {After: "2024-12-30T09:00:00Z"},
+			},
+		}).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewChangeUserStatus(tx, finder, changer, revoker, notifier, auditor, &ctxd.LoggerMock{})
+
+		u, err := uc.ChangeUserStatus(asAdmin(), uID, suspend)
+		require.NoError(t, err)
+		require.Equal(t, suspended(), u)
+	})
+
+	t.Run("reinstate", func(t *testing.T) {
+		t.Parallel()
+
+		reinstate := model.UserStatusChange{Status: model.StatusActive}
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(suspended(), nil).Once()
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(active, nil).Once()
+
+		changer := mocks.NewUserStatusChanger(t)
+		changer.EXPECT().ChangeStatus(mock.Anything, uID, suspended().UserStatusChange, mock.Anything).Return(nil)
+
+		notifier := mocks.NewUserStatusChangedNotifier(t)
+		notifier.EXPECT().NotifyUserStatusChanged(mock.Anything, uID, model.StatusActive).Return(nil)
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, &model.AuditEntry{
+			UserID: uID,
+			Action: model.AuditUserReinstated,
+			Actor:  "admin",
+			Changes: map[string]model.AuditChange{
+				"status":            {Before: "suspended", After: "active"},
+				"status_reason":     {Before: "cheating"},
+				"status_expires_at": {Before: "2024-12-30T09:00:00Z"},
+			},
+		}).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		// Reinstating does not revoke the sessions.
+		uc := NewChangeUserStatus(tx, finder, changer, mocks.NewSessionsRevoker(t), notifier, auditor, &ctxd.LoggerMock{})
+
+		_, err := uc.ChangeUserStatus(asAdmin(), uID, reinstate)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid transition", func(t *testing.T) {
+		t.Parallel()
+
+		banned := &model.User{ID: uID}
+		banned.Status = model.StatusBanned
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(banned, nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewChangeUserStatus(tx, finder, mocks.NewUserStatusChanger(t), mocks.NewSessionsRevoker(t), mocks.NewUserStatusChangedNotifier(t), mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		_, err := uc.ChangeUserStatus(asAdmin(), uID, suspend)
+		require.ErrorIs(t, err, ErrInvalidStatusTransition)
+	})
+
+	t.Run("expiry of a status not expiring", func(t *testing.T) {
+		t.Parallel()
+
+		uc := NewChangeUserStatus(mocks.NewTransactor(t), mocks.NewUserFinder(t), mocks.NewUserStatusChanger(t), mocks.NewSessionsRevoker(t), mocks.NewUserStatusChangedNotifier(t), mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		_, err := uc.ChangeUserStatus(asAdmin(), uID, model.UserStatusChange{Status: model.StatusActive, StatusExpiresAt: &until})
+		require.ErrorIs(t, err, ErrInvalidStatusTransition)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(nil, database.ErrNotFound)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewChangeUserStatus(tx, finder, mocks.NewUserStatusChanger(t), mocks.NewSessionsRevoker(t), mocks.NewUserStatusChangedNotifier(t), mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		_, err := uc.ChangeUserStatus(asAdmin(), uID, suspend)
+		require.ErrorIs(t, err, database.ErrNotFound)
+	})
+
+	t.Run("error notifier", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(active, nil)
+
+		changer := mocks.NewUserStatusChanger(t)
+		changer.EXPECT().ChangeStatus(mock.Anything, uID, mock.Anything, mock.Anything).Return(nil)
+
+		revoker := mocks.NewSessionsRevoker(t)
+		revoker.EXPECT().RevokeAll(mock.Anything, uID).Return(nil)
+
+		notifier := mocks.NewUserStatusChangedNotifier(t)
+		notifier.EXPECT().NotifyUserStatusChanged(mock.Anything, uID, model.StatusSuspended).Return(assert.AnError)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewChangeUserStatus(tx, finder, changer, revoker, notifier, mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		_, err := uc.ChangeUserStatus(asAdmin(), uID, suspend)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		t.Parallel()
+
+		uc := NewChangeUserStatus(mocks.NewTransactor(t), mocks.NewUserFinder(t), mocks.NewUserStatusChanger(t), mocks.NewSessionsRevoker(t), mocks.NewUserStatusChangedNotifier(t), mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		// Users can not lift their own suspension.
+		_, err := uc.ChangeUserStatus(actingAs(uID, model.RoleUser), uID, model.UserStatusChange{Status: model.StatusActive})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = uc.ChangeUserStatus(actingAs(uuid.New(), model.RoleSupport), uID, suspend)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
@@ -11,7 +11,9 @@ import (
 
 // UserDeleter defines functionality to delete a user from the data layer.
 type UserDeleter interface {
-	DeleteUser(ctx context.Context, id model.UserID) error
+	// DeleteUser deletes the user softly, provided it is at the version, whatever its version when 0. It can be
+	// restored until purged.
+	DeleteUser(ctx context.Context, id model.UserID, version int64) error
 }
 
 //go:generate mockery --name=UserDeletedNotifier --outpkg=mocks --output=mocks --filename=user_deleted_notifier.go --with-expecter
@@ -23,36 +25,79 @@ type UserDeletedNotifier interface {
 
 // DeleteUser is a use case to delete a user.
 type DeleteUser struct {
+	tx       Transactor
 	deleter  UserDeleter
+	finder   UserFinder
+	revoker  SessionsRevoker
 	notifier UserDeletedNotifier
+	auditor  AuditRecorder
 
 	logger ctxd.Logger
 }
 
 // NewDeleteUser creates a new DeleteUser use case.
-func NewDeleteUser(userDeleter UserDeleter, notifier UserDeletedNotifier, logger ctxd.Logger) *DeleteUser {
+//
+// The notifier is called within the same transaction as the deleter, before deleting the user, so the notification
+// can capture the last state of the user. Whether the deletion is notified on delete or on purge is up to the
+// notifier given to DeleteUser and PurgeDeletedUsers. The auditor records the user found before the deletion.
+func NewDeleteUser(tx Transactor, userDeleter UserDeleter, finder UserFinder, revoker SessionsRevoker, notifier UserDeletedNotifier, auditor AuditRecorder, logger ctxd.Logger) *DeleteUser {
 	return &DeleteUser{
+		tx:       tx,
 		deleter:  userDeleter,
+		finder:   finder,
+		revoker:  revoker,
 		notifier: notifier,
+		auditor:  auditor,
 		logger:   logger,
 	}
 }
 
 // DeleteUser executes the delete user use case.
-func (a *DeleteUser) DeleteUser(ctx context.Context, id model.UserID) error {
+//
+// The user is tombstoned, it can be restored within the grace period until purged. Its sessions are revoked, restored
+// users log in again. The user is only deleted when it is at the version, whatever its version when 0, otherwise
+// ErrVersionMismatch is returned.
+func (a *DeleteUser) DeleteUser(ctx context.Context, id model.UserID, version int64) error {
 	ctx = ctxd.AddFields(ctx, "use_case", "DeleteUser", "user_id", id)
 
-	if err := a.deleter.DeleteUser(ctx, id); err != nil {
-		return ctxd.WrapError(ctx, err, "delete user") // error contains the context fields added
+	// Users delete themselves, admins anyone.
+	if _, err := authorizeOnUser(ctx, model.PermissionWriteUsers, model.PermissionWriteOwnUser, id); err != nil {
+		return ctxd.WrapError(ctx, err, "authorize")
 	}
 
-	a.logger.Debug(ctx, "user deleted")
+	err := a.tx.InTx(ctx, func(ctx context.Context) error {
+		before, err := a.finder.FindByID(ctx, id)
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "find user") // error contains the context fields added
+		}
+
+		if version != 0 && before.Version != version {
+			return ctxd.WrapError(ctx, ErrVersionMismatch, "check version", "version", before.Version)
+		}
+
+		if err := a.notifier.NotifyUserDeleted(ctx, id); err != nil {
+			return ctxd.WrapError(ctx, err, "notify user deleted")
+		}
+
+		if err := a.deleter.DeleteUser(ctx, id, version); err != nil {
+			return ctxd.WrapError(ctx, err, "delete user")
+		}
 
-	if err := a.notifier.NotifyUserDeleted(ctx, id); err != nil {
-		return ctxd.WrapError(ctx, err, "notify user deleted")
+		if err := a.revoker.RevokeAll(ctx, id); err != nil {
+			return ctxd.WrapError(ctx, err, "revoke sessions")
+		}
+
+		if err := audit(ctx, a.auditor, id, model.AuditUserDeleted, before.UserState, model.UserState{}); err != nil {
+			return ctxd.WrapError(ctx, err, "audit user deleted")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	a.logger.Debug(ctx, "user deleted notification sent", "user_id", id)
+	a.logger.Debug(ctx, "user deleted")
 
 	return nil
 }
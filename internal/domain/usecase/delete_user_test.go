@@ -1,10 +1,10 @@
 package usecase
 
 import (
-	"context"
 	"testing"
 
 	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
 	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -21,16 +21,33 @@ func TestDeleteUser_DeleteUser(t *testing.T) {
 		t.Parallel()
 
 		deleter := mocks.NewUserDeleter(t)
-		deleter.EXPECT().DeleteUser(mock.Anything, uID).Return(nil)
+		deleter.EXPECT().DeleteUser(mock.Anything, uID, int64(0)).Return(nil)
+
+		revoker := mocks.NewSessionsRevoker(t)
+		revoker.EXPECT().RevokeAll(mock.Anything, uID).Return(nil)
 
 		notifier := mocks.NewUserDeletedNotifier(t)
 		notifier.EXPECT().NotifyUserDeleted(mock.Anything, uID).Return(nil)
 
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID, UserState: model.UserState{Email: "alice@bob.com"}}, nil)
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, &model.AuditEntry{
+			UserID:  uID,
+			Action:  model.AuditUserDeleted,
+			Actor:   "admin",
+			Changes: map[string]model.AuditChange{"email": {Before: "alice@bob.com"}},
+		}).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
 		logger := &ctxd.LoggerMock{}
 
-		uc := NewDeleteUser(deleter, notifier, logger)
+		uc := NewDeleteUser(tx, deleter, finder, revoker, notifier, auditor, logger)
 
-		err := uc.DeleteUser(context.Background(), uID)
+		err := uc.DeleteUser(asAdmin(), uID, 0)
 		require.NoError(t, err)
 	})
 
@@ -38,15 +55,22 @@ func TestDeleteUser_DeleteUser(t *testing.T) {
 		t.Parallel()
 
 		deleter := mocks.NewUserDeleter(t)
-		deleter.EXPECT().DeleteUser(mock.Anything, uID).Return(assert.AnError)
+		deleter.EXPECT().DeleteUser(mock.Anything, uID, int64(0)).Return(assert.AnError)
 
 		notifier := mocks.NewUserDeletedNotifier(t)
+		notifier.EXPECT().NotifyUserDeleted(mock.Anything, uID).Return(nil)
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID}, nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
 
 		logger := &ctxd.LoggerMock{}
 
-		uc := NewDeleteUser(deleter, notifier, logger)
+		uc := NewDeleteUser(tx, deleter, finder, mocks.NewSessionsRevoker(t), notifier, mocks.NewAuditRecorder(t), logger)
 
-		err := uc.DeleteUser(context.Background(), uID)
+		err := uc.DeleteUser(asAdmin(), uID, 0)
 		require.Error(t, err)
 		require.ErrorIs(t, err, assert.AnError)
 	})
@@ -55,17 +79,46 @@ func TestDeleteUser_DeleteUser(t *testing.T) {
 		t.Parallel()
 
 		deleter := mocks.NewUserDeleter(t)
-		deleter.EXPECT().DeleteUser(mock.Anything, uID).Return(nil)
 
 		notifier := mocks.NewUserDeletedNotifier(t)
 		notifier.EXPECT().NotifyUserDeleted(mock.Anything, uID).Return(assert.AnError)
 
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID}, nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
 		logger := &ctxd.LoggerMock{}
 
-		uc := NewDeleteUser(deleter, notifier, logger)
+		uc := NewDeleteUser(tx, deleter, finder, mocks.NewSessionsRevoker(t), notifier, mocks.NewAuditRecorder(t), logger)
 
-		err := uc.DeleteUser(context.Background(), uID)
+		err := uc.DeleteUser(asAdmin(), uID, 0)
 		require.Error(t, err)
 		require.ErrorIs(t, err, assert.AnError)
 	})
+
+	t.Run("version mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID, Version: 4}, nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewDeleteUser(tx, mocks.NewUserDeleter(t), finder, mocks.NewSessionsRevoker(t), mocks.NewUserDeletedNotifier(t), mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		err := uc.DeleteUser(asAdmin(), uID, 3)
+		require.ErrorIs(t, err, ErrVersionMismatch)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		t.Parallel()
+
+		uc := NewDeleteUser(mocks.NewTransactor(t), mocks.NewUserDeleter(t), mocks.NewUserFinder(t), mocks.NewSessionsRevoker(t), mocks.NewUserDeletedNotifier(t), mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		err := uc.DeleteUser(actingAs(uuid.New(), model.RoleUser), uID, 0)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
 }
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+//go:generate mockery --name=PersonalDataEraser --outpkg=mocks --output=mocks --filename=personal_data_eraser.go --with-expecter
+
+// PersonalDataEraser defines functionality to erase the personal data of a user from the data layer.
+type PersonalDataEraser interface {
+	// ErasePersonalData irreversibly erases the personal data of the user, deleted or not, keeping its id.
+	//
+	// Returns database.ErrNotFound when the user is not found or was already erased.
+	ErasePersonalData(ctx context.Context, id model.UserID) (*model.Erasure, error)
+}
+
+//go:generate mockery --name=ErasureCertifier --outpkg=mocks --output=mocks --filename=erasure_certifier.go --with-expecter
+
+// ErasureCertifier defines functionality to store the erasure certificates.
+type ErasureCertifier interface {
+	// AddErasureCertificate stores the erasure certificate, setting its id and the time of the erasure.
+	AddErasureCertificate(ctx context.Context, c *model.ErasureCertificate) error
+}
+
+// EraseUser is a use case to erase the personal data of a user.
+type EraseUser struct {
+	tx            Transactor
+	eraser        PersonalDataEraser
+	certifier     ErasureCertifier
+	notifier      UserDeletedNotifier
+	purgeNotifier UserDeletedNotifier
+	auditor       AuditRecorder
+
+	logger ctxd.Logger
+}
+
+// NewEraseUser creates a new EraseUser use case.
+//
+// An erased user is deleted and never purged. The notifier notifies the deletion of the users erased while not
+// deleted, the purgeNotifier the deletion of the users erased once deleted, as PurgeDeletedUsers would have. The
+// erasure is audited without changes, they would hold the personal data erased.
+func NewEraseUser(tx Transactor, eraser PersonalDataEraser, certifier ErasureCertifier, notifier, purgeNotifier UserDeletedNotifier, auditor AuditRecorder, logger ctxd.Logger) *EraseUser {
+	return &EraseUser{
+		tx:            tx,
+		eraser:        eraser,
+		certifier:     certifier,
+		notifier:      notifier,
+		purgeNotifier: purgeNotifier,
+		auditor:       auditor,
+		logger:        logger,
+	}
+}
+
+// EraseUser executes the erase user use case, returning the certificate of the erasure.
+//
+// The personal data of the user is erased irreversibly, the user is kept under its id so the records referencing it
+// stay consistent. Deleted users are erased too, as long as they are not purged.
+func (e *EraseUser) EraseUser(ctx context.Context, id model.UserID) (*model.ErasureCertificate, error) {
+	ctx = ctxd.AddFields(ctx, "use_case", "EraseUser", "user_id", id)
+
+	// Users erase themselves, admins anyone.
+	actor, err := authorizeOnUser(ctx, model.PermissionWriteUsers, model.PermissionWriteOwnUser, id)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "authorize")
+	}
+
+	var c *model.ErasureCertificate
+
+	err = e.tx.InTx(ctx, func(ctx context.Context) error {
+		erasure, err := e.eraser.ErasePersonalData(ctx, id)
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "erase personal data")
+		}
+
+		notifier := e.notifier
+		if erasure.Deleted {
+			notifier = e.purgeNotifier
+		}
+
+		if err := notifier.NotifyUserDeleted(ctx, id); err != nil {
+			return ctxd.WrapError(ctx, err, "notify user deleted")
+		}
+
+		c = &model.ErasureCertificate{
+			UserID:   id,
+			ErasedBy: actor.Subject,
+			Records:  erasure.Records,
+		}
+
+		if err := e.certifier.AddErasureCertificate(ctx, c); err != nil {
+			return ctxd.WrapError(ctx, err, "add erasure certificate")
+		}
+
+		if err := audit(ctx, e.auditor, id, model.AuditUserErased, model.UserState{}, model.UserState{}); err != nil {
+			return ctxd.WrapError(ctx, err, "audit user erased")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	e.logger.Debug(ctx, "user erased", "certificate_id", c.ID)
+
+	return c, nil
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEraseUser_EraseUser(t *testing.T) {
+	t.Parallel()
+
+	uID := uuid.New()
+	certID := uuid.New()
+	records := map[string]int64{"users": 1}
+
+	certify := func(_ context.Context, c *model.ErasureCertificate) error {
+		c.ID = certID
+
+		return nil
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		eraser := mocks.NewPersonalDataEraser(t)
+		eraser.EXPECT().ErasePersonalData(mock.Anything, uID).Return(&model.Erasure{Records: records}, nil)
+
+		notifier := mocks.NewUserDeletedNotifier(t)
+		notifier.EXPECT().NotifyUserDeleted(mock.Anything, uID).Return(nil)
+
+		certifier := mocks.NewErasureCertifier(t)
+		certifier.EXPECT().AddErasureCertificate(mock.Anything, &model.ErasureCertificate{
+			UserID:   uID,
+			ErasedBy: "admin",
+			Records:  records,
+		}).RunAndReturn(certify)
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, &model.AuditEntry{
+			UserID:  uID,
+			Action:  model.AuditUserErased,
+			Actor:   "admin",
+			Changes: map[string]model.AuditChange{},
+		}).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewEraseUser(tx, eraser, certifier, notifier, mocks.NewUserDeletedNotifier(t), auditor, &ctxd.LoggerMock{})
+
+		c, err := uc.EraseUser(asAdmin(), uID)
+		require.NoError(t, err)
+		require.Equal(t, certID, c.ID)
+		require.Equal(t, uID, c.UserID)
+	})
+
+	t.Run("success deleted user", func(t *testing.T) {
+		t.Parallel()
+
+		eraser := mocks.NewPersonalDataEraser(t)
+		eraser.EXPECT().ErasePersonalData(mock.Anything, uID).Return(&model.Erasure{Deleted: true, Records: records}, nil)
+
+		purgeNotifier := mocks.NewUserDeletedNotifier(t)
+		purgeNotifier.EXPECT().NotifyUserDeleted(mock.Anything, uID).Return(nil)
+
+		certifier := mocks.NewErasureCertifier(t)
+		certifier.EXPECT().AddErasureCertificate(mock.Anything, mock.Anything).RunAndReturn(certify)
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, mock.Anything).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewEraseUser(tx, eraser, certifier, mocks.NewUserDeletedNotifier(t), purgeNotifier, auditor, &ctxd.LoggerMock{})
+
+		c, err := uc.EraseUser(actingAs(uID, model.RoleUser), uID)
+		require.NoError(t, err)
+		require.Equal(t, uID.String(), c.ErasedBy)
+	})
+
+	t.Run("error eraser", func(t *testing.T) {
+		t.Parallel()
+
+		eraser := mocks.NewPersonalDataEraser(t)
+		eraser.EXPECT().ErasePersonalData(mock.Anything, uID).Return(nil, assert.AnError)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewEraseUser(tx, eraser, mocks.NewErasureCertifier(t), mocks.NewUserDeletedNotifier(t), mocks.NewUserDeletedNotifier(t), mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		_, err := uc.EraseUser(asAdmin(), uID)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("error certifier", func(t *testing.T) {
+		t.Parallel()
+
+		eraser := mocks.NewPersonalDataEraser(t)
+		eraser.EXPECT().ErasePersonalData(mock.Anything, uID).Return(&model.Erasure{Records: records}, nil)
+
+		notifier := mocks.NewUserDeletedNotifier(t)
+		notifier.EXPECT().NotifyUserDeleted(mock.Anything, uID).Return(nil)
+
+		certifier := mocks.NewErasureCertifier(t)
+		certifier.EXPECT().AddErasureCertificate(mock.Anything, mock.Anything).Return(assert.AnError)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewEraseUser(tx, eraser, certifier, notifier, mocks.NewUserDeletedNotifier(t), mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		_, err := uc.EraseUser(asAdmin(), uID)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		t.Parallel()
+
+		uc := NewEraseUser(mocks.NewTransactor(t), mocks.NewPersonalDataEraser(t), mocks.NewErasureCertifier(t), mocks.NewUserDeletedNotifier(t), mocks.NewUserDeletedNotifier(t), mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		_, err := uc.EraseUser(actingAs(uuid.New(), model.RoleSupport), uID)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+//go:generate mockery --name=PersonalDataExporter --outpkg=mocks --output=mocks --filename=personal_data_exporter.go --with-expecter
+
+// PersonalDataExporter defines functionality to gather the personal data of a user from the data layer.
+type PersonalDataExporter interface {
+	// ExportPersonalData gathers every record stored about the user, deleted or not, database.ErrNotFound otherwise.
+	ExportPersonalData(ctx context.Context, id model.UserID) (*model.PersonalData, error)
+}
+
+// ExportUserData is a use case to export the personal data of a user.
+type ExportUserData struct {
+	exporter PersonalDataExporter
+
+	logger ctxd.Logger
+}
+
+// NewExportUserData creates a new ExportUserData use case.
+func NewExportUserData(exporter PersonalDataExporter, logger ctxd.Logger) *ExportUserData {
+	return &ExportUserData{
+		exporter: exporter,
+		logger:   logger,
+	}
+}
+
+// ExportUserData executes the export user data use case, returning every record stored about the user.
+//
+// Deleted users are exported too, as long as they are not purged. Secrets are never exported.
+func (e *ExportUserData) ExportUserData(ctx context.Context, id model.UserID) (*model.PersonalData, error) {
+	ctx = ctxd.AddFields(ctx, "use_case", "ExportUserData", "user_id", id)
+
+	// Users export their own data, admins and support anyone's.
+	if _, err := authorizeOnUser(ctx, model.PermissionReadUsers, model.PermissionReadOwnUser, id); err != nil {
+		return nil, ctxd.WrapError(ctx, err, "authorize")
+	}
+
+	data, err := e.exporter.ExportPersonalData(ctx, id)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "export personal data")
+	}
+
+	e.logger.Debug(ctx, "user data exported")
+
+	return data, nil
+}
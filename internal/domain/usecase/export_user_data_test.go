@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportUserData_ExportUserData(t *testing.T) {
+	t.Parallel()
+
+	uID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		data := &model.PersonalData{User: model.User{ID: uID}}
+
+		exporter := mocks.NewPersonalDataExporter(t)
+		exporter.EXPECT().ExportPersonalData(mock.Anything, uID).Return(data, nil)
+
+		uc := NewExportUserData(exporter, &ctxd.LoggerMock{})
+
+		got, err := uc.ExportUserData(actingAs(uID, model.RoleUser), uID)
+		require.NoError(t, err)
+		require.Equal(t, data, got)
+	})
+
+	t.Run("error exporter", func(t *testing.T) {
+		t.Parallel()
+
+		exporter := mocks.NewPersonalDataExporter(t)
+		exporter.EXPECT().ExportPersonalData(mock.Anything, uID).Return(nil, assert.AnError)
+
+		uc := NewExportUserData(exporter, &ctxd.LoggerMock{})
+
+		_, err := uc.ExportUserData(asAdmin(), uID)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		t.Parallel()
+
+		uc := NewExportUserData(mocks.NewPersonalDataExporter(t), &ctxd.LoggerMock{})
+
+		_, err := uc.ExportUserData(actingAs(uuid.New(), model.RoleUser), uID)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+//go:generate mockery --name=UserFinder --outpkg=mocks --output=mocks --filename=user_finder.go --with-expecter
+
+// UserFinder defines functionality to find a user by id.
+type UserFinder interface {
+	FindByID(ctx context.Context, id model.UserID) (*model.User, error)
+}
+
+// GetUser is a use case to get a user by id.
+type GetUser struct {
+	finder UserFinder
+
+	logger ctxd.Logger
+}
+
+// NewGetUser creates a new GetUser use case.
+func NewGetUser(finder UserFinder, logger ctxd.Logger) *GetUser {
+	return &GetUser{
+		finder: finder,
+		logger: logger,
+	}
+}
+
+// GetUser executes the get user use case.
+func (g *GetUser) GetUser(ctx context.Context, id model.UserID) (*model.User, error) {
+	ctx = ctxd.AddFields(ctx, "use_case", "GetUser", "id", id)
+
+	if _, err := authorizeOnUser(ctx, model.PermissionReadUsers, model.PermissionReadOwnUser, id); err != nil {
+		return nil, ctxd.WrapError(ctx, err, "authorize")
+	}
+
+	user, err := g.finder.FindByID(ctx, id)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "get user") // error contains the context fields added
+	}
+
+	g.logger.Debug(ctx, "user found")
+
+	return user, nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUser_GetUser(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, id).Return(&model.User{ID: id}, nil)
+
+		logger := &ctxd.LoggerMock{}
+
+		uc := NewGetUser(finder, logger)
+
+		user, err := uc.GetUser(asAdmin(), id)
+		require.NoError(t, err)
+
+		require.Equal(t, id, user.ID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, id).Return(nil, database.ErrNotFound)
+
+		logger := &ctxd.LoggerMock{}
+
+		uc := NewGetUser(finder, logger)
+
+		user, err := uc.GetUser(asAdmin(), id)
+		require.Error(t, err)
+		require.Nil(t, user)
+		require.ErrorIs(t, err, database.ErrNotFound)
+	})
+
+	t.Run("support", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, id).Return(&model.User{ID: id}, nil)
+
+		uc := NewGetUser(finder, &ctxd.LoggerMock{})
+
+		user, err := uc.GetUser(actingAs(uuid.New(), model.RoleSupport), id)
+		require.NoError(t, err)
+		require.Equal(t, id, user.ID)
+	})
+
+	t.Run("self", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, id).Return(&model.User{ID: id}, nil)
+
+		uc := NewGetUser(finder, &ctxd.LoggerMock{})
+
+		_, err := uc.GetUser(actingAs(id, model.RoleUser), id)
+		require.NoError(t, err)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		t.Parallel()
+
+		uc := NewGetUser(mocks.NewUserFinder(t), &ctxd.LoggerMock{})
+
+		_, err := uc.GetUser(actingAs(uuid.New(), model.RoleUser), id)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+//go:generate mockery --name=ExpiredStatusLister --outpkg=mocks --output=mocks --filename=expired_status_lister.go --with-expecter
+
+// ExpiredStatusLister defines functionality to list the users whose suspension or ban expired.
+type ExpiredStatusLister interface {
+	// ListExpiredStatuses lists the suspended and banned users whose status expired at the given time.
+	ListExpiredStatuses(ctx context.Context, at time.Time, limit uint64) ([]*model.User, error)
+}
+
+// LiftExpiredStatuses is a use case to reinstate the users whose suspension or ban expired.
+type LiftExpiredStatuses struct {
+	tx       Transactor
+	lister   ExpiredStatusLister
+	changer  UserStatusChanger
+	notifier UserStatusChangedNotifier
+	auditor  AuditRecorder
+
+	logger ctxd.Logger
+}
+
+// NewLiftExpiredStatuses creates a new LiftExpiredStatuses use case.
+func NewLiftExpiredStatuses(tx Transactor, lister ExpiredStatusLister, changer UserStatusChanger, notifier UserStatusChangedNotifier, auditor AuditRecorder, logger ctxd.Logger) *LiftExpiredStatuses {
+	return &LiftExpiredStatuses{
+		tx:       tx,
+		lister:   lister,
+		changer:  changer,
+		notifier: notifier,
+		auditor:  auditor,
+		logger:   logger,
+	}
+}
+
+// LiftExpiredStatuses executes the lift expired statuses use case, returning the number of users reinstated.
+//
+// Up to limit users expired at the given time are reinstated, each within its own transaction. It runs on behalf of the
+// service, no actor is required, the changes are audited as performed by the system. A user whose status changed in
+// between, e.g. by another instance lifting it first, is skipped.
+func (l *LiftExpiredStatuses) LiftExpiredStatuses(ctx context.Context, at time.Time, limit uint64) (int, error) {
+	ctx = ctxd.AddFields(ctx, "use_case", "LiftExpiredStatuses")
+
+	users, err := l.lister.ListExpiredStatuses(ctx, at, limit)
+	if err != nil {
+		return 0, ctxd.WrapError(ctx, err, "list expired statuses")
+	}
+
+	var lifted int
+
+	for _, u := range users {
+		ctx := ctxd.AddFields(ctx, "user_id", u.ID, "status", u.Status)
+
+		change := model.UserStatusChange{
+			Status:          model.StatusActive,
+			StatusReason:    string(u.Status) + " expired",
+			StatusChangedBy: systemActor,
+		}
+
+		err := l.tx.InTx(ctx, func(ctx context.Context) error {
+			if err := l.changer.ChangeStatus(ctx, u.ID, u.UserStatusChange, change); err != nil {
+				return ctxd.WrapError(ctx, err, "change status")
+			}
+
+			if err := l.notifier.NotifyUserStatusChanged(ctx, u.ID, change.Status); err != nil {
+				return ctxd.WrapError(ctx, err, "notify user status changed")
+			}
+
+			if err := auditStatus(ctx, l.auditor, u.ID, u.UserStatusChange, change); err != nil {
+				return ctxd.WrapError(ctx, err, "audit user status changed")
+			}
+
+			return nil
+		})
+		if err != nil {
+			if errors.Is(err, ErrInvalidStatusTransition) {
+				l.logger.Debug(ctx, "expired status changed in between, skipped")
+
+				continue
+			}
+
+			return lifted, err
+		}
+
+		lifted++
+
+		l.logger.Debug(ctx, "expired status lifted")
+	}
+
+	return lifted, nil
+}
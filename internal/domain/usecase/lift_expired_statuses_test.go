@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLiftExpiredStatuses_LiftExpiredStatuses(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 12, 23, 9, 0, 0, 0, time.UTC)
+	expired := now.Add(-time.Minute)
+
+	newUser := func(status model.UserStatus) *model.User {
+		u := &model.User{ID: uuid.New()}
+		u.Status = status
+		u.StatusExpiresAt = &expired
+
+		return u
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		suspended := newUser(model.StatusSuspended)
+		banned := newUser(model.StatusBanned)
+
+		lister := mocks.NewExpiredStatusLister(t)
+		lister.EXPECT().ListExpiredStatuses(mock.Anything, now, uint64(10)).Return([]*model.User{suspended, banned}, nil)
+
+		changer := mocks.NewUserStatusChanger(t)
+		changer.EXPECT().ChangeStatus(mock.Anything, suspended.ID, suspended.UserStatusChange, model.UserStatusChange{
+			Status:          model.StatusActive,
+			StatusReason:    "suspended expired",
+			StatusChangedBy: "system",
+		}).Return(nil)
+		changer.EXPECT().ChangeStatus(mock.Anything, banned.ID, banned.UserStatusChange, model.UserStatusChange{
+			Status:          model.StatusActive,
+			StatusReason:    "banned expired",
+			StatusChangedBy: "system",
+		}).Return(nil)
+
+		notifier := mocks.NewUserStatusChangedNotifier(t)
+		notifier.EXPECT().NotifyUserStatusChanged(mock.Anything, suspended.ID, model.StatusActive).Return(nil)
+		notifier.EXPECT().NotifyUserStatusChanged(mock.Anything, banned.ID, model.StatusActive).Return(nil)
+
+		// Audited as performed by the system.
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, &model.AuditEntry{
+			UserID: suspended.ID,
+			Action: model.AuditUserReinstated,
+			Actor:  "system",
+			Changes: map[string]model.AuditChange{
+				"status":            {Before: "suspended", After: "active"},
+				"status_reason":     {After: "suspended expired"},
+				"status_expires_at": {Before: "2024-12-23T08:59:00Z"},
+			},
+		}).Return(nil)
+		auditor.EXPECT().RecordAudit(mock.Anything, &model.AuditEntry{
+			UserID: banned.ID,
+			Action: model.AuditUserReinstated,
+			Actor:  "system",
+			Changes: map[string]model.AuditChange{
+				"status":            {Before: "banned", After: "active"},
+				"status_reason":     {After: "banned expired"},
+				"status_expires_at": {Before: "2024-12-23T08:59:00Z"},
+			},
+		}).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewLiftExpiredStatuses(tx, lister, changer, notifier, auditor, &ctxd.LoggerMock{})
+
+		lifted, err := uc.LiftExpiredStatuses(context.Background(), now, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, lifted)
+	})
+
+	t.Run("changed in between", func(t *testing.T) {
+		t.Parallel()
+
+		suspended := newUser(model.StatusSuspended)
+
+		lister := mocks.NewExpiredStatusLister(t)
+		lister.EXPECT().ListExpiredStatuses(mock.Anything, now, uint64(10)).Return([]*model.User{suspended}, nil)
+
+		changer := mocks.NewUserStatusChanger(t)
+		changer.EXPECT().ChangeStatus(mock.Anything, suspended.ID, mock.Anything, mock.Anything).
+			Return(fmt.Errorf("%w: status changed concurrently", ErrInvalidStatusTransition))
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewLiftExpiredStatuses(tx, lister, changer, mocks.NewUserStatusChangedNotifier(t), mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		lifted, err := uc.LiftExpiredStatuses(context.Background(), now, 10)
+		require.NoError(t, err)
+		require.Zero(t, lifted)
+	})
+
+	t.Run("error lister", func(t *testing.T) {
+		t.Parallel()
+
+		lister := mocks.NewExpiredStatusLister(t)
+		lister.EXPECT().ListExpiredStatuses(mock.Anything, now, uint64(10)).Return(nil, assert.AnError)
+
+		uc := NewLiftExpiredStatuses(mocks.NewTransactor(t), lister, mocks.NewUserStatusChanger(t), mocks.NewUserStatusChangedNotifier(t), mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		_, err := uc.LiftExpiredStatuses(context.Background(), now, 10)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+//go:generate mockery --name=AuditLister --outpkg=mocks --output=mocks --filename=audit_lister.go --with-expecter
+
+// AuditLister defines functionality to list the audit entries.
+type AuditLister interface {
+	// ListAuditEntries lists the page of audit entries matching the filter, the latest first, after the cursor, from
+	// the latest entry when nil.
+	ListAuditEntries(ctx context.Context, f model.AuditFilter, limit uint64, after model.Cursor) (model.AuditPage, error)
+}
+
+// ListUserAuditEvents is a use case to list the audit log of the user mutations.
+type ListUserAuditEvents struct {
+	lister AuditLister
+
+	logger ctxd.Logger
+}
+
+// NewListUserAuditEvents creates a new ListUserAuditEvents use case.
+func NewListUserAuditEvents(lister AuditLister, logger ctxd.Logger) *ListUserAuditEvents {
+	return &ListUserAuditEvents{
+		lister: lister,
+		logger: logger,
+	}
+}
+
+// ListUserAuditEvents executes the list user audit events use case, the latest first.
+func (l *ListUserAuditEvents) ListUserAuditEvents(ctx context.Context, f model.AuditFilter, limit uint64, after model.Cursor) (model.AuditPage, error) {
+	ctx = ctxd.AddFields(ctx, "use_case", "ListUserAuditEvents", "user_id", f.UserID, "actor", f.Actor)
+
+	if _, err := authorize(ctx, model.PermissionReadAudit); err != nil {
+		return model.AuditPage{}, ctxd.WrapError(ctx, err, "authorize")
+	}
+
+	page, err := l.lister.ListAuditEntries(ctx, f, limit, after)
+	if err != nil {
+		return model.AuditPage{}, ctxd.WrapError(ctx, err, "list audit entries") // error contains the context fields added
+	}
+
+	l.logger.Debug(ctx, "user audit events list")
+
+	return page, nil
+}
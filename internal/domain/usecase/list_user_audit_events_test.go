@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListUserAuditEvents_ListUserAuditEvents(t *testing.T) {
+	t.Parallel()
+
+	f := model.AuditFilter{
+		UserID: uuid.New(),
+		Actor:  "admin",
+		Since:  time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		lister := mocks.NewAuditLister(t)
+		lister.EXPECT().ListAuditEntries(mock.Anything, f, uint64(100), model.Cursor(nil)).Return(model.AuditPage{
+			Entries: []*model.AuditEntry{
+				{ID: 2, UserID: f.UserID, Action: model.AuditUserUpdated},
+				{ID: 1, UserID: f.UserID, Action: model.AuditUserAdded},
+			},
+			Next: model.Cursor{"1"},
+		}, nil)
+
+		uc := NewListUserAuditEvents(lister, &ctxd.LoggerMock{})
+
+		page, err := uc.ListUserAuditEvents(asAdmin(), f, 100, nil)
+		require.NoError(t, err)
+
+		require.Len(t, page.Entries, 2)
+		require.Equal(t, model.Cursor{"1"}, page.Next)
+	})
+
+	t.Run("error lister", func(t *testing.T) {
+		t.Parallel()
+
+		lister := mocks.NewAuditLister(t)
+		lister.EXPECT().ListAuditEntries(mock.Anything, f, uint64(100), model.Cursor(nil)).Return(model.AuditPage{}, assert.AnError)
+
+		uc := NewListUserAuditEvents(lister, &ctxd.LoggerMock{})
+
+		_, err := uc.ListUserAuditEvents(asAdmin(), f, 100, nil)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		t.Parallel()
+
+		uc := NewListUserAuditEvents(mocks.NewAuditLister(t), &ctxd.LoggerMock{})
+
+		_, err := uc.ListUserAuditEvents(actingAs(uuid.New(), model.RoleSupport), f, 100, nil)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
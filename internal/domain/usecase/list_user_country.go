@@ -11,7 +11,8 @@ import (
 
 // UserByCountryFinder defines functionality to list users by country.
 type UserByCountryFinder interface {
-	ListByCountry(ctx context.Context, country string, limit, offset uint64) ([]*model.User, error)
+	// ListByCountry lists the page of users of the country after the cursor, from the first user when nil.
+	ListByCountry(ctx context.Context, country string, limit uint64, after model.Cursor) (model.UserPage, error)
 }
 
 // ListUsersByCountry is a use case to list users by country.
@@ -30,15 +31,19 @@ func NewListUsersByCountry(finder UserByCountryFinder, logger ctxd.Logger) *List
 }
 
 // ListUsersByCountry executes the list user by country use case.
-func (l *ListUsersByCountry) ListUsersByCountry(ctx context.Context, country string, limit, offset uint64) ([]*model.User, error) {
+func (l *ListUsersByCountry) ListUsersByCountry(ctx context.Context, country string, limit uint64, after model.Cursor) (model.UserPage, error) {
 	ctx = ctxd.AddFields(ctx, "use_case", "ListUsersByCountry", "country", country)
 
-	users, err := l.finder.ListByCountry(ctx, country, limit, offset)
+	if _, err := authorize(ctx, model.PermissionReadUsers); err != nil {
+		return model.UserPage{}, ctxd.WrapError(ctx, err, "authorize")
+	}
+
+	page, err := l.finder.ListByCountry(ctx, country, limit, after)
 	if err != nil {
-		return nil, ctxd.WrapError(ctx, err, "list user by country") // error contains the context fields added
+		return model.UserPage{}, ctxd.WrapError(ctx, err, "list user by country") // error contains the context fields added
 	}
 
 	l.logger.Debug(ctx, "user list by country")
 
-	return users, nil
+	return page, nil
 }
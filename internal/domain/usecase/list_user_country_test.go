@@ -1,7 +1,6 @@
 package usecase
 
 import (
-	"context"
 	"testing"
 
 	"github.com/bool64/ctxd"
@@ -16,42 +15,48 @@ import (
 func TestListUserByCountry_ListByCountry(t *testing.T) {
 	t.Parallel()
 
+	after := model.Cursor{"2024-01-01T10:00:00Z", "26ef0140-c436-4838-a271-32652c72f6f2"}
+
 	t.Run("success", func(t *testing.T) {
 		t.Parallel()
 
 		finder := mocks.NewUserByCountryFinder(t)
-		finder.EXPECT().ListByCountry(mock.Anything, "UK", uint64(100), uint64(0)).Return([]*model.User{
-			{
-				ID: uuid.New(),
-			},
-			{
-				ID: uuid.New(),
+		finder.EXPECT().ListByCountry(mock.Anything, "UK", uint64(2), after).Return(model.UserPage{
+			Users: []*model.User{
+				{
+					ID: uuid.New(),
+				},
+				{
+					ID: uuid.New(),
+				},
 			},
+			Next: model.Cursor{"2024-01-02T10:00:00Z", "29d7fe1d-6d03-4c52-9880-d39788f9c227"},
 		}, nil)
 
 		logger := &ctxd.LoggerMock{}
 
 		uc := NewListUsersByCountry(finder, logger)
 
-		users, err := uc.ListUsersByCountry(context.Background(), "UK", 100, 0)
+		page, err := uc.ListUsersByCountry(asAdmin(), "UK", 2, after)
 		require.NoError(t, err)
 
-		require.Len(t, users, 2)
+		require.Len(t, page.Users, 2)
+		require.Equal(t, model.Cursor{"2024-01-02T10:00:00Z", "29d7fe1d-6d03-4c52-9880-d39788f9c227"}, page.Next)
 	})
 
 	t.Run("error", func(t *testing.T) {
 		t.Parallel()
 
 		finder := mocks.NewUserByCountryFinder(t)
-		finder.EXPECT().ListByCountry(mock.Anything, "UK", uint64(100), uint64(0)).Return(nil, assert.AnError)
+		finder.EXPECT().ListByCountry(mock.Anything, "UK", uint64(100), model.Cursor(nil)).Return(model.UserPage{}, assert.AnError)
 
 		logger := &ctxd.LoggerMock{}
 
 		uc := NewListUsersByCountry(finder, logger)
 
-		users, err := uc.ListUsersByCountry(context.Background(), "UK", 100, 0)
+		page, err := uc.ListUsersByCountry(asAdmin(), "UK", 100, nil)
 		require.Error(t, err)
-		require.Nil(t, users)
+		require.Empty(t, page.Users)
 		require.ErrorIs(t, err, assert.AnError)
 	})
 }
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+// ErrInvalidFilter occurs when the filter of the users is malformed or refers to unknown fields.
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// ErrInvalidOrderBy occurs when the order of the users is malformed or refers to unknown fields.
+var ErrInvalidOrderBy = errors.New("invalid order by")
+
+// ErrInvalidPageToken occurs when the page token is malformed, forged or provided by a call with other parameters.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+//go:generate mockery --name=UserLister --outpkg=mocks --output=mocks --filename=user_lister.go --with-expecter
+
+// UserLister defines functionality to list users.
+type UserLister interface {
+	// List lists the page of users matching the AIP-160 filter, sorted by the AIP-132 order by, after the cursor,
+	// from the first user when nil.
+	List(ctx context.Context, filter, orderBy string, limit uint64, after model.Cursor) (model.UserPage, error)
+}
+
+// ListUsers is a use case to list users.
+type ListUsers struct {
+	lister UserLister
+
+	logger ctxd.Logger
+}
+
+// NewListUsers creates a new ListUsers use case.
+func NewListUsers(lister UserLister, logger ctxd.Logger) *ListUsers {
+	return &ListUsers{
+		lister: lister,
+		logger: logger,
+	}
+}
+
+// ListUsers executes the list users use case.
+func (l *ListUsers) ListUsers(ctx context.Context, filter, orderBy string, limit uint64, after model.Cursor) (model.UserPage, error) {
+	ctx = ctxd.AddFields(ctx, "use_case", "ListUsers", "filter", filter, "order_by", orderBy)
+
+	if _, err := authorize(ctx, model.PermissionReadUsers); err != nil {
+		return model.UserPage{}, ctxd.WrapError(ctx, err, "authorize")
+	}
+
+	page, err := l.lister.List(ctx, filter, orderBy, limit, after)
+	if err != nil {
+		return model.UserPage{}, ctxd.WrapError(ctx, err, "list users") // error contains the context fields added
+	}
+
+	l.logger.Debug(ctx, "user list")
+
+	return page, nil
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListUsers_ListUsers(t *testing.T) {
+	t.Parallel()
+
+	filter := `country = "UK"`
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		lister := mocks.NewUserLister(t)
+		lister.EXPECT().List(mock.Anything, filter, "created_at desc", uint64(100), model.Cursor(nil)).Return(model.UserPage{
+			Users: []*model.User{
+				{
+					ID: uuid.New(),
+				},
+				{
+					ID: uuid.New(),
+				},
+			},
+		}, nil)
+
+		logger := &ctxd.LoggerMock{}
+
+		uc := NewListUsers(lister, logger)
+
+		page, err := uc.ListUsers(asAdmin(), filter, "created_at desc", 100, nil)
+		require.NoError(t, err)
+
+		require.Len(t, page.Users, 2)
+		require.Nil(t, page.Next)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		t.Parallel()
+
+		lister := mocks.NewUserLister(t)
+		lister.EXPECT().List(mock.Anything, filter, "", uint64(100), model.Cursor(nil)).Return(model.UserPage{}, ErrInvalidFilter)
+
+		logger := &ctxd.LoggerMock{}
+
+		uc := NewListUsers(lister, logger)
+
+		page, err := uc.ListUsers(asAdmin(), filter, "", 100, nil)
+		require.Error(t, err)
+		require.Empty(t, page.Users)
+		require.ErrorIs(t, err, ErrInvalidFilter)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		t.Parallel()
+
+		uc := NewListUsers(mocks.NewUserLister(t), &ctxd.LoggerMock{})
+
+		_, err := uc.ListUsers(actingAs(uuid.New(), model.RoleUser), "", "", 100, nil)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
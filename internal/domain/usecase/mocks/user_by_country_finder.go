@@ -22,29 +22,27 @@ func (_m *UserByCountryFinder) EXPECT() *UserByCountryFinder_Expecter {
 	return &UserByCountryFinder_Expecter{mock: &_m.Mock}
 }
 
-// ListByCountry provides a mock function with given fields: ctx, country, limit, offset
-func (_m *UserByCountryFinder) ListByCountry(ctx context.Context, country string, limit uint64, offset uint64) ([]*model.User, error) {
-	ret := _m.Called(ctx, country, limit, offset)
+// ListByCountry provides a mock function with given fields: ctx, country, limit, after
+func (_m *UserByCountryFinder) ListByCountry(ctx context.Context, country string, limit uint64, after model.Cursor) (model.UserPage, error) {
+	ret := _m.Called(ctx, country, limit, after)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListByCountry")
 	}
 
-	var r0 []*model.User
+	var r0 model.UserPage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) ([]*model.User, error)); ok {
-		return rf(ctx, country, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, model.Cursor) (model.UserPage, error)); ok {
+		return rf(ctx, country, limit, after)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) []*model.User); ok {
-		r0 = rf(ctx, country, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, model.Cursor) model.UserPage); ok {
+		r0 = rf(ctx, country, limit, after)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.User)
-		}
+		r0 = ret.Get(0).(model.UserPage)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
-		r1 = rf(ctx, country, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, model.Cursor) error); ok {
+		r1 = rf(ctx, country, limit, after)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,24 +59,24 @@ type UserByCountryFinder_ListByCountry_Call struct {
 //   - ctx context.Context
 //   - country string
 //   - limit uint64
-//   - offset uint64
-func (_e *UserByCountryFinder_Expecter) ListByCountry(ctx interface{}, country interface{}, limit interface{}, offset interface{}) *UserByCountryFinder_ListByCountry_Call {
-	return &UserByCountryFinder_ListByCountry_Call{Call: _e.mock.On("ListByCountry", ctx, country, limit, offset)}
+//   - after model.Cursor
+func (_e *UserByCountryFinder_Expecter) ListByCountry(ctx interface{}, country interface{}, limit interface{}, after interface{}) *UserByCountryFinder_ListByCountry_Call {
+	return &UserByCountryFinder_ListByCountry_Call{Call: _e.mock.On("ListByCountry", ctx, country, limit, after)}
 }
 
-func (_c *UserByCountryFinder_ListByCountry_Call) Run(run func(ctx context.Context, country string, limit uint64, offset uint64)) *UserByCountryFinder_ListByCountry_Call {
+func (_c *UserByCountryFinder_ListByCountry_Call) Run(run func(ctx context.Context, country string, limit uint64, after model.Cursor)) *UserByCountryFinder_ListByCountry_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(uint64), args[3].(uint64))
+		run(args[0].(context.Context), args[1].(string), args[2].(uint64), args[3].(model.Cursor))
 	})
 	return _c
 }
 
-func (_c *UserByCountryFinder_ListByCountry_Call) Return(_a0 []*model.User, _a1 error) *UserByCountryFinder_ListByCountry_Call {
+func (_c *UserByCountryFinder_ListByCountry_Call) Return(_a0 model.UserPage, _a1 error) *UserByCountryFinder_ListByCountry_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *UserByCountryFinder_ListByCountry_Call) RunAndReturn(run func(context.Context, string, uint64, uint64) ([]*model.User, error)) *UserByCountryFinder_ListByCountry_Call {
+func (_c *UserByCountryFinder_ListByCountry_Call) RunAndReturn(run func(context.Context, string, uint64, model.Cursor) (model.UserPage, error)) *UserByCountryFinder_ListByCountry_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/go-grpc-service/database"
+)
+
+//go:generate mockery --name=DeletedUserLister --outpkg=mocks --output=mocks --filename=deleted_user_lister.go --with-expecter
+
+// DeletedUserLister defines functionality to list the deleted users.
+type DeletedUserLister interface {
+	// ListDeletedUsers lists the users deleted at or before the given time.
+	ListDeletedUsers(ctx context.Context, before time.Time, limit uint64) ([]*model.User, error)
+}
+
+//go:generate mockery --name=UserPurger --outpkg=mocks --output=mocks --filename=user_purger.go --with-expecter
+
+// UserPurger defines functionality to delete a deleted user from the data layer for good.
+type UserPurger interface {
+	// PurgeUser deletes the user deleted at or before the given time, database.ErrNotFound otherwise.
+	PurgeUser(ctx context.Context, id model.UserID, before time.Time) error
+}
+
+// PurgeDeletedUsers is a use case to delete for good the users deleted for longer than the retention period.
+type PurgeDeletedUsers struct {
+	tx       Transactor
+	lister   DeletedUserLister
+	purger   UserPurger
+	notifier UserDeletedNotifier
+	auditor  AuditRecorder
+
+	logger ctxd.Logger
+}
+
+// NewPurgeDeletedUsers creates a new PurgeDeletedUsers use case.
+func NewPurgeDeletedUsers(tx Transactor, lister DeletedUserLister, purger UserPurger, notifier UserDeletedNotifier, auditor AuditRecorder, logger ctxd.Logger) *PurgeDeletedUsers {
+	return &PurgeDeletedUsers{
+		tx:       tx,
+		lister:   lister,
+		purger:   purger,
+		notifier: notifier,
+		auditor:  auditor,
+		logger:   logger,
+	}
+}
+
+// PurgeDeletedUsers executes the purge deleted users use case, returning the number of users purged.
+//
+// Up to limit users deleted at or before the given time are purged, each within its own transaction. It runs on behalf
+// of the service, no actor is required, the purges are audited as performed by the system. A user restored or purged in
+// between, e.g. by another instance, is skipped.
+func (p *PurgeDeletedUsers) PurgeDeletedUsers(ctx context.Context, before time.Time, limit uint64) (int, error) {
+	ctx = ctxd.AddFields(ctx, "use_case", "PurgeDeletedUsers")
+
+	users, err := p.lister.ListDeletedUsers(ctx, before, limit)
+	if err != nil {
+		return 0, ctxd.WrapError(ctx, err, "list deleted users")
+	}
+
+	var purged int
+
+	for _, u := range users {
+		ctx := ctxd.AddFields(ctx, "user_id", u.ID)
+
+		// The deletion is notified before purging the user, so the notification can capture its last state.
+		err := p.tx.InTx(ctx, func(ctx context.Context) error {
+			if err := p.notifier.NotifyUserDeleted(ctx, u.ID); err != nil {
+				return ctxd.WrapError(ctx, err, "notify user deleted")
+			}
+
+			if err := p.purger.PurgeUser(ctx, u.ID, before); err != nil {
+				return ctxd.WrapError(ctx, err, "purge user")
+			}
+
+			err := record(ctx, p.auditor, &model.AuditEntry{
+				UserID: u.ID,
+				Action: model.AuditUserPurged,
+				Actor:  systemActor,
+			})
+			if err != nil {
+				return ctxd.WrapError(ctx, err, "audit user purged")
+			}
+
+			return nil
+		})
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				p.logger.Debug(ctx, "deleted user restored or purged in between, skipped")
+
+				continue
+			}
+
+			return purged, err
+		}
+
+		purged++
+
+		p.logger.Debug(ctx, "deleted user purged")
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeDeletedUsers_PurgeDeletedUsers(t *testing.T) {
+	t.Parallel()
+
+	before := time.Date(2024, 11, 24, 9, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		alice := &model.User{ID: uuid.New()}
+		bob := &model.User{ID: uuid.New()}
+
+		lister := mocks.NewDeletedUserLister(t)
+		lister.EXPECT().ListDeletedUsers(mock.Anything, before, uint64(10)).Return([]*model.User{alice, bob}, nil)
+
+		purger := mocks.NewUserPurger(t)
+		purger.EXPECT().PurgeUser(mock.Anything, alice.ID, before).Return(nil)
+		purger.EXPECT().PurgeUser(mock.Anything, bob.ID, before).Return(nil)
+
+		notifier := mocks.NewUserDeletedNotifier(t)
+		notifier.EXPECT().NotifyUserDeleted(mock.Anything, alice.ID).Return(nil)
+		notifier.EXPECT().NotifyUserDeleted(mock.Anything, bob.ID).Return(nil)
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, &model.AuditEntry{
+			UserID: alice.ID, Action: model.AuditUserPurged, Actor: "system", Changes: map[string]model.AuditChange{},
+		}).Return(nil)
+		auditor.EXPECT().RecordAudit(mock.Anything, &model.AuditEntry{
+			UserID: bob.ID, Action: model.AuditUserPurged, Actor: "system", Changes: map[string]model.AuditChange{},
+		}).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewPurgeDeletedUsers(tx, lister, purger, notifier, auditor, &ctxd.LoggerMock{})
+
+		purged, err := uc.PurgeDeletedUsers(context.Background(), before, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, purged)
+	})
+
+	t.Run("restored in between", func(t *testing.T) {
+		t.Parallel()
+
+		alice := &model.User{ID: uuid.New()}
+
+		lister := mocks.NewDeletedUserLister(t)
+		lister.EXPECT().ListDeletedUsers(mock.Anything, before, uint64(10)).Return([]*model.User{alice}, nil)
+
+		purger := mocks.NewUserPurger(t)
+		purger.EXPECT().PurgeUser(mock.Anything, alice.ID, before).Return(database.ErrNotFound)
+
+		notifier := mocks.NewUserDeletedNotifier(t)
+		notifier.EXPECT().NotifyUserDeleted(mock.Anything, alice.ID).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewPurgeDeletedUsers(tx, lister, purger, notifier, mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		purged, err := uc.PurgeDeletedUsers(context.Background(), before, 10)
+		require.NoError(t, err)
+		require.Zero(t, purged)
+	})
+
+	t.Run("error lister", func(t *testing.T) {
+		t.Parallel()
+
+		lister := mocks.NewDeletedUserLister(t)
+		lister.EXPECT().ListDeletedUsers(mock.Anything, before, uint64(10)).Return(nil, assert.AnError)
+
+		uc := NewPurgeDeletedUsers(mocks.NewTransactor(t), lister, mocks.NewUserPurger(t), mocks.NewUserDeletedNotifier(t), mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		_, err := uc.PurgeDeletedUsers(context.Background(), before, 10)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+// ErrInvalidRefreshToken occurs when the refresh token is unknown, expired, revoked or already used.
+var ErrInvalidRefreshToken = errors.New("invalid refresh token")
+
+//go:generate mockery --name=SessionRefresher --outpkg=mocks --output=mocks --filename=session_refresher.go --with-expecter
+
+// SessionRefresher defines functionality to rotate and revoke the sessions of the users by refresh token.
+type SessionRefresher interface {
+	// Refresh exchanges the refresh token for a new session, the refresh token is not valid anymore.
+	Refresh(ctx context.Context, refreshToken string) (model.Session, error)
+	// Revoke revokes the session the refresh token belongs to.
+	Revoke(ctx context.Context, refreshToken string) error
+}
+
+// RefreshSession is a use case to exchange a refresh token for a new session.
+type RefreshSession struct {
+	refresher SessionRefresher
+
+	logger ctxd.Logger
+}
+
+// NewRefreshSession creates a new RefreshSession use case.
+func NewRefreshSession(refresher SessionRefresher, logger ctxd.Logger) *RefreshSession {
+	return &RefreshSession{
+		refresher: refresher,
+		logger:    logger,
+	}
+}
+
+// RefreshSession executes the refresh session use case.
+func (r *RefreshSession) RefreshSession(ctx context.Context, refreshToken string) (model.Session, error) {
+	ctx = ctxd.AddFields(ctx, "use_case", "RefreshSession")
+
+	s, err := r.refresher.Refresh(ctx, refreshToken)
+	if err != nil {
+		return model.Session{}, ctxd.WrapError(ctx, err, "refresh session")
+	}
+
+	r.logger.Debug(ctx, "session refreshed")
+
+	return s, nil
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshSession_RefreshSession(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		session := model.Session{
+			AccessToken:           "access",
+			AccessTokenExpiresAt:  time.Date(2024, 12, 19, 9, 15, 0, 0, time.UTC),
+			RefreshToken:          "rotated",
+			RefreshTokenExpiresAt: time.Date(2025, 1, 18, 9, 0, 0, 0, time.UTC),
+		}
+
+		refresher := mocks.NewSessionRefresher(t)
+		refresher.EXPECT().Refresh(mock.Anything, "refresh").Return(session, nil)
+
+		uc := NewRefreshSession(refresher, &ctxd.LoggerMock{})
+
+		s, err := uc.RefreshSession(context.Background(), "refresh")
+		require.NoError(t, err)
+		require.Equal(t, session, s)
+	})
+
+	t.Run("invalid refresh token", func(t *testing.T) {
+		t.Parallel()
+
+		refresher := mocks.NewSessionRefresher(t)
+		refresher.EXPECT().Refresh(mock.Anything, "refresh").Return(model.Session{}, ErrInvalidRefreshToken)
+
+		uc := NewRefreshSession(refresher, &ctxd.LoggerMock{})
+
+		_, err := uc.RefreshSession(context.Background(), "refresh")
+		require.ErrorIs(t, err, ErrInvalidRefreshToken)
+	})
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/go-grpc-service/database"
+)
+
+// ErrTooManyResetRequests occurs when the password resets requested for an email, or from an IP, exceed the limits.
+var ErrTooManyResetRequests = errors.New("too many password reset requests")
+
+//go:generate mockery --name=PasswordResetThrottler --outpkg=mocks --output=mocks --filename=password_reset_throttler.go --with-expecter
+
+// PasswordResetThrottler defines functionality to rate limit the password resets requested.
+type PasswordResetThrottler interface {
+	// Allow records a password reset requested for the email from the IP, reporting whether both are within the
+	// limits.
+	Allow(ctx context.Context, email, ip string) (bool, error)
+}
+
+//go:generate mockery --name=PasswordResetIssuer --outpkg=mocks --output=mocks --filename=password_reset_issuer.go --with-expecter
+
+// PasswordResetIssuer defines functionality to issue the tokens resetting the password of the users.
+type PasswordResetIssuer interface {
+	// Issue issues a single-use token resetting the password of the user, the tokens issued before are not valid
+	// anymore.
+	Issue(ctx context.Context, id model.UserID, email string) (model.PasswordResetRequested, error)
+}
+
+//go:generate mockery --name=PasswordResetRequestedNotifier --outpkg=mocks --output=mocks --filename=password_reset_requested_notifier.go --with-expecter
+
+// PasswordResetRequestedNotifier defines functionality to notify about a password reset requested, delivering the
+// token to the email of the user.
+type PasswordResetRequestedNotifier interface {
+	NotifyPasswordResetRequested(ctx context.Context, r model.PasswordResetRequested) error
+}
+
+// RequestPasswordReset is a use case to request the reset of the password of a user by email.
+type RequestPasswordReset struct {
+	throttler PasswordResetThrottler
+	finder    CredentialsFinder
+	issuer    PasswordResetIssuer
+	notifier  PasswordResetRequestedNotifier
+
+	logger ctxd.Logger
+}
+
+// NewRequestPasswordReset creates a new RequestPasswordReset use case.
+func NewRequestPasswordReset(throttler PasswordResetThrottler, finder CredentialsFinder, issuer PasswordResetIssuer, notifier PasswordResetRequestedNotifier, logger ctxd.Logger) *RequestPasswordReset {
+	return &RequestPasswordReset{
+		throttler: throttler,
+		finder:    finder,
+		issuer:    issuer,
+		notifier:  notifier,
+		logger:    logger,
+	}
+}
+
+// RequestPasswordReset executes the request password reset use case.
+//
+// The request succeeds whether the email is registered or not, and whether the token was delivered or not, so the
+// response does not reveal which emails are registered. The requests are rate limited per email and per IP alike.
+func (r *RequestPasswordReset) RequestPasswordReset(ctx context.Context, email, ip string) error {
+	ctx = ctxd.AddFields(ctx, "use_case", "RequestPasswordReset")
+
+	allowed, err := r.throttler.Allow(ctx, email, ip)
+	if err != nil {
+		return ctxd.WrapError(ctx, err, "check password reset limits")
+	}
+
+	if !allowed {
+		return ErrTooManyResetRequests
+	}
+
+	u, err := r.finder.FindByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			r.logger.Debug(ctx, "password reset requested for unknown email")
+
+			return nil
+		}
+
+		return ctxd.WrapError(ctx, err, "find user by email")
+	}
+
+	ctx = ctxd.AddFields(ctx, "user_id", u.ID)
+
+	pr, err := r.issuer.Issue(ctx, u.ID, u.Email)
+	if err != nil {
+		return ctxd.WrapError(ctx, err, "issue password reset token")
+	}
+
+	if err := r.notifier.NotifyPasswordResetRequested(ctx, pr); err != nil {
+		r.logger.Warn(ctx, "notify password reset requested", "error", err)
+
+		return nil
+	}
+
+	r.logger.Debug(ctx, "password reset requested")
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestPasswordReset_RequestPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	uID := uuid.New()
+
+	user := &model.User{ID: uID, UserState: model.UserState{Email: "alice@bob.com"}}
+
+	pr := model.PasswordResetRequested{
+		UserID:    uID,
+		Email:     "alice@bob.com",
+		Token:     "token",
+		ExpiresAt: time.Date(2024, 12, 22, 10, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		throttler := mocks.NewPasswordResetThrottler(t)
+		throttler.EXPECT().Allow(mock.Anything, "alice@bob.com", "192.0.2.1").Return(true, nil)
+
+		finder := mocks.NewCredentialsFinder(t)
+		finder.EXPECT().FindByEmail(mock.Anything, "alice@bob.com").Return(user, nil)
+
+		issuer := mocks.NewPasswordResetIssuer(t)
+		issuer.EXPECT().Issue(mock.Anything, uID, "alice@bob.com").Return(pr, nil)
+
+		notifier := mocks.NewPasswordResetRequestedNotifier(t)
+		notifier.EXPECT().NotifyPasswordResetRequested(mock.Anything, pr).Return(nil)
+
+		uc := NewRequestPasswordReset(throttler, finder, issuer, notifier, &ctxd.LoggerMock{})
+
+		require.NoError(t, uc.RequestPasswordReset(context.Background(), "alice@bob.com", "192.0.2.1"))
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		t.Parallel()
+
+		throttler := mocks.NewPasswordResetThrottler(t)
+		throttler.EXPECT().Allow(mock.Anything, "unknown@bob.com", "192.0.2.1").Return(true, nil)
+
+		finder := mocks.NewCredentialsFinder(t)
+		finder.EXPECT().FindByEmail(mock.Anything, "unknown@bob.com").Return(nil, database.ErrNotFound)
+
+		uc := NewRequestPasswordReset(throttler, finder, mocks.NewPasswordResetIssuer(t), mocks.NewPasswordResetRequestedNotifier(t), &ctxd.LoggerMock{})
+
+		require.NoError(t, uc.RequestPasswordReset(context.Background(), "unknown@bob.com", "192.0.2.1"))
+	})
+
+	t.Run("too many requests", func(t *testing.T) {
+		t.Parallel()
+
+		throttler := mocks.NewPasswordResetThrottler(t)
+		throttler.EXPECT().Allow(mock.Anything, "alice@bob.com", "192.0.2.1").Return(false, nil)
+
+		uc := NewRequestPasswordReset(throttler, mocks.NewCredentialsFinder(t), mocks.NewPasswordResetIssuer(t), mocks.NewPasswordResetRequestedNotifier(t), &ctxd.LoggerMock{})
+
+		require.ErrorIs(t, uc.RequestPasswordReset(context.Background(), "alice@bob.com", "192.0.2.1"), ErrTooManyResetRequests)
+	})
+
+	t.Run("error notifier", func(t *testing.T) {
+		t.Parallel()
+
+		throttler := mocks.NewPasswordResetThrottler(t)
+		throttler.EXPECT().Allow(mock.Anything, "alice@bob.com", "192.0.2.1").Return(true, nil)
+
+		finder := mocks.NewCredentialsFinder(t)
+		finder.EXPECT().FindByEmail(mock.Anything, "alice@bob.com").Return(user, nil)
+
+		issuer := mocks.NewPasswordResetIssuer(t)
+		issuer.EXPECT().Issue(mock.Anything, uID, "alice@bob.com").Return(pr, nil)
+
+		notifier := mocks.NewPasswordResetRequestedNotifier(t)
+		notifier.EXPECT().NotifyPasswordResetRequested(mock.Anything, pr).Return(assert.AnError)
+
+		logger := &ctxd.LoggerMock{}
+
+		uc := NewRequestPasswordReset(throttler, finder, issuer, notifier, logger)
+
+		// Not delivering the token is not reported, it would reveal the email is registered.
+		require.NoError(t, uc.RequestPasswordReset(context.Background(), "alice@bob.com", "192.0.2.1"))
+		require.Contains(t, logger.String(), "notify password reset requested")
+	})
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+// ErrEmailAlreadyVerified occurs when requesting the verification of an email already verified.
+var ErrEmailAlreadyVerified = errors.New("email already verified")
+
+//go:generate mockery --name=VerificationIssuer --outpkg=mocks --output=mocks --filename=verification_issuer.go --with-expecter
+
+// VerificationIssuer defines functionality to issue the tokens verifying the email of the users.
+type VerificationIssuer interface {
+	// Issue issues a single-use token verifying the email of the user, the tokens issued before are not valid anymore.
+	Issue(ctx context.Context, id model.UserID, email string) (model.VerificationRequested, error)
+}
+
+//go:generate mockery --name=VerificationRequestedNotifier --outpkg=mocks --output=mocks --filename=verification_requested_notifier.go --with-expecter
+
+// VerificationRequestedNotifier defines functionality to notify about a verification requested, delivering the token
+// to the email.
+type VerificationRequestedNotifier interface {
+	NotifyVerificationRequested(ctx context.Context, v model.VerificationRequested) error
+}
+
+//go:generate mockery --name=VerificationRequester --outpkg=mocks --output=mocks --filename=verification_requester.go --with-expecter
+
+// VerificationRequester defines functionality to request the verification of the email of a user.
+type VerificationRequester interface {
+	RequestVerification(ctx context.Context, id model.UserID) error
+}
+
+// RequestVerification is a domain service to request the verification of the email of a user.
+type RequestVerification struct {
+	finder   UserFinder
+	issuer   VerificationIssuer
+	notifier VerificationRequestedNotifier
+
+	logger ctxd.Logger
+}
+
+// NewRequestVerification creates a new RequestVerification domain service.
+func NewRequestVerification(finder UserFinder, issuer VerificationIssuer, notifier VerificationRequestedNotifier, logger ctxd.Logger) *RequestVerification {
+	return &RequestVerification{
+		finder:   finder,
+		issuer:   issuer,
+		notifier: notifier,
+		logger:   logger,
+	}
+}
+
+// RequestVerification issues a token verifying the current email of the user and notifies the verification
+// requested.
+//
+// The notification is not transactional, the token is never stored in plain. A verification not delivered is
+// requested again.
+func (r *RequestVerification) RequestVerification(ctx context.Context, id model.UserID) error {
+	ctx = ctxd.AddFields(ctx, "use_case", "RequestVerification", "user_id", id)
+
+	u, err := r.finder.FindByID(ctx, id)
+	if err != nil {
+		return ctxd.WrapError(ctx, err, "find user")
+	}
+
+	if u.EmailVerified() {
+		return ErrEmailAlreadyVerified
+	}
+
+	v, err := r.issuer.Issue(ctx, id, u.Email)
+	if err != nil {
+		return ctxd.WrapError(ctx, err, "issue verification token")
+	}
+
+	if err := r.notifier.NotifyVerificationRequested(ctx, v); err != nil {
+		return ctxd.WrapError(ctx, err, "notify verification requested")
+	}
+
+	r.logger.Debug(ctx, "verification requested")
+
+	return nil
+}
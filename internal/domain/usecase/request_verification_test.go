@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestVerification_RequestVerification(t *testing.T) {
+	t.Parallel()
+
+	uID := uuid.New()
+
+	user := &model.User{ID: uID, UserState: model.UserState{Email: "alice@bob.com"}}
+
+	v := model.VerificationRequested{
+		UserID:    uID,
+		Email:     "alice@bob.com",
+		Token:     "token",
+		ExpiresAt: time.Date(2024, 12, 22, 9, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(user, nil)
+
+		issuer := mocks.NewVerificationIssuer(t)
+		issuer.EXPECT().Issue(mock.Anything, uID, "alice@bob.com").Return(v, nil)
+
+		notifier := mocks.NewVerificationRequestedNotifier(t)
+		notifier.EXPECT().NotifyVerificationRequested(mock.Anything, v).Return(nil)
+
+		s := NewRequestVerification(finder, issuer, notifier, &ctxd.LoggerMock{})
+
+		require.NoError(t, s.RequestVerification(context.Background(), uID))
+	})
+
+	t.Run("already verified", func(t *testing.T) {
+		t.Parallel()
+
+		verifiedAt := time.Date(2024, 12, 21, 9, 0, 0, 0, time.UTC)
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID, EmailVerifiedAt: &verifiedAt}, nil)
+
+		s := NewRequestVerification(finder, mocks.NewVerificationIssuer(t), mocks.NewVerificationRequestedNotifier(t), &ctxd.LoggerMock{})
+
+		require.ErrorIs(t, s.RequestVerification(context.Background(), uID), ErrEmailAlreadyVerified)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(nil, database.ErrNotFound)
+
+		s := NewRequestVerification(finder, mocks.NewVerificationIssuer(t), mocks.NewVerificationRequestedNotifier(t), &ctxd.LoggerMock{})
+
+		require.ErrorIs(t, s.RequestVerification(context.Background(), uID), database.ErrNotFound)
+	})
+
+	t.Run("error notifier", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(user, nil)
+
+		issuer := mocks.NewVerificationIssuer(t)
+		issuer.EXPECT().Issue(mock.Anything, uID, "alice@bob.com").Return(v, nil)
+
+		notifier := mocks.NewVerificationRequestedNotifier(t)
+		notifier.EXPECT().NotifyVerificationRequested(mock.Anything, v).Return(assert.AnError)
+
+		s := NewRequestVerification(finder, issuer, notifier, &ctxd.LoggerMock{})
+
+		require.ErrorIs(t, s.RequestVerification(context.Background(), uID), assert.AnError)
+	})
+}
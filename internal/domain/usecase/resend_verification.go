@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+// ResendVerification is a use case to request the verification of the email of a user again, e.g. when the token
+// expired or was not delivered.
+type ResendVerification struct {
+	requester VerificationRequester
+
+	logger ctxd.Logger
+}
+
+// NewResendVerification creates a new ResendVerification use case.
+func NewResendVerification(requester VerificationRequester, logger ctxd.Logger) *ResendVerification {
+	return &ResendVerification{
+		requester: requester,
+		logger:    logger,
+	}
+}
+
+// ResendVerification executes the resend verification use case.
+//
+// The tokens issued before are not valid anymore.
+func (r *ResendVerification) ResendVerification(ctx context.Context, id model.UserID) error {
+	ctx = ctxd.AddFields(ctx, "use_case", "ResendVerification", "user_id", id)
+
+	// Users resend their own verification, admins anyone's.
+	if _, err := authorizeOnUser(ctx, model.PermissionWriteUsers, model.PermissionWriteOwnUser, id); err != nil {
+		return ctxd.WrapError(ctx, err, "authorize")
+	}
+
+	if err := r.requester.RequestVerification(ctx, id); err != nil {
+		return ctxd.WrapError(ctx, err, "request verification")
+	}
+
+	r.logger.Debug(ctx, "verification resent")
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResendVerification_ResendVerification(t *testing.T) {
+	t.Parallel()
+
+	uID := uuid.New()
+
+	t.Run("self", func(t *testing.T) {
+		t.Parallel()
+
+		requester := mocks.NewVerificationRequester(t)
+		requester.EXPECT().RequestVerification(mock.Anything, uID).Return(nil)
+
+		uc := NewResendVerification(requester, &ctxd.LoggerMock{})
+
+		require.NoError(t, uc.ResendVerification(actingAs(uID, model.RoleUser), uID))
+	})
+
+	t.Run("already verified", func(t *testing.T) {
+		t.Parallel()
+
+		requester := mocks.NewVerificationRequester(t)
+		requester.EXPECT().RequestVerification(mock.Anything, uID).Return(ErrEmailAlreadyVerified)
+
+		uc := NewResendVerification(requester, &ctxd.LoggerMock{})
+
+		require.ErrorIs(t, uc.ResendVerification(asAdmin(), uID), ErrEmailAlreadyVerified)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		t.Parallel()
+
+		uc := NewResendVerification(mocks.NewVerificationRequester(t), &ctxd.LoggerMock{})
+
+		err := uc.ResendVerification(actingAs(uuid.New(), model.RoleUser, model.RoleSupport), uID)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
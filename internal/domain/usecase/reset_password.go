@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+// ErrInvalidResetToken occurs when the password reset token is unknown, expired or already used.
+var ErrInvalidResetToken = errors.New("invalid password reset token")
+
+//go:generate mockery --name=PasswordResetRedeemer --outpkg=mocks --output=mocks --filename=password_reset_redeemer.go --with-expecter
+
+// PasswordResetRedeemer defines functionality to redeem the tokens resetting the password of the users.
+type PasswordResetRedeemer interface {
+	// Redeem uses the token, returning the user whose password it resets.
+	Redeem(ctx context.Context, token string) (model.UserID, error)
+}
+
+//go:generate mockery --name=SessionsRevoker --outpkg=mocks --output=mocks --filename=sessions_revoker.go --with-expecter
+
+// SessionsRevoker defines functionality to revoke all the sessions of a user.
+type SessionsRevoker interface {
+	RevokeAll(ctx context.Context, id model.UserID) error
+}
+
+//go:generate mockery --name=UserPasswordResetNotifier --outpkg=mocks --output=mocks --filename=user_password_reset_notifier.go --with-expecter
+
+// UserPasswordResetNotifier defines functionality to notify about a user password reset.
+type UserPasswordResetNotifier interface {
+	NotifyPasswordReset(ctx context.Context, id model.UserID) error
+}
+
+// ResetPassword is a use case to reset the password of a user with the token delivered to its email.
+type ResetPassword struct {
+	tx       Transactor
+	redeemer PasswordResetRedeemer
+	updater  PasswordHashUpdater
+	hasher   PasswordHasher
+	revoker  SessionsRevoker
+	notifier UserPasswordResetNotifier
+	auditor  AuditRecorder
+
+	logger ctxd.Logger
+}
+
+// NewResetPassword creates a new ResetPassword use case.
+func NewResetPassword(tx Transactor, redeemer PasswordResetRedeemer, updater PasswordHashUpdater, hasher PasswordHasher, revoker SessionsRevoker, notifier UserPasswordResetNotifier, auditor AuditRecorder, logger ctxd.Logger) *ResetPassword {
+	return &ResetPassword{
+		tx:       tx,
+		redeemer: redeemer,
+		updater:  updater,
+		hasher:   hasher,
+		revoker:  revoker,
+		notifier: notifier,
+		auditor:  auditor,
+		logger:   logger,
+	}
+}
+
+// ResetPassword executes the reset password use case.
+//
+// The token proves the ownership of the email, no actor is required. The sessions of the user are revoked, whoever
+// held them has to log in with the new password, and the password reset is notified as a security event and audited
+// as performed by the user, all within the same transaction.
+func (r *ResetPassword) ResetPassword(ctx context.Context, token, password string) error {
+	ctx = ctxd.AddFields(ctx, "use_case", "ResetPassword")
+
+	var id model.UserID
+
+	err := r.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		id, err = r.redeemer.Redeem(ctx, token)
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "redeem password reset token")
+		}
+
+		ctx = ctxd.AddFields(ctx, "user_id", id)
+
+		hash, err := r.hasher.Hash(password)
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "hash password")
+		}
+
+		if err := r.updater.UpdatePasswordHash(ctx, id, hash); err != nil {
+			return ctxd.WrapError(ctx, err, "update password hash")
+		}
+
+		if err := r.revoker.RevokeAll(ctx, id); err != nil {
+			return ctxd.WrapError(ctx, err, "revoke sessions")
+		}
+
+		if err := r.notifier.NotifyPasswordReset(ctx, id); err != nil {
+			return ctxd.WrapError(ctx, err, "notify password reset")
+		}
+
+		if err := auditPasswordHash(ctx, r.auditor, id, model.AuditUserPasswordReset); err != nil {
+			return ctxd.WrapError(ctx, err, "audit password reset")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.logger.Debug(ctxd.AddFields(ctx, "user_id", id), "password reset")
+
+	return nil
+}
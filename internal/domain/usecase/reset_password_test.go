@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResetPassword_ResetPassword(t *testing.T) {
+	t.Parallel()
+
+	uID := uuid.New()
+
+	hash := "$argon2id$v=19$m=65536,t=3,p=2$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		redeemer := mocks.NewPasswordResetRedeemer(t)
+		redeemer.EXPECT().Redeem(mock.Anything, "token").Return(uID, nil)
+
+		hasher := mocks.NewPasswordHasher(t)
+		hasher.EXPECT().Hash("n3w-s3cr3t-passw0rd").Return(hash, nil)
+
+		updater := mocks.NewPasswordHashUpdater(t)
+		updater.EXPECT().UpdatePasswordHash(mock.Anything, uID, hash).Return(nil)
+
+		revoker := mocks.NewSessionsRevoker(t)
+		revoker.EXPECT().RevokeAll(mock.Anything, uID).Return(nil)
+
+		notifier := mocks.NewUserPasswordResetNotifier(t)
+		notifier.EXPECT().NotifyPasswordReset(mock.Anything, uID).Return(nil)
+
+		// Audited as performed by the user, the token proves it.
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, &model.AuditEntry{
+			UserID:    uID,
+			Action:    model.AuditUserPasswordReset,
+			Actor:     uID.String(),
+			RequestID: "req-1",
+			SourceIP:  "10.0.0.1",
+			Changes:   map[string]model.AuditChange{"password_hash": {Before: model.RedactedValue, After: model.RedactedValue}},
+		}).Return(nil)
+
+		uc := NewResetPassword(tx, redeemer, updater, hasher, revoker, notifier, auditor, &ctxd.LoggerMock{})
+
+		ctx := ContextWithRequest(context.Background(), model.RequestInfo{ID: "req-1", SourceIP: "10.0.0.1"})
+
+		require.NoError(t, uc.ResetPassword(ctx, "token", "n3w-s3cr3t-passw0rd"))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		t.Parallel()
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		redeemer := mocks.NewPasswordResetRedeemer(t)
+		redeemer.EXPECT().Redeem(mock.Anything, "token").Return(uuid.Nil, ErrInvalidResetToken)
+
+		uc := NewResetPassword(tx, redeemer, mocks.NewPasswordHashUpdater(t), mocks.NewPasswordHasher(t), mocks.NewSessionsRevoker(t), mocks.NewUserPasswordResetNotifier(t), mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		require.ErrorIs(t, uc.ResetPassword(context.Background(), "token", "n3w-s3cr3t-passw0rd"), ErrInvalidResetToken)
+	})
+
+	t.Run("error revoker", func(t *testing.T) {
+		t.Parallel()
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		redeemer := mocks.NewPasswordResetRedeemer(t)
+		redeemer.EXPECT().Redeem(mock.Anything, "token").Return(uID, nil)
+
+		hasher := mocks.NewPasswordHasher(t)
+		hasher.EXPECT().Hash("n3w-s3cr3t-passw0rd").Return(hash, nil)
+
+		updater := mocks.NewPasswordHashUpdater(t)
+		updater.EXPECT().UpdatePasswordHash(mock.Anything, uID, hash).Return(nil)
+
+		revoker := mocks.NewSessionsRevoker(t)
+		revoker.EXPECT().RevokeAll(mock.Anything, uID).Return(assert.AnError)
+
+		uc := NewResetPassword(tx, redeemer, updater, hasher, revoker, mocks.NewUserPasswordResetNotifier(t), mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		require.ErrorIs(t, uc.ResetPassword(context.Background(), "token", "n3w-s3cr3t-passw0rd"), assert.AnError)
+	})
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+)
+
+// RevokeSession is a use case to revoke a session by refresh token.
+type RevokeSession struct {
+	refresher SessionRefresher
+
+	logger ctxd.Logger
+}
+
+// NewRevokeSession creates a new RevokeSession use case.
+func NewRevokeSession(refresher SessionRefresher, logger ctxd.Logger) *RevokeSession {
+	return &RevokeSession{
+		refresher: refresher,
+		logger:    logger,
+	}
+}
+
+// RevokeSession executes the revoke session use case.
+//
+// Revoking an invalid refresh token succeeds, as defined by RFC 7009, there is nothing left to revoke.
+func (r *RevokeSession) RevokeSession(ctx context.Context, refreshToken string) error {
+	ctx = ctxd.AddFields(ctx, "use_case", "RevokeSession")
+
+	err := r.refresher.Revoke(ctx, refreshToken)
+	if err != nil && !errors.Is(err, ErrInvalidRefreshToken) {
+		return ctxd.WrapError(ctx, err, "revoke session")
+	}
+
+	r.logger.Debug(ctx, "session revoked")
+
+	return nil
+}
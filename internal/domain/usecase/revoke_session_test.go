@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokeSession_RevokeSession(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		refresher := mocks.NewSessionRefresher(t)
+		refresher.EXPECT().Revoke(mock.Anything, "refresh").Return(nil)
+
+		uc := NewRevokeSession(refresher, &ctxd.LoggerMock{})
+
+		require.NoError(t, uc.RevokeSession(context.Background(), "refresh"))
+	})
+
+	t.Run("invalid refresh token", func(t *testing.T) {
+		t.Parallel()
+
+		refresher := mocks.NewSessionRefresher(t)
+		refresher.EXPECT().Revoke(mock.Anything, "refresh").Return(ErrInvalidRefreshToken)
+
+		uc := NewRevokeSession(refresher, &ctxd.LoggerMock{})
+
+		require.NoError(t, uc.RevokeSession(context.Background(), "refresh"))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		refresher := mocks.NewSessionRefresher(t)
+		refresher.EXPECT().Revoke(mock.Anything, "refresh").Return(assert.AnError)
+
+		uc := NewRevokeSession(refresher, &ctxd.LoggerMock{})
+
+		require.ErrorIs(t, uc.RevokeSession(context.Background(), "refresh"), assert.AnError)
+	})
+}
@@ -0,0 +1,12 @@
+package usecase
+
+import "context"
+
+//go:generate mockery --name=Transactor --outpkg=mocks --output=mocks --filename=transactor.go --with-expecter
+
+// Transactor defines functionality to run a set of operations within a single data layer transaction.
+//
+// The transaction travels within the context, so every operation executed with the given context takes part of it.
+type Transactor interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
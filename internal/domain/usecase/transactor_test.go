@@ -0,0 +1,8 @@
+package usecase
+
+import "context"
+
+// inTx runs the function as a transaction would do, used to mock Transactor.
+func inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
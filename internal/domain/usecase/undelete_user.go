@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+//go:generate mockery --name=UserRestorer --outpkg=mocks --output=mocks --filename=user_restorer.go --with-expecter
+
+// UserRestorer defines functionality to restore a deleted user in the data layer.
+type UserRestorer interface {
+	// RestoreUser restores the user deleted within the grace period, database.ErrNotFound otherwise.
+	RestoreUser(ctx context.Context, id model.UserID, grace time.Duration) error
+}
+
+//go:generate mockery --name=UserRestoredNotifier --outpkg=mocks --output=mocks --filename=user_restored_notifier.go --with-expecter
+
+// UserRestoredNotifier defines functionality to notify about a deleted user restored.
+type UserRestoredNotifier interface {
+	NotifyUserRestored(ctx context.Context, id model.UserID) error
+}
+
+// UndeleteUser is a use case to restore a deleted user.
+type UndeleteUser struct {
+	tx       Transactor
+	restorer UserRestorer
+	finder   UserFinder
+	notifier UserRestoredNotifier
+	auditor  AuditRecorder
+	grace    time.Duration
+
+	logger ctxd.Logger
+}
+
+// NewUndeleteUser creates a new UndeleteUser use case, restoring the users deleted within the grace period.
+func NewUndeleteUser(tx Transactor, restorer UserRestorer, finder UserFinder, notifier UserRestoredNotifier, auditor AuditRecorder, grace time.Duration, logger ctxd.Logger) *UndeleteUser {
+	return &UndeleteUser{
+		tx:       tx,
+		restorer: restorer,
+		finder:   finder,
+		notifier: notifier,
+		auditor:  auditor,
+		grace:    grace,
+		logger:   logger,
+	}
+}
+
+// UndeleteUser executes the undelete user use case, returning the restored user.
+//
+// A user deleted before the grace period, or purged, is not found. The user keeps the status it had when deleted.
+func (u *UndeleteUser) UndeleteUser(ctx context.Context, id model.UserID) (*model.User, error) {
+	ctx = ctxd.AddFields(ctx, "use_case", "UndeleteUser", "user_id", id)
+
+	_, err := authorize(ctx, model.PermissionWriteUsers)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "authorize")
+	}
+
+	var user *model.User
+
+	err = u.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := u.restorer.RestoreUser(ctx, id, u.grace); err != nil {
+			return ctxd.WrapError(ctx, err, "restore user")
+		}
+
+		if err := u.notifier.NotifyUserRestored(ctx, id); err != nil {
+			return ctxd.WrapError(ctx, err, "notify user restored")
+		}
+
+		user, err = u.finder.FindByID(ctx, id)
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "find user")
+		}
+
+		if err := audit(ctx, u.auditor, id, model.AuditUserRestored, model.UserState{}, user.UserState); err != nil {
+			return ctxd.WrapError(ctx, err, "audit user restored")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.Debug(ctx, "user restored")
+
+	return user, nil
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUndeleteUser_UndeleteUser(t *testing.T) {
+	t.Parallel()
+
+	uID := uuid.New()
+	grace := 30 * 24 * time.Hour
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		restorer := mocks.NewUserRestorer(t)
+		restorer.EXPECT().RestoreUser(mock.Anything, uID, grace).Return(nil)
+
+		notifier := mocks.NewUserRestoredNotifier(t)
+		notifier.EXPECT().NotifyUserRestored(mock.Anything, uID).Return(nil)
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{
+			ID:        uID,
+			UserState: model.UserState{Email: "alice@bob.com"},
+		}, nil)
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, &model.AuditEntry{
+			UserID:  uID,
+			Action:  model.AuditUserRestored,
+			Actor:   "admin",
+			Changes: map[string]model.AuditChange{"email": {After: "alice@bob.com"}},
+		}).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewUndeleteUser(tx, restorer, finder, notifier, auditor, grace, &ctxd.LoggerMock{})
+
+		u, err := uc.UndeleteUser(asAdmin(), uID)
+		require.NoError(t, err)
+		require.Equal(t, uID, u.ID)
+		require.Equal(t, "alice@bob.com", u.Email)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		restorer := mocks.NewUserRestorer(t)
+		restorer.EXPECT().RestoreUser(mock.Anything, uID, grace).Return(database.ErrNotFound)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewUndeleteUser(tx, restorer, mocks.NewUserFinder(t), mocks.NewUserRestoredNotifier(t), mocks.NewAuditRecorder(t), grace, &ctxd.LoggerMock{})
+
+		_, err := uc.UndeleteUser(asAdmin(), uID)
+		require.ErrorIs(t, err, database.ErrNotFound)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		t.Parallel()
+
+		uc := NewUndeleteUser(mocks.NewTransactor(t), mocks.NewUserRestorer(t), mocks.NewUserFinder(t), mocks.NewUserRestoredNotifier(t), mocks.NewAuditRecorder(t), grace, &ctxd.LoggerMock{})
+
+		_, err := uc.UndeleteUser(actingAs(uID, model.RoleUser), uID)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
@@ -2,57 +2,151 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
 	"github.com/bool64/ctxd"
 	"github.com/dohernandez/faceit/internal/domain/model"
 )
 
+// ErrVersionMismatch occurs when the user changed since the version a mutation is conditioned on.
+var ErrVersionMismatch = errors.New("version mismatch")
+
 //go:generate mockery --name=UserUpdater --outpkg=mocks --output=mocks --filename=user_updater.go --with-expecter
 
 // UserUpdater defines functionality to update a user to the data layer.
 type UserUpdater interface {
-	UpdateUser(ctx context.Context, id model.UserID, info model.UserState) error
+	// UpdateUser updates the fields of the user to their value in the state, provided it is at the version, whatever
+	// its version when 0.
+	UpdateUser(ctx context.Context, id model.UserID, info model.UserState, fields model.UserFields, version int64) error
 }
 
 //go:generate mockery --name=UserUpdatedNotifier --outpkg=mocks --output=mocks --filename=user_updated_notifier.go --with-expecter
 
-// UserUpdatedNotifier defines functionality to notify about a user updated.
+// UserUpdatedNotifier defines functionality to notify about a user updated with its state after the update.
 type UserUpdatedNotifier interface {
 	NotifyUserUpdated(ctx context.Context, id model.UserID, info model.UserState) error
 }
 
+//go:generate mockery --name=PasswordResetDiscarder --outpkg=mocks --output=mocks --filename=password_reset_discarder.go --with-expecter
+
+// PasswordResetDiscarder defines functionality to discard the pending tokens resetting the password of a user.
+type PasswordResetDiscarder interface {
+	Discard(ctx context.Context, id model.UserID) error
+}
+
 // UpdateUser is a use case to update a user.
 type UpdateUser struct {
-	updater  UserUpdater
-	notifier UserUpdatedNotifier
+	tx           Transactor
+	updater      UserUpdater
+	finder       UserFinder
+	hasher       PasswordHasher
+	notifier     UserUpdatedNotifier
+	auditor      AuditRecorder
+	verification VerificationRequester
+	resets       PasswordResetDiscarder
 
 	logger ctxd.Logger
 }
 
 // NewUpdateUser creates a new UpdateUser use case.
-func NewUpdateUser(userUpdater UserUpdater, notifier UserUpdatedNotifier, logger ctxd.Logger) *UpdateUser {
+//
+// The notifier and the auditor are called within the same transaction as the updater, the auditor records the user
+// found before and after the update. The verification of the email is requested once the email is changed, the pending
+// password resets, mailed to the former email, are discarded within the transaction.
+func NewUpdateUser(tx Transactor, userUpdater UserUpdater, finder UserFinder, hasher PasswordHasher, notifier UserUpdatedNotifier, auditor AuditRecorder, verification VerificationRequester, resets PasswordResetDiscarder, logger ctxd.Logger) *UpdateUser {
 	return &UpdateUser{
-		updater:  userUpdater,
-		notifier: notifier,
-		logger:   logger,
+		tx:           tx,
+		updater:      userUpdater,
+		finder:       finder,
+		hasher:       hasher,
+		notifier:     notifier,
+		auditor:      auditor,
+		verification: verification,
+		resets:       resets,
+		logger:       logger,
 	}
 }
 
-// UpdateUser executes the update user use case.
-func (a *UpdateUser) UpdateUser(ctx context.Context, id model.UserID, info model.UserState) error {
+// UpdateUser executes the update user use case, returning the updated user.
+//
+// Only the fields are updated, to their value in the state, so they can be cleared. The password is changed when the
+// password field is set, hashed before the user is stored. The user is only updated when it is at the version,
+// whatever its version when 0, otherwise ErrVersionMismatch is returned. Without fields, the user is returned as is.
+func (a *UpdateUser) UpdateUser(ctx context.Context, id model.UserID, info model.UserState, fields model.UserFields, password string, version int64) (*model.User, error) {
 	ctx = ctxd.AddFields(ctx, "use_case", "UpdateUser", "user_id", id)
 
-	if err := a.updater.UpdateUser(ctx, id, info); err != nil {
-		return ctxd.WrapError(ctx, err, "update user") // error contains the context fields added
+	// Users update themselves, admins anyone.
+	if _, err := authorizeOnUser(ctx, model.PermissionWriteUsers, model.PermissionWriteOwnUser, id); err != nil {
+		return nil, ctxd.WrapError(ctx, err, "authorize")
 	}
 
-	a.logger.Debug(ctx, "user updated")
+	if fields.Has(model.UserFieldPassword) {
+		hash, err := a.hasher.Hash(password)
+		if err != nil {
+			return nil, ctxd.WrapError(ctx, err, "hash password")
+		}
+
+		info.PasswordHash = hash
+	}
+
+	var after *model.User
+
+	err := a.tx.InTx(ctx, func(ctx context.Context) error {
+		before, err := a.finder.FindByID(ctx, id)
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "find user") // error contains the context fields added
+		}
+
+		if version != 0 && before.Version != version {
+			return ctxd.WrapError(ctx, ErrVersionMismatch, "check version", "version", before.Version)
+		}
 
-	if err := a.notifier.NotifyUserUpdated(ctx, id, info); err != nil {
-		return ctxd.WrapError(ctx, err, "notify user updated")
+		if len(fields) == 0 {
+			after = before
+
+			return nil
+		}
+
+		if err := a.updater.UpdateUser(ctx, id, info, fields, version); err != nil {
+			return ctxd.WrapError(ctx, err, "update user")
+		}
+
+		after, err = a.finder.FindByID(ctx, id)
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "find updated user")
+		}
+
+		// The links mailed to the former email must not reset the password anymore.
+		if after.Email != before.Email {
+			if err := a.resets.Discard(ctx, id); err != nil {
+				return ctxd.WrapError(ctx, err, "discard password resets")
+			}
+		}
+
+		// The full state tells the fields cleared apart from the fields left untouched.
+		if err := a.notifier.NotifyUserUpdated(ctx, id, after.UserState); err != nil {
+			return ctxd.WrapError(ctx, err, "notify user updated")
+		}
+
+		if err := audit(ctx, a.auditor, id, model.AuditUserUpdated, before.UserState, after.UserState); err != nil {
+			return ctxd.WrapError(ctx, err, "audit user updated")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	a.logger.Debug(ctx, "user updated notification sent")
+	a.logger.Debug(ctx, "user updated")
+
+	// Changing the email resets its verification, an email given unchanged is still verified.
+	if fields.Has(model.UserFieldEmail) {
+		err := a.verification.RequestVerification(ctx, id)
+		if err != nil && !errors.Is(err, ErrEmailAlreadyVerified) {
+			a.logger.Warn(ctx, "request email verification", "error", err)
+		}
+	}
 
-	return nil
+	return after, nil
 }
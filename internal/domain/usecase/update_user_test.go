@@ -1,7 +1,6 @@
 package usecase
 
 import (
-	"context"
 	"testing"
 
 	"github.com/bool64/ctxd"
@@ -25,36 +24,105 @@ func TestUpdateUser_UpdateUser(t *testing.T) {
 		Country:   "UK",
 	}
 
+	fields := model.UserFields{model.UserFieldFirstName, model.UserFieldLastName, model.UserFieldNickname, model.UserFieldCountry}
+
 	t.Run("success", func(t *testing.T) {
 		t.Parallel()
 
 		updater := mocks.NewUserUpdater(t)
-		updater.EXPECT().UpdateUser(mock.Anything, uID, userState).Return(nil)
+		updater.EXPECT().UpdateUser(mock.Anything, uID, userState, fields, int64(0)).Return(nil)
 
 		notifier := mocks.NewUserUpdatedNotifier(t)
-		notifier.EXPECT().NotifyUserUpdated(mock.Anything, uID, userState).Return(nil)
+		notifier.EXPECT().NotifyUserUpdated(mock.Anything, uID, model.UserState{}).Return(nil)
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID}, nil)
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, mock.Anything).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
 
 		logger := &ctxd.LoggerMock{}
 
-		uc := NewUpdateUser(updater, notifier, logger)
+		uc := NewUpdateUser(tx, updater, finder, mocks.NewPasswordHasher(t), notifier, auditor, mocks.NewVerificationRequester(t), mocks.NewPasswordResetDiscarder(t), logger)
 
-		err := uc.UpdateUser(context.Background(), uID, userState)
+		u, err := uc.UpdateUser(asAdmin(), uID, userState, fields, "", 0)
 		require.NoError(t, err)
+		require.Equal(t, &model.User{ID: uID}, u)
+	})
+
+	t.Run("clear nickname", func(t *testing.T) {
+		t.Parallel()
+
+		cleared := model.UserFields{model.UserFieldNickname}
+
+		updater := mocks.NewUserUpdater(t)
+		updater.EXPECT().UpdateUser(mock.Anything, uID, model.UserState{}, cleared, int64(0)).Return(nil)
+
+		notifier := mocks.NewUserUpdatedNotifier(t)
+		notifier.EXPECT().NotifyUserUpdated(mock.Anything, uID, model.UserState{FirstName: "Alice"}).Return(nil)
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID, UserState: model.UserState{FirstName: "Alice", Nickname: "AB123"}}, nil).Once()
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID, UserState: model.UserState{FirstName: "Alice"}}, nil).Once()
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, &model.AuditEntry{
+			UserID:  uID,
+			Action:  model.AuditUserUpdated,
+			Actor:   "admin",
+			Changes: map[string]model.AuditChange{"nickname": {Before: "AB123"}},
+		}).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewUpdateUser(tx, updater, finder, mocks.NewPasswordHasher(t), notifier, auditor, mocks.NewVerificationRequester(t), mocks.NewPasswordResetDiscarder(t), &ctxd.LoggerMock{})
+
+		u, err := uc.UpdateUser(asAdmin(), uID, model.UserState{}, cleared, "", 0)
+		require.NoError(t, err)
+		require.Empty(t, u.Nickname)
+	})
+
+	t.Run("no fields", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID}, nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewUpdateUser(tx, mocks.NewUserUpdater(t), finder, mocks.NewPasswordHasher(t), mocks.NewUserUpdatedNotifier(t), mocks.NewAuditRecorder(t), mocks.NewVerificationRequester(t), mocks.NewPasswordResetDiscarder(t), &ctxd.LoggerMock{})
+
+		u, err := uc.UpdateUser(actingAs(uID, model.RoleUser), uID, model.UserState{}, nil, "", 0)
+		require.NoError(t, err)
+		require.Equal(t, &model.User{ID: uID}, u)
 	})
 
 	t.Run("error updater", func(t *testing.T) {
 		t.Parallel()
 
 		updater := mocks.NewUserUpdater(t)
-		updater.EXPECT().UpdateUser(mock.Anything, uID, userState).Return(assert.AnError)
+		updater.EXPECT().UpdateUser(mock.Anything, uID, userState, fields, int64(0)).Return(assert.AnError)
 
 		notifier := mocks.NewUserUpdatedNotifier(t)
 
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID}, nil)
+
+		auditor := mocks.NewAuditRecorder(t)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
 		logger := &ctxd.LoggerMock{}
 
-		uc := NewUpdateUser(updater, notifier, logger)
+		uc := NewUpdateUser(tx, updater, finder, mocks.NewPasswordHasher(t), notifier, auditor, mocks.NewVerificationRequester(t), mocks.NewPasswordResetDiscarder(t), logger)
 
-		err := uc.UpdateUser(context.Background(), uID, userState)
+		_, err := uc.UpdateUser(asAdmin(), uID, userState, fields, "", 0)
 		require.Error(t, err)
 		require.ErrorIs(t, err, assert.AnError)
 	})
@@ -63,17 +131,223 @@ func TestUpdateUser_UpdateUser(t *testing.T) {
 		t.Parallel()
 
 		updater := mocks.NewUserUpdater(t)
-		updater.EXPECT().UpdateUser(mock.Anything, uID, userState).Return(nil)
+		updater.EXPECT().UpdateUser(mock.Anything, uID, userState, fields, int64(0)).Return(nil)
 
 		notifier := mocks.NewUserUpdatedNotifier(t)
-		notifier.EXPECT().NotifyUserUpdated(mock.Anything, uID, userState).Return(assert.AnError)
+		notifier.EXPECT().NotifyUserUpdated(mock.Anything, uID, model.UserState{}).Return(assert.AnError)
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID}, nil)
+
+		auditor := mocks.NewAuditRecorder(t)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
 
 		logger := &ctxd.LoggerMock{}
 
-		uc := NewUpdateUser(updater, notifier, logger)
+		uc := NewUpdateUser(tx, updater, finder, mocks.NewPasswordHasher(t), notifier, auditor, mocks.NewVerificationRequester(t), mocks.NewPasswordResetDiscarder(t), logger)
 
-		err := uc.UpdateUser(context.Background(), uID, userState)
+		_, err := uc.UpdateUser(asAdmin(), uID, userState, fields, "", 0)
 		require.Error(t, err)
 		require.ErrorIs(t, err, assert.AnError)
 	})
+
+	t.Run("password", func(t *testing.T) {
+		t.Parallel()
+
+		hash := "$argon2id$v=19$m=65536,t=3,p=2$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"
+
+		hashed := userState
+		hashed.PasswordHash = hash
+
+		withPassword := append(model.UserFields{model.UserFieldPassword}, fields...)
+
+		hasher := mocks.NewPasswordHasher(t)
+		hasher.EXPECT().Hash("s3cr3t-passw0rd").Return(hash, nil)
+
+		updater := mocks.NewUserUpdater(t)
+		updater.EXPECT().UpdateUser(mock.Anything, uID, hashed, withPassword, int64(0)).Return(nil)
+
+		notifier := mocks.NewUserUpdatedNotifier(t)
+		notifier.EXPECT().NotifyUserUpdated(mock.Anything, uID, model.UserState{}).Return(nil)
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID}, nil)
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, mock.Anything).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		logger := &ctxd.LoggerMock{}
+
+		uc := NewUpdateUser(tx, updater, finder, hasher, notifier, auditor, mocks.NewVerificationRequester(t), mocks.NewPasswordResetDiscarder(t), logger)
+
+		_, err := uc.UpdateUser(asAdmin(), uID, userState, withPassword, "s3cr3t-passw0rd", 0)
+		require.NoError(t, err)
+	})
+
+	t.Run("email", func(t *testing.T) {
+		t.Parallel()
+
+		changed := userState
+		changed.Email = "alice@bob.com"
+
+		withEmail := append(model.UserFields{model.UserFieldEmail}, fields...)
+
+		for name, err := range map[string]error{
+			"changed":   nil,
+			"unchanged": ErrEmailAlreadyVerified,
+		} {
+			before := &model.User{ID: uID, UserState: model.UserState{Email: changed.Email}}
+			resets := mocks.NewPasswordResetDiscarder(t)
+
+			if err == nil {
+				before = &model.User{ID: uID, UserState: model.UserState{Email: "alice@example.com"}}
+
+				resets.EXPECT().Discard(mock.Anything, uID).Return(nil)
+			}
+
+			updater := mocks.NewUserUpdater(t)
+			updater.EXPECT().UpdateUser(mock.Anything, uID, changed, withEmail, int64(0)).Return(nil)
+
+			notifier := mocks.NewUserUpdatedNotifier(t)
+			notifier.EXPECT().NotifyUserUpdated(mock.Anything, uID, model.UserState{Email: changed.Email}).Return(nil)
+
+			finder := mocks.NewUserFinder(t)
+			finder.EXPECT().FindByID(mock.Anything, uID).Return(before, nil).Once()
+			finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID, UserState: model.UserState{Email: changed.Email}}, nil).Once()
+
+			auditor := mocks.NewAuditRecorder(t)
+			auditor.EXPECT().RecordAudit(mock.Anything, mock.Anything).Return(nil)
+
+			tx := mocks.NewTransactor(t)
+			tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+			verification := mocks.NewVerificationRequester(t)
+			verification.EXPECT().RequestVerification(mock.Anything, uID).Return(err)
+
+			logger := &ctxd.LoggerMock{}
+
+			uc := NewUpdateUser(tx, updater, finder, mocks.NewPasswordHasher(t), notifier, auditor, verification, resets, logger)
+
+			_, err := uc.UpdateUser(asAdmin(), uID, changed, withEmail, "", 0)
+			require.NoError(t, err, name)
+			require.NotContains(t, logger.String(), "request email verification", name)
+		}
+	})
+
+	t.Run("self", func(t *testing.T) {
+		t.Parallel()
+
+		updater := mocks.NewUserUpdater(t)
+		updater.EXPECT().UpdateUser(mock.Anything, uID, userState, fields, int64(0)).Return(nil)
+
+		notifier := mocks.NewUserUpdatedNotifier(t)
+		notifier.EXPECT().NotifyUserUpdated(mock.Anything, uID, model.UserState{}).Return(nil)
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID}, nil)
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, mock.Anything).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewUpdateUser(tx, updater, finder, mocks.NewPasswordHasher(t), notifier, auditor, mocks.NewVerificationRequester(t), mocks.NewPasswordResetDiscarder(t), &ctxd.LoggerMock{})
+
+		_, err := uc.UpdateUser(actingAs(uID, model.RoleUser), uID, userState, fields, "", 0)
+		require.NoError(t, err)
+	})
+
+	t.Run("audit", func(t *testing.T) {
+		t.Parallel()
+
+		before := &model.User{ID: uID, UserState: model.UserState{FirstName: "Alice", Nickname: "AB123", PasswordHash: "hash"}}
+		after := &model.User{ID: uID, UserState: model.UserState{FirstName: "Alicia", Nickname: "AB123", PasswordHash: "hash2"}}
+
+		updater := mocks.NewUserUpdater(t)
+		updater.EXPECT().UpdateUser(mock.Anything, uID, userState, fields, int64(0)).Return(nil)
+
+		notifier := mocks.NewUserUpdatedNotifier(t)
+		notifier.EXPECT().NotifyUserUpdated(mock.Anything, uID, after.UserState).Return(nil)
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(before, nil).Once()
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(after, nil).Once()
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, &model.AuditEntry{
+			UserID:    uID,
+			Action:    model.AuditUserUpdated,
+			Actor:     "admin",
+			RequestID: "req-1",
+			SourceIP:  "10.0.0.1",
+			Changes: map[string]model.AuditChange{
+				"first_name":    {Before: "Alice", After: "Alicia"},
+				"password_hash": {Before: model.RedactedValue, After: model.RedactedValue},
+			},
+		}).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewUpdateUser(tx, updater, finder, mocks.NewPasswordHasher(t), notifier, auditor, mocks.NewVerificationRequester(t), mocks.NewPasswordResetDiscarder(t), &ctxd.LoggerMock{})
+
+		ctx := ContextWithRequest(asAdmin(), model.RequestInfo{ID: "req-1", SourceIP: "10.0.0.1"})
+
+		_, err := uc.UpdateUser(ctx, uID, userState, fields, "", 0)
+		require.NoError(t, err)
+	})
+
+	t.Run("version", func(t *testing.T) {
+		t.Parallel()
+
+		updater := mocks.NewUserUpdater(t)
+		updater.EXPECT().UpdateUser(mock.Anything, uID, userState, fields, int64(3)).Return(nil)
+
+		notifier := mocks.NewUserUpdatedNotifier(t)
+		notifier.EXPECT().NotifyUserUpdated(mock.Anything, uID, model.UserState{}).Return(nil)
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID, Version: 3}, nil)
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, mock.Anything).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewUpdateUser(tx, updater, finder, mocks.NewPasswordHasher(t), notifier, auditor, mocks.NewVerificationRequester(t), mocks.NewPasswordResetDiscarder(t), &ctxd.LoggerMock{})
+
+		_, err := uc.UpdateUser(asAdmin(), uID, userState, fields, "", 3)
+		require.NoError(t, err)
+	})
+
+	t.Run("version mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewUserFinder(t)
+		finder.EXPECT().FindByID(mock.Anything, uID).Return(&model.User{ID: uID, Version: 4}, nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewUpdateUser(tx, mocks.NewUserUpdater(t), finder, mocks.NewPasswordHasher(t), mocks.NewUserUpdatedNotifier(t), mocks.NewAuditRecorder(t), mocks.NewVerificationRequester(t), mocks.NewPasswordResetDiscarder(t), &ctxd.LoggerMock{})
+
+		_, err := uc.UpdateUser(asAdmin(), uID, userState, fields, "", 3)
+		require.ErrorIs(t, err, ErrVersionMismatch)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		t.Parallel()
+
+		uc := NewUpdateUser(mocks.NewTransactor(t), mocks.NewUserUpdater(t), mocks.NewUserFinder(t), mocks.NewPasswordHasher(t), mocks.NewUserUpdatedNotifier(t), mocks.NewAuditRecorder(t), mocks.NewVerificationRequester(t), mocks.NewPasswordResetDiscarder(t), &ctxd.LoggerMock{})
+
+		_, err := uc.UpdateUser(actingAs(uuid.New(), model.RoleUser, model.RoleSupport), uID, userState, fields, "", 0)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
 }
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+// ErrInvalidVerificationToken occurs when the verification token is unknown, expired, already used or issued for an
+// email the user changed since.
+var ErrInvalidVerificationToken = errors.New("invalid verification token")
+
+//go:generate mockery --name=EmailVerifier --outpkg=mocks --output=mocks --filename=email_verifier.go --with-expecter
+
+// EmailVerifier defines functionality to verify the email of the users by token.
+type EmailVerifier interface {
+	// Verify uses the token, marking the email it was issued for as verified. Returns the user the email belongs to.
+	Verify(ctx context.Context, token string) (model.UserID, error)
+}
+
+// VerifyEmail is a use case to verify the email of a user with the token delivered to it.
+type VerifyEmail struct {
+	tx       Transactor
+	verifier EmailVerifier
+	auditor  AuditRecorder
+
+	logger ctxd.Logger
+}
+
+// NewVerifyEmail creates a new VerifyEmail use case.
+func NewVerifyEmail(tx Transactor, verifier EmailVerifier, auditor AuditRecorder, logger ctxd.Logger) *VerifyEmail {
+	return &VerifyEmail{
+		tx:       tx,
+		verifier: verifier,
+		auditor:  auditor,
+		logger:   logger,
+	}
+}
+
+// VerifyEmail executes the verify email use case.
+//
+// The token proves the ownership of the email, no actor is required. The verification is audited as performed by
+// the user, within the same transaction.
+func (v *VerifyEmail) VerifyEmail(ctx context.Context, token string) error {
+	ctx = ctxd.AddFields(ctx, "use_case", "VerifyEmail")
+
+	var id model.UserID
+
+	err := v.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		id, err = v.verifier.Verify(ctx, token)
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "verify email")
+		}
+
+		return record(ctx, v.auditor, &model.AuditEntry{
+			UserID:  id,
+			Action:  model.AuditUserEmailVerified,
+			Actor:   id.String(),
+			Changes: map[string]model.AuditChange{"email_verified": {Before: "false", After: "true"}},
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx = ctxd.AddFields(ctx, "user_id", id)
+
+	v.logger.Debug(ctx, "email verified")
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyEmail_VerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		uID := uuid.New()
+
+		verifier := mocks.NewEmailVerifier(t)
+		verifier.EXPECT().Verify(mock.Anything, "token").Return(uID, nil)
+
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, &model.AuditEntry{
+			UserID:  uID,
+			Action:  model.AuditUserEmailVerified,
+			Actor:   uID.String(),
+			Changes: map[string]model.AuditChange{"email_verified": {Before: "false", After: "true"}},
+		}).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewVerifyEmail(tx, verifier, auditor, &ctxd.LoggerMock{})
+
+		// No actor, the token proves the ownership of the email.
+		require.NoError(t, uc.VerifyEmail(context.Background(), "token"))
+	})
+
+	t.Run("invalid verification token", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := mocks.NewEmailVerifier(t)
+		verifier.EXPECT().Verify(mock.Anything, "token").Return(uuid.Nil, ErrInvalidVerificationToken)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewVerifyEmail(tx, verifier, mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		require.ErrorIs(t, uc.VerifyEmail(context.Background(), "token"), ErrInvalidVerificationToken)
+	})
+}
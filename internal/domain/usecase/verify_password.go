@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/go-grpc-service/database"
+)
+
+// ErrInvalidCredentials occurs when the email is unknown or the password does not match.
+//
+// Both cases are reported with the same error, not to reveal which emails are registered.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+//go:generate mockery --name=PasswordHasher --outpkg=mocks --output=mocks --filename=password_hasher.go --with-expecter
+
+// PasswordHasher defines functionality to hash and verify passwords.
+type PasswordHasher interface {
+	// Hash hashes the password with a random salt.
+	Hash(password string) (string, error)
+	// Verify reports whether the password matches the hash, and whether the hash must be rehashed as it uses a legacy
+	// scheme or outdated parameters.
+	Verify(password, hash string) (match, rehash bool, err error)
+}
+
+//go:generate mockery --name=CredentialsFinder --outpkg=mocks --output=mocks --filename=credentials_finder.go --with-expecter
+
+// CredentialsFinder defines functionality to find a user by the email the user logs in with.
+type CredentialsFinder interface {
+	FindByEmail(ctx context.Context, email string) (*model.User, error)
+}
+
+//go:generate mockery --name=PasswordHashUpdater --outpkg=mocks --output=mocks --filename=password_hash_updater.go --with-expecter
+
+// PasswordHashUpdater defines functionality to update the password hash of a user.
+type PasswordHashUpdater interface {
+	UpdatePasswordHash(ctx context.Context, id model.UserID, hash string) error
+}
+
+// VerifyPassword is a domain service to verify the password of a user.
+type VerifyPassword struct {
+	tx      Transactor
+	finder  CredentialsFinder
+	updater PasswordHashUpdater
+	hasher  PasswordHasher
+	auditor AuditRecorder
+
+	logger ctxd.Logger
+}
+
+// NewVerifyPassword creates a new VerifyPassword domain service.
+func NewVerifyPassword(tx Transactor, finder CredentialsFinder, updater PasswordHashUpdater, hasher PasswordHasher, auditor AuditRecorder, logger ctxd.Logger) *VerifyPassword {
+	return &VerifyPassword{
+		tx:      tx,
+		finder:  finder,
+		updater: updater,
+		hasher:  hasher,
+		auditor: auditor,
+		logger:  logger,
+	}
+}
+
+// VerifyPassword verifies the password of the user with the email, returning the user when it matches.
+//
+// A hash using a legacy scheme or outdated parameters is transparently rehashed and audited as performed by the user,
+// failing to store the new hash does not fail the verification, it is retried on the next one.
+func (v *VerifyPassword) VerifyPassword(ctx context.Context, email, password string) (*model.User, error) {
+	ctx = ctxd.AddFields(ctx, "use_case", "VerifyPassword")
+
+	u, err := v.finder.FindByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			// Spend the time of a verification, not to reveal the email is unknown.
+			_, _ = v.hasher.Hash(password) //nolint:errcheck
+
+			return nil, ErrInvalidCredentials
+		}
+
+		return nil, ctxd.WrapError(ctx, err, "find user by email")
+	}
+
+	ctx = ctxd.AddFields(ctx, "user_id", u.ID)
+
+	match, rehash, err := v.hasher.Verify(password, u.PasswordHash)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "verify password")
+	}
+
+	if !match {
+		return nil, ErrInvalidCredentials
+	}
+
+	if rehash {
+		v.rehash(ctx, u, password)
+	}
+
+	v.logger.Debug(ctx, "password verified")
+
+	return u, nil
+}
+
+// rehash hashes the password with the current scheme and parameters, replacing the stored hash.
+func (v *VerifyPassword) rehash(ctx context.Context, u *model.User, password string) {
+	hash, err := v.hasher.Hash(password)
+	if err != nil {
+		v.logger.Warn(ctx, "rehash password", "error", err)
+
+		return
+	}
+
+	err = v.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := v.updater.UpdatePasswordHash(ctx, u.ID, hash); err != nil {
+			return ctxd.WrapError(ctx, err, "update password hash")
+		}
+
+		if err := auditPasswordHash(ctx, v.auditor, u.ID, model.AuditUserPasswordRehashed); err != nil {
+			return ctxd.WrapError(ctx, err, "audit password rehashed")
+		}
+
+		return nil
+	})
+	if err != nil {
+		v.logger.Warn(ctx, "store rehashed password", "error", err)
+
+		return
+	}
+
+	u.PasswordHash = hash
+
+	v.logger.Debug(ctx, "password rehashed")
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyPassword_VerifyPassword(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	email := "alice@bob.com"
+	password := "s3cr3t-passw0rd"
+	hash := "$argon2id$v=19$m=65536,t=3,p=2$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"
+
+	newUser := func(hash string) *model.User {
+		return &model.User{
+			ID: id,
+			UserState: model.UserState{
+				PasswordHash: hash,
+				Email:        email,
+			},
+		}
+	}
+
+	t.Run("match", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewCredentialsFinder(t)
+		finder.EXPECT().FindByEmail(mock.Anything, email).Return(newUser(hash), nil)
+
+		hasher := mocks.NewPasswordHasher(t)
+		hasher.EXPECT().Verify(password, hash).Return(true, false, nil)
+
+		uc := NewVerifyPassword(mocks.NewTransactor(t), finder, mocks.NewPasswordHashUpdater(t), hasher, mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		u, err := uc.VerifyPassword(context.Background(), email, password)
+		require.NoError(t, err)
+		require.Equal(t, id, u.ID)
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewCredentialsFinder(t)
+		finder.EXPECT().FindByEmail(mock.Anything, email).Return(newUser(hash), nil)
+
+		hasher := mocks.NewPasswordHasher(t)
+		hasher.EXPECT().Verify("wrong", hash).Return(false, false, nil)
+
+		uc := NewVerifyPassword(mocks.NewTransactor(t), finder, mocks.NewPasswordHashUpdater(t), hasher, mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		u, err := uc.VerifyPassword(context.Background(), email, "wrong")
+		require.ErrorIs(t, err, ErrInvalidCredentials)
+		require.Nil(t, u)
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewCredentialsFinder(t)
+		finder.EXPECT().FindByEmail(mock.Anything, email).Return(nil, database.ErrNotFound)
+
+		hasher := mocks.NewPasswordHasher(t)
+		hasher.EXPECT().Hash(password).Return(hash, nil)
+
+		uc := NewVerifyPassword(mocks.NewTransactor(t), finder, mocks.NewPasswordHashUpdater(t), hasher, mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		u, err := uc.VerifyPassword(context.Background(), email, password)
+		require.ErrorIs(t, err, ErrInvalidCredentials)
+		require.Nil(t, u)
+	})
+
+	t.Run("error finder", func(t *testing.T) {
+		t.Parallel()
+
+		finder := mocks.NewCredentialsFinder(t)
+		finder.EXPECT().FindByEmail(mock.Anything, email).Return(nil, assert.AnError)
+
+		uc := NewVerifyPassword(mocks.NewTransactor(t), finder, mocks.NewPasswordHashUpdater(t), mocks.NewPasswordHasher(t), mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		_, err := uc.VerifyPassword(context.Background(), email, password)
+		require.ErrorIs(t, err, assert.AnError)
+		require.NotErrorIs(t, err, ErrInvalidCredentials)
+	})
+
+	t.Run("rehash legacy", func(t *testing.T) {
+		t.Parallel()
+
+		legacy := "f8ba1fcbed0e0db6e7fbd4bd6b9b0ba9a8e28a3ab1b1c2e2a37d9ab6c8a1cd3e"
+
+		finder := mocks.NewCredentialsFinder(t)
+		finder.EXPECT().FindByEmail(mock.Anything, email).Return(newUser(legacy), nil)
+
+		hasher := mocks.NewPasswordHasher(t)
+		hasher.EXPECT().Verify(password, legacy).Return(true, true, nil)
+		hasher.EXPECT().Hash(password).Return(hash, nil)
+
+		updater := mocks.NewPasswordHashUpdater(t)
+		updater.EXPECT().UpdatePasswordHash(mock.Anything, id, hash).Return(nil)
+
+		// Audited as performed by the user, logging in.
+		auditor := mocks.NewAuditRecorder(t)
+		auditor.EXPECT().RecordAudit(mock.Anything, &model.AuditEntry{
+			UserID:  id,
+			Action:  model.AuditUserPasswordRehashed,
+			Actor:   id.String(),
+			Changes: map[string]model.AuditChange{"password_hash": {Before: model.RedactedValue, After: model.RedactedValue}},
+		}).Return(nil)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewVerifyPassword(tx, finder, updater, hasher, auditor, &ctxd.LoggerMock{})
+
+		u, err := uc.VerifyPassword(context.Background(), email, password)
+		require.NoError(t, err)
+		require.Equal(t, hash, u.PasswordHash)
+	})
+
+	t.Run("rehash store error", func(t *testing.T) {
+		t.Parallel()
+
+		legacy := "f8ba1fcbed0e0db6e7fbd4bd6b9b0ba9a8e28a3ab1b1c2e2a37d9ab6c8a1cd3e"
+
+		finder := mocks.NewCredentialsFinder(t)
+		finder.EXPECT().FindByEmail(mock.Anything, email).Return(newUser(legacy), nil)
+
+		hasher := mocks.NewPasswordHasher(t)
+		hasher.EXPECT().Verify(password, legacy).Return(true, true, nil)
+		hasher.EXPECT().Hash(password).Return(hash, nil)
+
+		updater := mocks.NewPasswordHashUpdater(t)
+		updater.EXPECT().UpdatePasswordHash(mock.Anything, id, hash).Return(assert.AnError)
+
+		tx := mocks.NewTransactor(t)
+		tx.EXPECT().InTx(mock.Anything, mock.Anything).RunAndReturn(inTx)
+
+		uc := NewVerifyPassword(tx, finder, updater, hasher, mocks.NewAuditRecorder(t), &ctxd.LoggerMock{})
+
+		u, err := uc.VerifyPassword(context.Background(), email, password)
+		require.NoError(t, err)
+		require.Equal(t, legacy, u.PasswordHash)
+	})
+}
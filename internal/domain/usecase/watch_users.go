@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+// ErrInvalidResumeToken occurs when the resume token is malformed.
+var ErrInvalidResumeToken = errors.New("invalid resume token")
+
+// ErrSlowSubscriber occurs when the subscriber does not keep up with the user events.
+var ErrSlowSubscriber = errors.New("slow subscriber")
+
+//go:generate mockery --name=UserEventsStreamer --outpkg=mocks --output=mocks --filename=user_events_streamer.go --with-expecter
+
+// UserEventsStreamer defines functionality to stream the user events.
+type UserEventsStreamer interface {
+	// StreamUserEvents calls fn with every user event after the one of the resume token, or with the events happening
+	// from now on when the token is empty, until the context is done or fn fails.
+	StreamUserEvents(ctx context.Context, resumeToken string, fn func(e model.UserEvent) error) error
+}
+
+// WatchUsers is a use case to watch the user changes.
+type WatchUsers struct {
+	streamer UserEventsStreamer
+
+	logger ctxd.Logger
+}
+
+// NewWatchUsers creates a new WatchUsers use case.
+func NewWatchUsers(streamer UserEventsStreamer, logger ctxd.Logger) *WatchUsers {
+	return &WatchUsers{
+		streamer: streamer,
+		logger:   logger,
+	}
+}
+
+// WatchUsers executes the watch users use case.
+//
+// The events are filtered by the country of the user when country is not empty.
+func (w *WatchUsers) WatchUsers(ctx context.Context, country, resumeToken string, fn func(e model.UserEvent) error) error {
+	ctx = ctxd.AddFields(ctx, "use_case", "WatchUsers", "country", country)
+
+	if _, err := authorize(ctx, model.PermissionReadUsers); err != nil {
+		return ctxd.WrapError(ctx, err, "authorize")
+	}
+
+	w.logger.Debug(ctx, "watching users")
+
+	err := w.streamer.StreamUserEvents(ctx, resumeToken, func(e model.UserEvent) error {
+		if country != "" && e.Country != country {
+			return nil
+		}
+
+		return fn(e)
+	})
+	if err != nil {
+		return ctxd.WrapError(ctx, err, "stream user events") // error contains the context fields added
+	}
+
+	return nil
+}
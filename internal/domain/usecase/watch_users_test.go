@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchUsers_WatchUsers(t *testing.T) {
+	t.Parallel()
+
+	events := []model.UserEvent{
+		{ID: uuid.New(), Type: model.UserAddedV1, Country: "UK", ResumeToken: "1"},
+		{ID: uuid.New(), Type: model.UserUpdatedV1, Country: "DE", ResumeToken: "2"},
+		{ID: uuid.New(), Type: model.UserDeletedV1, Country: "UK", ResumeToken: "3"},
+	}
+
+	stream := func(_ context.Context, _ string, fn func(e model.UserEvent) error) error {
+		for _, e := range events {
+			if err := fn(e); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	t.Run("success, filtered by country", func(t *testing.T) {
+		t.Parallel()
+
+		streamer := mocks.NewUserEventsStreamer(t)
+		streamer.EXPECT().StreamUserEvents(mock.Anything, "", mock.Anything).RunAndReturn(stream)
+
+		uc := NewWatchUsers(streamer, &ctxd.LoggerMock{})
+
+		var received []string
+
+		err := uc.WatchUsers(asAdmin(), "UK", "", func(e model.UserEvent) error {
+			received = append(received, e.ResumeToken)
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"1", "3"}, received)
+	})
+
+	t.Run("success, all countries", func(t *testing.T) {
+		t.Parallel()
+
+		streamer := mocks.NewUserEventsStreamer(t)
+		streamer.EXPECT().StreamUserEvents(mock.Anything, "1", mock.Anything).RunAndReturn(stream)
+
+		uc := NewWatchUsers(streamer, &ctxd.LoggerMock{})
+
+		var received int
+
+		err := uc.WatchUsers(asAdmin(), "", "1", func(_ model.UserEvent) error {
+			received++
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, received)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		streamer := mocks.NewUserEventsStreamer(t)
+		streamer.EXPECT().StreamUserEvents(mock.Anything, "", mock.Anything).RunAndReturn(stream)
+
+		uc := NewWatchUsers(streamer, &ctxd.LoggerMock{})
+
+		err := uc.WatchUsers(asAdmin(), "", "", func(_ model.UserEvent) error {
+			return assert.AnError
+		})
+		require.Error(t, err)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -0,0 +1,20 @@
+package app
+
+import (
+	"time"
+
+	sapp "github.com/dohernandez/go-grpc-service/app"
+)
+
+// providedClock resolves the clock of the locator on every call.
+//
+// The clock provider can be replaced once the dependencies are set up, the integration tests do it to control the
+// time, the dependencies keep following it.
+type providedClock struct {
+	l *sapp.Locator
+}
+
+// Now returns the current time of the provided clock.
+func (c providedClock) Now() time.Time {
+	return c.l.Clock().Now()
+}
@@ -1,34 +1,108 @@
 package app
 
 import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/auth"
 	"github.com/dohernandez/faceit/internal/platform/config"
+	"github.com/dohernandez/faceit/internal/platform/credentials"
+	"github.com/dohernandez/faceit/internal/platform/feed"
 	"github.com/dohernandez/faceit/internal/platform/notifier"
+	"github.com/dohernandez/faceit/internal/platform/outbox"
+	"github.com/dohernandez/faceit/internal/platform/pagetoken"
+	"github.com/dohernandez/faceit/internal/platform/scheduler"
 	"github.com/dohernandez/faceit/internal/platform/service"
 	"github.com/dohernandez/faceit/internal/platform/storage"
 	"github.com/dohernandez/faceit/resources/swagger"
 	sapp "github.com/dohernandez/go-grpc-service/app"
 	"github.com/dohernandez/servers"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/nhatthm/go-clock"
+)
+
+var (
+	// ErrUnknownNotifier occurs when the configured notifier is not supported.
+	ErrUnknownNotifier = errors.New("unknown notifier")
+	// ErrUnknownDeletionNotifyOn occurs when the configured moment the deletions are notified at is not supported.
+	ErrUnknownDeletionNotifyOn = errors.New("unknown deletion notify on")
+	// ErrDeletionGracePeriod occurs when the deleted users would be purged before the end of their grace period.
+	ErrDeletionGracePeriod = errors.New("deletion grace period longer than retention")
+	// ErrTrustedIssuer occurs when the issuer of the trusted keys is the issuer of the service.
+	ErrTrustedIssuer = errors.New("trusted issuer is the service issuer")
 )
 
 // Locator defines application resources.
 type Locator struct {
 	*sapp.Locator
 
-	cfg *config.Config
+	cfg   *config.Config
+	clock clock.Clock
 
 	FaceitService *service.FaceitService
 
-	notifierUser *notifier.NoopNotifier
+	// InProcessBroker is the broker the user events are published to when the broker notifier is configured.
+	InProcessBroker *notifier.InProcessBroker
+
+	notifierUser         outbox.Notifier
+	notifierVerification usecase.VerificationRequestedNotifier
+	notifierReset        usecase.PasswordResetRequestedNotifier
+	notifierDelete       usecase.UserDeletedNotifier
+	notifierPurge        usecase.UserDeletedNotifier
+	notifierRestore      usecase.UserRestoredNotifier
+	pageTokens           *pagetoken.Signer
+	hasher               *credentials.Hasher
+	sessions             *auth.Sessions
+	lockout              *auth.Lockout
+	verifications        *auth.Verifications
+	passwordResets       *auth.PasswordResets
+	resetLimiter         *auth.PasswordResetLimiter
+	authenticator        *auth.Authenticator
 
 	// storages
-	storageUser *storage.User
+	storageUser          *storage.User
+	storageOutbox        *storage.Outbox
+	storageSession       *storage.Session
+	storageLockout       *storage.Lockout
+	storageRole          *storage.Role
+	storageVerification  *storage.Verification
+	storagePasswordReset *storage.PasswordReset
+	storageRateLimit     *storage.RateLimiter
+	storagePersonalData  *storage.PersonalData
+	storageAudit         *storage.Audit
+	storageIdempotency   *storage.Idempotency
+
+	// workers
+	outboxRelay *outbox.Relay
+	userFeed    *feed.Feed
+	scheduler   *scheduler.Scheduler
 
 	// use cases
-	ucAddUser           *usecase.AddUser
-	ucUpdateUser        *usecase.UpdateUser
-	usDeleteUser        *usecase.DeleteUser
-	usListUserByCountry *usecase.ListUsersByCountry
+	ucAddUser              *usecase.AddUser
+	ucUpdateUser           *usecase.UpdateUser
+	usDeleteUser           *usecase.DeleteUser
+	ucGetUser              *usecase.GetUser
+	usListUserByCountry    *usecase.ListUsersByCountry
+	ucListUsers            *usecase.ListUsers
+	ucWatchUsers           *usecase.WatchUsers
+	ucAuthenticate         *usecase.Authenticate
+	ucRefreshSession       *usecase.RefreshSession
+	ucRevokeSession        *usecase.RevokeSession
+	ucVerifyEmail          *usecase.VerifyEmail
+	ucResendVerification   *usecase.ResendVerification
+	ucRequestPasswordReset *usecase.RequestPasswordReset
+	ucResetPassword        *usecase.ResetPassword
+	ucChangeUserStatus     *usecase.ChangeUserStatus
+	ucLiftExpiredStatuses  *usecase.LiftExpiredStatuses
+	ucUndeleteUser         *usecase.UndeleteUser
+	ucPurgeDeletedUsers    *usecase.PurgeDeletedUsers
+	ucExportUserData       *usecase.ExportUserData
+	ucEraseUser            *usecase.EraseUser
+	ucListUserAuditEvents  *usecase.ListUserAuditEvents
 }
 
 // NewServiceLocator creates application locator.
@@ -41,16 +115,47 @@ func NewServiceLocator(cfg *config.Config, opts ...sapp.Option) (*Locator, error
 		return nil, err
 	}
 
+	// The real clock, unless one is provided, the integration tests replace it afterwards.
+	if upl.ClockProvider == nil {
+		upl.ClockProvider = clock.New()
+	}
+
 	l := &Locator{
 		Locator: upl,
 		cfg:     cfg,
+		clock:   providedClock{l: upl},
 	}
 
 	// setting up storage dependencies
 	l.setupStorage()
 
 	// setting up notifier dependencies
-	l.notifierUser = notifier.NewNoopNotifier()
+	if err := l.setupNotifier(); err != nil {
+		return nil, err
+	}
+
+	// setting up deletion dependencies
+	if err := l.setupDeletion(); err != nil {
+		return nil, err
+	}
+
+	// setting up workers dependencies
+	l.setupWorkers()
+
+	// setting up page tokens dependencies
+	if err := l.setupPageTokens(); err != nil {
+		return nil, err
+	}
+
+	// setting up credentials dependencies
+	if err := l.setupCredentials(); err != nil {
+		return nil, err
+	}
+
+	// setting up authorization dependencies
+	if err := l.setupAuthorization(); err != nil {
+		return nil, err
+	}
 
 	// setting up use cases dependencies
 	l.setupUsecaseDependencies()
@@ -58,7 +163,17 @@ func NewServiceLocator(cfg *config.Config, opts ...sapp.Option) (*Locator, error
 	l.FaceitService = service.NewFaceitService(l)
 
 	// Setup services with health check
-	err = l.SetupServices(l.FaceitService, swagger.SwgJSON, servers.WithHealthCheck(l.withHealthChecks()...))
+	err = l.SetupServices(
+		l.FaceitService,
+		swagger.SwgJSON,
+		servers.WithHealthCheck(l.withHealthChecks()...),
+		servers.WithCollector(l.outboxRelay.Metrics()),
+		servers.WithServerMuxOption(
+			runtime.WithIncomingHeaderMatcher(service.IncomingHeaderMatcher),
+			runtime.WithOutgoingHeaderMatcher(service.OutgoingHeaderMatcher),
+			runtime.WithErrorHandler(service.HTTPErrorHandler),
+		),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -69,14 +184,281 @@ func NewServiceLocator(cfg *config.Config, opts ...sapp.Option) (*Locator, error
 // setupStorage sets up storage dependencies (platform).
 func (l *Locator) setupStorage() {
 	l.storageUser = storage.NewUser(l.Storage)
+	l.storageOutbox = storage.NewOutbox(l.Storage)
+	l.storageSession = storage.NewSession(l.Storage)
+	l.storageLockout = storage.NewLockout(l.Storage)
+	l.storageRole = storage.NewRole(l.Storage)
+	l.storageVerification = storage.NewVerification(l.Storage)
+	l.storagePasswordReset = storage.NewPasswordReset(l.Storage)
+	l.storageRateLimit = storage.NewRateLimiter(l.Storage)
+	l.storagePersonalData = storage.NewPersonalData(l.Storage)
+	l.storageAudit = storage.NewAudit(l.Storage)
+	l.storageIdempotency = storage.NewIdempotency(l.Storage)
+}
+
+// setupNotifier sets up the notifier the user events are delivered to (platform).
+//
+// Notifiers wrap the events in CloudEvents. The broker notifier publishes the events to an in-process broker
+// standing in for a NATS or Kafka client, any of them can be plugged in by implementing notifier.Publisher.
+//
+// The verification and password reset links are emailed by the mailer, they are not sent when no SMTP server is
+// configured.
+func (l *Locator) setupNotifier() error {
+	if l.cfg.Mailer.SMTPAddr != "" {
+		m := notifier.NewMailer(l.cfg.Mailer)
+
+		l.notifierVerification = m
+		l.notifierReset = m
+	} else {
+		n := notifier.NewNoopNotifier()
+
+		l.notifierVerification = n
+		l.notifierReset = n
+
+		l.CtxdLogger().Warn(context.Background(), "MAILER_SMTP_ADDR not set, verification and password reset emails are not sent")
+	}
+
+	envelope := notifier.NewEnvelope(l.cfg.EventSource, l.clock)
+
+	switch l.cfg.Notifier {
+	case config.NotifierNoop:
+		l.notifierUser = notifier.NewNoopNotifier()
+	case config.NotifierWebhook:
+		l.notifierUser = notifier.NewWebhook(l.cfg.Webhook, envelope, l.clock)
+	case config.NotifierBroker:
+		l.InProcessBroker = notifier.NewInProcessBroker()
+		l.notifierUser = notifier.NewBroker(l.InProcessBroker, l.cfg.Broker, envelope)
+	default:
+		return fmt.Errorf("%w %q", ErrUnknownNotifier, l.cfg.Notifier)
+	}
+
+	return nil
+}
+
+// setupDeletion sets up the notifiers of the user deletions (platform).
+//
+// Deletions are recorded in the outbox when the users are purged, or when they are tombstoned, the restorations
+// along with them: a restoration is only notified when the deletion was.
+func (l *Locator) setupDeletion() error {
+	if l.cfg.Deletion.GracePeriod > l.cfg.Deletion.Retention {
+		return fmt.Errorf("%w: %s > %s", ErrDeletionGracePeriod, l.cfg.Deletion.GracePeriod, l.cfg.Deletion.Retention)
+	}
+
+	noop := notifier.NewNoopNotifier()
+
+	switch l.cfg.Deletion.NotifyOn {
+	case config.DeletionNotifyOnPurge:
+		l.notifierDelete = noop
+		l.notifierPurge = l.storageOutbox
+		l.notifierRestore = noop
+	case config.DeletionNotifyOnTombstone:
+		l.notifierDelete = l.storageOutbox
+		l.notifierPurge = noop
+		l.notifierRestore = l.storageOutbox
+	default:
+		return fmt.Errorf("%w %q", ErrUnknownDeletionNotifyOn, l.cfg.Deletion.NotifyOn)
+	}
+
+	return nil
+}
+
+// setupWorkers sets up background workers dependencies (platform).
+func (l *Locator) setupWorkers() {
+	l.outboxRelay = outbox.NewRelay(
+		l.storageOutbox,
+		outbox.NewNotifierPublisher(l.notifierUser),
+		l.clock,
+		l.cfg.Outbox,
+	)
+
+	l.outboxRelay.OnError = func(ctx context.Context, err error) {
+		l.CtxdLogger().Error(ctx, "relay outbox", "error", err)
+	}
+
+	l.userFeed = feed.New(l.storageOutbox, l.cfg.EventSource, l.cfg.Feed)
+
+	l.userFeed.OnError = func(ctx context.Context, err error) {
+		l.CtxdLogger().Error(ctx, "read user feed", "error", err)
+	}
+
+	l.scheduler = scheduler.New(l.clock, l.cfg.Scheduler)
+
+	l.scheduler.OnError = func(ctx context.Context, err error) {
+		l.CtxdLogger().Error(ctx, "run scheduled jobs", "error", err)
+	}
+}
+
+// setupPageTokens sets up the signer of the page tokens (platform).
+//
+// Without a configured secret the tokens are signed with a random key, they are then only valid on this instance
+// until it restarts.
+func (l *Locator) setupPageTokens() error {
+	key := []byte(l.cfg.PageTokenSecret)
+
+	if len(key) == 0 {
+		key = make([]byte, 32)
+
+		if _, err := rand.Read(key); err != nil {
+			return fmt.Errorf("generate page token key: %w", err)
+		}
+
+		l.CtxdLogger().Warn(context.Background(), "PAGE_TOKEN_SECRET not set, page tokens are signed with a random key")
+	}
+
+	l.pageTokens = pagetoken.NewSigner(key)
+
+	return nil
+}
+
+// setupCredentials sets up the hasher of the passwords and the sessions of the authenticated users (platform).
+//
+// Without configured signing keys the access tokens are signed with a random key, they are then only valid on this
+// instance until it restarts.
+func (l *Locator) setupCredentials() error {
+	l.hasher = credentials.NewHasher(l.cfg.Argon2)
+
+	var (
+		keys *auth.KeySet
+		err  error
+	)
+
+	if len(l.cfg.Auth.SigningKeys) == 0 {
+		keys, err = auth.GenerateKeySet()
+
+		l.CtxdLogger().Warn(context.Background(), "AUTH_SIGNING_KEYS not set, access tokens are signed with a random key")
+	} else {
+		keys, err = auth.ParseKeySet(l.cfg.Auth.SigningKeys)
+	}
+
+	if err != nil {
+		return fmt.Errorf("setup signing keys: %w", err)
+	}
+
+	l.sessions = auth.NewSessions(l.storageSession, keys, l.clock, l.cfg.Auth)
+	l.lockout = auth.NewLockout(l.storageLockout, l.clock, l.cfg.Auth)
+	l.verifications = auth.NewVerifications(l.storageVerification, l.clock, l.cfg.Auth)
+	l.passwordResets = auth.NewPasswordResets(l.storagePasswordReset, l.clock, l.cfg.Auth)
+	l.resetLimiter = auth.NewPasswordResetLimiter(l.storageRateLimit, l.clock, l.cfg.Auth)
+
+	return nil
+}
+
+// setupAuthorization sets up the authorization of the requests (platform).
+//
+// The access tokens are verified with the signing keys, and the keys of the JWKS file of the trusted issuer when
+// configured. The gRPC calls are authorized after the recovery and logging interceptors of the locator, rejected
+// calls are logged too. The information of the request, audited along with the actor, is carried before. The
+// mutations retried with an idempotency key are replayed after, the keys are scoped by the actor.
+func (l *Locator) setupAuthorization() error {
+	keys := l.sessions.Keys()
+
+	if l.cfg.Auth.JWKSFile != "" {
+		// The tokens of the trusted issuer must not pass for the tokens of the service.
+		if l.cfg.Auth.JWKSIssuer == l.cfg.Auth.Issuer {
+			return fmt.Errorf("%w: %s", ErrTrustedIssuer, l.cfg.Auth.JWKSIssuer)
+		}
+
+		set, err := auth.ReadJWKS(l.cfg.Auth.JWKSFile)
+		if err != nil {
+			return fmt.Errorf("read trusted keys: %w", err)
+		}
+
+		if err := keys.Trust(l.cfg.Auth.JWKSIssuer, set); err != nil {
+			return fmt.Errorf("trust keys: %w", err)
+		}
+	}
+
+	l.authenticator = auth.NewAuthenticator(keys, l.storageRole, l.clock, l.cfg.Auth)
+
+	request, err := service.NewRequestInterceptor(l.cfg.Request)
+	if err != nil {
+		return fmt.Errorf("setup request interceptor: %w", err)
+	}
+
+	interceptor := service.NewAuthInterceptor(l.authenticator)
+	idempotency := service.NewIdempotencyInterceptor(l.storageIdempotency, l.clock, l.cfg.Idempotency, l.CtxdLogger())
+
+	sapp.WithGRPC(
+		servers.WithChainUnaryInterceptor(
+			request.UnaryServerInterceptor,
+			interceptor.UnaryServerInterceptor,
+			idempotency.UnaryServerInterceptor,
+		),
+		servers.WithChainStreamInterceptor(request.StreamServerInterceptor, interceptor.StreamServerInterceptor),
+	)(l.Locator)
+
+	return nil
 }
 
 // setupUsecaseDependencies sets up use case dependencies (domain).
+//
+// User events are recorded in the outbox within the same transaction as the user mutation, the outbox relay delivers
+// them to the notifier. The verification and password reset tokens are not, they are emailed once the user is stored.
+// Every user mutation is audited within its transaction too. The expired suspensions and bans are lifted, and the
+// deleted users purged, by the scheduler. The expired idempotency keys are purged by the scheduler as well.
 func (l *Locator) setupUsecaseDependencies() {
-	l.ucAddUser = usecase.NewAddUser(l.storageUser, l.notifierUser, l.CtxdLogger())
-	l.ucUpdateUser = usecase.NewUpdateUser(l.storageUser, l.notifierUser, l.CtxdLogger())
-	l.usDeleteUser = usecase.NewDeleteUser(l.storageUser, l.notifierUser, l.CtxdLogger())
+	requestVerification := usecase.NewRequestVerification(l.storageUser, l.verifications, l.notifierVerification, l.CtxdLogger())
+
+	l.ucAddUser = usecase.NewAddUser(l.Storage, l.storageUser, l.hasher, l.storageOutbox, l.storageAudit, requestVerification, l.CtxdLogger())
+	l.ucUpdateUser = usecase.NewUpdateUser(l.Storage, l.storageUser, l.storageUser, l.hasher, l.storageOutbox, l.storageAudit, requestVerification, l.passwordResets, l.CtxdLogger())
+	l.usDeleteUser = usecase.NewDeleteUser(l.Storage, l.storageUser, l.storageUser, l.sessions, l.notifierDelete, l.storageAudit, l.CtxdLogger())
+	l.ucGetUser = usecase.NewGetUser(l.storageUser, l.CtxdLogger())
 	l.usListUserByCountry = usecase.NewListUsersByCountry(l.storageUser, l.CtxdLogger())
+	l.ucListUsers = usecase.NewListUsers(l.storageUser, l.CtxdLogger())
+	l.ucWatchUsers = usecase.NewWatchUsers(l.userFeed, l.CtxdLogger())
+
+	verifyPassword := usecase.NewVerifyPassword(l.Storage, l.storageUser, l.storageUser, l.hasher, l.storageAudit, l.CtxdLogger())
+
+	l.ucAuthenticate = usecase.NewAuthenticate(verifyPassword, l.lockout, l.sessions, l.CtxdLogger())
+	l.ucRefreshSession = usecase.NewRefreshSession(l.sessions, l.CtxdLogger())
+	l.ucRevokeSession = usecase.NewRevokeSession(l.sessions, l.CtxdLogger())
+	l.ucVerifyEmail = usecase.NewVerifyEmail(l.Storage, l.verifications, l.storageAudit, l.CtxdLogger())
+	l.ucResendVerification = usecase.NewResendVerification(requestVerification, l.CtxdLogger())
+	l.ucRequestPasswordReset = usecase.NewRequestPasswordReset(l.resetLimiter, l.storageUser, l.passwordResets, l.notifierReset, l.CtxdLogger())
+	l.ucResetPassword = usecase.NewResetPassword(l.Storage, l.passwordResets, l.storageUser, l.hasher, l.sessions, l.storageOutbox, l.storageAudit, l.CtxdLogger())
+	l.ucChangeUserStatus = usecase.NewChangeUserStatus(l.Storage, l.storageUser, l.storageUser, l.sessions, l.storageOutbox, l.storageAudit, l.CtxdLogger())
+	l.ucLiftExpiredStatuses = usecase.NewLiftExpiredStatuses(l.Storage, l.storageUser, l.storageUser, l.storageOutbox, l.storageAudit, l.CtxdLogger())
+
+	l.ucUndeleteUser = usecase.NewUndeleteUser(l.Storage, l.storageUser, l.storageUser, l.notifierRestore, l.storageAudit, l.cfg.Deletion.GracePeriod, l.CtxdLogger())
+	l.ucPurgeDeletedUsers = usecase.NewPurgeDeletedUsers(l.Storage, l.storageUser, l.storageUser, l.notifierPurge, l.storageAudit, l.CtxdLogger())
+
+	l.ucExportUserData = usecase.NewExportUserData(l.storagePersonalData, l.CtxdLogger())
+	l.ucEraseUser = usecase.NewEraseUser(l.Storage, l.storagePersonalData, l.storagePersonalData, l.storageOutbox, l.notifierPurge, l.storageAudit, l.CtxdLogger())
+
+	l.ucListUserAuditEvents = usecase.NewListUserAuditEvents(l.storageAudit, l.CtxdLogger())
+
+	l.scheduler.Add("lift expired statuses", l.ucLiftExpiredStatuses.LiftExpiredStatuses)
+	l.scheduler.Add("purge deleted users", func(ctx context.Context, at time.Time, limit uint64) (int, error) {
+		return l.ucPurgeDeletedUsers.PurgeDeletedUsers(ctx, at.Add(-l.cfg.Deletion.Retention), limit)
+	})
+	l.scheduler.Add("purge expired idempotency keys", l.storageIdempotency.PurgeExpiredIdempotencyKeys)
+}
+
+// StartWorkers starts the background workers, they run until the context is done.
+func (l *Locator) StartWorkers(ctx context.Context) {
+	go l.outboxRelay.Run(ctx)
+	go l.userFeed.Run(ctx)
+	go l.scheduler.Run(ctx)
+}
+
+// SSEConfig returns the Server-Sent Events configuration.
+func (l *Locator) SSEConfig() service.SSEConfig {
+	return l.cfg.SSE
+}
+
+// PageTokens returns the signer of the page tokens.
+func (l *Locator) PageTokens() *pagetoken.Signer {
+	return l.pageTokens
+}
+
+// SigningKeys returns the keys the access tokens are signed with.
+func (l *Locator) SigningKeys() *auth.KeySet {
+	return l.sessions.Keys()
+}
+
+// Authenticator returns the authenticator of the requests.
+func (l *Locator) Authenticator() *auth.Authenticator {
+	return l.authenticator
 }
 
 // AddUser returns the usecase.AddUser use case.
@@ -94,7 +476,82 @@ func (l *Locator) DeleteUser() service.DeleteUser {
 	return l.usDeleteUser
 }
 
+// GetUser returns the usecase.GetUser use case.
+func (l *Locator) GetUser() service.GetUser {
+	return l.ucGetUser
+}
+
 // ListUsersByCountry returns the usecase.ListUsersByCountry use case.
 func (l *Locator) ListUsersByCountry() service.ListUsersByCountry {
 	return l.usListUserByCountry
 }
+
+// ListUsers returns the usecase.ListUsers use case.
+func (l *Locator) ListUsers() service.ListUsers {
+	return l.ucListUsers
+}
+
+// WatchUsers returns the usecase.WatchUsers use case.
+func (l *Locator) WatchUsers() service.WatchUsers {
+	return l.ucWatchUsers
+}
+
+// Authenticate returns the usecase.Authenticate use case.
+func (l *Locator) Authenticate() service.Authenticate {
+	return l.ucAuthenticate
+}
+
+// RefreshSession returns the usecase.RefreshSession use case.
+func (l *Locator) RefreshSession() service.RefreshSession {
+	return l.ucRefreshSession
+}
+
+// RevokeSession returns the usecase.RevokeSession use case.
+func (l *Locator) RevokeSession() service.RevokeSession {
+	return l.ucRevokeSession
+}
+
+// VerifyEmail returns the usecase.VerifyEmail use case.
+func (l *Locator) VerifyEmail() service.VerifyEmail {
+	return l.ucVerifyEmail
+}
+
+// ResendVerification returns the usecase.ResendVerification use case.
+func (l *Locator) ResendVerification() service.ResendVerification {
+	return l.ucResendVerification
+}
+
+// RequestPasswordReset returns the usecase.RequestPasswordReset use case.
+func (l *Locator) RequestPasswordReset() service.RequestPasswordReset {
+	return l.ucRequestPasswordReset
+}
+
+// ResetPassword returns the usecase.ResetPassword use case.
+func (l *Locator) ResetPassword() service.ResetPassword {
+	return l.ucResetPassword
+}
+
+// ChangeUserStatus returns the usecase.ChangeUserStatus use case.
+func (l *Locator) ChangeUserStatus() service.ChangeUserStatus {
+	return l.ucChangeUserStatus
+}
+
+// UndeleteUser returns the usecase.UndeleteUser use case.
+func (l *Locator) UndeleteUser() service.UndeleteUser {
+	return l.ucUndeleteUser
+}
+
+// ExportUserData returns the usecase.ExportUserData use case.
+func (l *Locator) ExportUserData() service.ExportUserData {
+	return l.ucExportUserData
+}
+
+// EraseUser returns the usecase.EraseUser use case.
+func (l *Locator) EraseUser() service.EraseUser {
+	return l.ucEraseUser
+}
+
+// ListUserAuditEvents returns the usecase.ListUserAuditEvents use case.
+func (l *Locator) ListUserAuditEvents() service.ListUserAuditEvents {
+	return l.ucListUserAuditEvents
+}
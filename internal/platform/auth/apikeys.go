@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+// ErrUnknownRole occurs when an API key is assigned an unknown role.
+var ErrUnknownRole = errors.New("unknown role")
+
+// APIKey is a static API key, granting scopes to the services calling with it.
+type APIKey struct {
+	// Name identifies the caller, it is the subject of the principal.
+	Name string `json:"name"`
+	// KeySHA256 is the hex encoded SHA-256 of the key, the key itself is not configured.
+	KeySHA256 string `json:"key_sha256"`
+	// Scopes are the scopes granted to the key.
+	Scopes []string `json:"scopes"`
+	// Roles are the roles assigned to the caller.
+	Roles []model.Role `json:"roles,omitempty"`
+}
+
+// APIKeys is a list of API keys, decoded from JSON.
+type APIKeys []APIKey
+
+// Decode implements envconfig.Decoder.
+func (k *APIKeys) Decode(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	if err := json.Unmarshal([]byte(value), (*[]APIKey)(k)); err != nil {
+		return err
+	}
+
+	for _, key := range *k {
+		for _, r := range key.Roles {
+			if !r.Valid() {
+				return fmt.Errorf("%w %q of API key %q", ErrUnknownRole, r, key.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// HashAPIKey returns the hex encoded SHA-256 of the API key, as configured.
+//
+// API keys are random, unlike passwords a fast unsalted hash is enough.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,25 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/platform/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeys_Decode(t *testing.T) {
+	t.Parallel()
+
+	var k auth.APIKeys
+
+	require.NoError(t, k.Decode(`[{"name":"backoffice","key_sha256":"abc","scopes":["users:read"],"roles":["support"]}]`))
+	require.Equal(t, auth.APIKeys{{
+		Name:      "backoffice",
+		KeySHA256: "abc",
+		Scopes:    []string{auth.ScopeUsersRead},
+		Roles:     []model.Role{model.RoleSupport},
+	}}, k)
+
+	require.ErrorIs(t, k.Decode(`[{"name":"backoffice","key_sha256":"abc","roles":["root"]}]`), auth.ErrUnknownRole)
+}
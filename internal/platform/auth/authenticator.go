@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/google/uuid"
+	"github.com/nhatthm/go-clock"
+)
+
+// Authorization errors.
+var (
+	// ErrMissingToken occurs when the request bears no token.
+	ErrMissingToken = errors.New("missing bearer token")
+	// ErrInsufficientScope occurs when the principal is not granted the scopes required by the request.
+	ErrInsufficientScope = errors.New("insufficient scope")
+)
+
+// schemeBearer is the authorization scheme of the requests, RFC 6750.
+const schemeBearer = "Bearer"
+
+// RoleStore defines functionality to find the roles assigned to the users.
+type RoleStore interface {
+	FindUserRoles(ctx context.Context, id model.UserID) ([]model.Role, error)
+}
+
+// Authenticator authenticates the bearers of the requests: the users with an access token, signed by the key set
+// or by a trusted issuer, and the services with a static API key.
+type Authenticator struct {
+	keys    *KeySet
+	roles   RoleStore
+	apiKeys map[string]APIKey
+	clock   clock.Clock
+	cfg     Config
+}
+
+// NewAuthenticator creates a new Authenticator.
+func NewAuthenticator(keys *KeySet, roles RoleStore, clk clock.Clock, cfg Config) *Authenticator {
+	apiKeys := make(map[string]APIKey, len(cfg.APIKeys))
+
+	for _, k := range cfg.APIKeys {
+		apiKeys[strings.ToLower(k.KeySHA256)] = k
+	}
+
+	return &Authenticator{
+		keys:    keys,
+		roles:   roles,
+		apiKeys: apiKeys,
+		clock:   clk,
+		cfg:     cfg,
+	}
+}
+
+// Authenticate authenticates the token, an API key or an access token, returning its principal.
+func (a *Authenticator) Authenticate(token string) (Principal, error) {
+	if k, ok := a.apiKeys[HashAPIKey(token)]; ok {
+		return Principal{Type: PrincipalAPIKey, Subject: k.Name, Scopes: k.Scopes, Roles: k.Roles}, nil
+	}
+
+	c, issuer, err := a.keys.Verify(token)
+	if err != nil {
+		return Principal{}, err
+	}
+
+	// A trusted key only verifies the tokens of the issuer owning it, the signing keys the tokens of the service.
+	if issuer == "" {
+		issuer = a.cfg.Issuer
+	}
+
+	if err := c.Validate(issuer, a.cfg.Audience, a.clock.Now()); err != nil {
+		return Principal{}, err
+	}
+
+	return Principal{Type: PrincipalUser, Subject: c.Subject, Issuer: c.Issuer, Scopes: c.Scopes()}, nil
+}
+
+// Authorize authenticates the bearer of the authorization, the value of the Authorization header, and checks it is
+// granted the scopes.
+//
+// Returns a context carrying the principal, and logging it, and the actor of the use cases with the roles of the
+// principal. Every user has the user role, along the roles assigned to it. The subjects of the trusted issuer are
+// only bound to the users when configured.
+func (a *Authenticator) Authorize(ctx context.Context, authorization string, scopes ...string) (context.Context, error) {
+	scheme, token, found := strings.Cut(strings.TrimSpace(authorization), " ")
+	if !found || !strings.EqualFold(scheme, schemeBearer) || strings.TrimSpace(token) == "" {
+		return ctx, ErrMissingToken
+	}
+
+	p, err := a.Authenticate(strings.TrimSpace(token))
+	if err != nil {
+		return ctx, err
+	}
+
+	ctx = ctxd.AddFields(ctx, "principal", p.Subject, "principal_type", string(p.Type))
+
+	if !p.HasScopes(scopes...) {
+		return ctx, fmt.Errorf("%w: %s required", ErrInsufficientScope, strings.Join(scopes, " "))
+	}
+
+	actor := model.Actor{Subject: p.Subject, Roles: p.Roles}
+
+	if p.Type == PrincipalUser {
+		// Subjects of other issuers are not users of the service unless bound, they only get the user role.
+		bound := p.Issuer == a.cfg.Issuer || a.cfg.JWKSBindUsers
+
+		if id, err := uuid.Parse(p.Subject); err == nil && bound {
+			actor.UserID = id
+
+			if actor.Roles, err = a.roles.FindUserRoles(ctx, id); err != nil {
+				return ctx, fmt.Errorf("find user roles: %w", err)
+			}
+		}
+
+		actor.Roles = append([]model.Role{model.RoleUser}, actor.Roles...)
+		p.Roles = actor.Roles
+	}
+
+	return usecase.ContextWithActor(ContextWithPrincipal(ctx, p), actor), nil
+}
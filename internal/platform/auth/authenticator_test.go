@@ -0,0 +1,219 @@
+package auth_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/auth"
+	"github.com/google/uuid"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/require"
+)
+
+type roleStore map[model.UserID][]model.Role
+
+func (s roleStore) FindUserRoles(_ context.Context, id model.UserID) ([]model.Role, error) {
+	return s[id], nil
+}
+
+func TestAuthenticator_Authorize(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 12, 19, 9, 0, 0, 0, time.UTC)
+
+	ks, err := auth.NewKeySet(bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, err)
+
+	idp, err := auth.NewKeySet(bytes.Repeat([]byte{2}, 32))
+	require.NoError(t, err)
+
+	require.NoError(t, ks.Trust("idp", idp.JWKS()))
+
+	cfg := auth.Config{
+		Issuer:   "faceit",
+		Audience: "faceit-api",
+		APIKeys: auth.APIKeys{
+			{Name: "backoffice", KeySHA256: auth.HashAPIKey("backoffice-key"), Scopes: []string{auth.ScopeUsersRead}, Roles: []model.Role{model.RoleSupport}},
+		},
+	}
+
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+	a := auth.NewAuthenticator(ks, roleStore{userID: {model.RoleAdmin}}, clock.Fix(now), cfg)
+
+	signWith := func(ks *auth.KeySet, c auth.Claims) string {
+		t.Helper()
+
+		token, err := ks.Sign(c)
+		require.NoError(t, err)
+
+		return token
+	}
+
+	sign := func(c auth.Claims) string {
+		t.Helper()
+
+		return signWith(ks, c)
+	}
+
+	idpToken := signWith(idp, auth.Claims{
+		Issuer:    "idp",
+		Subject:   "26ef0140-c436-4838-a271-32652c72f6f2",
+		Audience:  auth.Audience{"faceit-api"},
+		ExpiresAt: now.Add(time.Minute).Unix(),
+	})
+
+	readToken := sign(auth.Claims{
+		Issuer:    "faceit",
+		Subject:   "26ef0140-c436-4838-a271-32652c72f6f2",
+		Audience:  auth.Audience{"faceit-api"},
+		ExpiresAt: now.Add(time.Minute).Unix(),
+		Scope:     auth.ScopeUsersRead,
+	})
+
+	t.Run("access token", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, err := a.Authorize(context.Background(), "Bearer "+readToken, auth.ScopeUsersRead)
+		require.NoError(t, err)
+
+		p, ok := auth.PrincipalFromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, auth.Principal{
+			Type:    auth.PrincipalUser,
+			Subject: "26ef0140-c436-4838-a271-32652c72f6f2",
+			Issuer:  "faceit",
+			Scopes:  []string{auth.ScopeUsersRead},
+			Roles:   []model.Role{model.RoleUser, model.RoleAdmin},
+		}, p)
+
+		// The principal is logged.
+		require.Equal(t, []any{"principal", p.Subject, "principal_type", "user"}, ctxd.Fields(ctx))
+
+		// The principal acts on the use cases with the user role along the roles assigned to it.
+		actor, ok := usecase.ActorFromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, model.Actor{
+			Subject: p.Subject,
+			UserID:  userID,
+			Roles:   []model.Role{model.RoleUser, model.RoleAdmin},
+		}, actor)
+	})
+
+	t.Run("access token of a subject not a user", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, err := a.Authorize(context.Background(), "Bearer "+sign(auth.Claims{
+			Issuer:    "faceit",
+			Subject:   "alice@idp",
+			Audience:  auth.Audience{"faceit-api"},
+			ExpiresAt: now.Add(time.Minute).Unix(),
+		}))
+		require.NoError(t, err)
+
+		actor, ok := usecase.ActorFromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, model.Actor{Subject: "alice@idp", Roles: []model.Role{model.RoleUser}}, actor)
+	})
+
+	t.Run("access token of the trusted issuer", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, err := a.Authorize(context.Background(), "Bearer "+idpToken)
+		require.NoError(t, err)
+
+		// The subject is not bound to the user of the same id, nor granted its roles.
+		actor, ok := usecase.ActorFromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, model.Actor{Subject: userID.String(), Roles: []model.Role{model.RoleUser}}, actor)
+	})
+
+	t.Run("access token of the trusted issuer, bound", func(t *testing.T) {
+		t.Parallel()
+
+		bound := cfg
+		bound.JWKSBindUsers = true
+
+		ctx, err := auth.NewAuthenticator(ks, roleStore{userID: {model.RoleAdmin}}, clock.Fix(now), bound).
+			Authorize(context.Background(), "Bearer "+idpToken)
+		require.NoError(t, err)
+
+		actor, ok := usecase.ActorFromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, model.Actor{
+			Subject: userID.String(),
+			UserID:  userID,
+			Roles:   []model.Role{model.RoleUser, model.RoleAdmin},
+		}, actor)
+	})
+
+	t.Run("api key", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, err := a.Authorize(context.Background(), "bearer backoffice-key", auth.ScopeUsersRead)
+		require.NoError(t, err)
+
+		p, ok := auth.PrincipalFromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, auth.PrincipalAPIKey, p.Type)
+		require.Equal(t, "backoffice", p.Subject)
+
+		actor, ok := usecase.ActorFromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, model.Actor{Subject: "backoffice", Roles: []model.Role{model.RoleSupport}}, actor)
+	})
+
+	t.Run("insufficient scope", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := a.Authorize(context.Background(), "Bearer "+readToken, auth.ScopeUsersWrite)
+		require.ErrorIs(t, err, auth.ErrInsufficientScope)
+
+		_, err = a.Authorize(context.Background(), "Bearer backoffice-key", auth.ScopeUsersWrite)
+		require.ErrorIs(t, err, auth.ErrInsufficientScope)
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		t.Parallel()
+
+		for _, authorization := range []string{"", "Bearer", "Bearer ", "Basic YWxpY2U6Ym9i", "backoffice-key"} {
+			_, err := a.Authorize(context.Background(), authorization)
+			require.ErrorIs(t, err, auth.ErrMissingToken, authorization)
+		}
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		t.Parallel()
+
+		for name, token := range map[string]string{
+			"unknown api key": "unknown-key",
+			"expired": sign(auth.Claims{
+				Issuer:    "faceit",
+				Audience:  auth.Audience{"faceit-api"},
+				ExpiresAt: now.Unix(),
+			}),
+			"other audience": sign(auth.Claims{
+				Issuer:    "faceit",
+				Audience:  auth.Audience{"other-api"},
+				ExpiresAt: now.Add(time.Minute).Unix(),
+			}),
+			"trusted issuer signed by the signing key": sign(auth.Claims{
+				Issuer:    "idp",
+				Audience:  auth.Audience{"faceit-api"},
+				ExpiresAt: now.Add(time.Minute).Unix(),
+			}),
+			"service issuer signed by the trusted key": signWith(idp, auth.Claims{
+				Issuer:    "faceit",
+				Audience:  auth.Audience{"faceit-api"},
+				ExpiresAt: now.Add(time.Minute).Unix(),
+			}),
+		} {
+			_, err := a.Authorize(context.Background(), "Bearer "+token)
+			require.ErrorIs(t, err, auth.ErrInvalidToken, name)
+		}
+	})
+}
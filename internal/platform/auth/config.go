@@ -0,0 +1,48 @@
+package auth
+
+import "time"
+
+// Config represents the authentication configuration.
+type Config struct {
+	// Issuer is the issuer of the access tokens, the iss claim.
+	Issuer string `default:"faceit"`
+	// Audience is the audience of the access tokens, the aud claim, not checked when empty.
+	Audience string
+	// AccessTokenTTL is the lifetime of the access tokens.
+	AccessTokenTTL time.Duration `split_words:"true" default:"15m"`
+	// RefreshTokenTTL is the lifetime of the refresh tokens, the maximum time a session lasts without activity.
+	RefreshTokenTTL time.Duration `split_words:"true" default:"720h"`
+	// SigningKeys are the base64 encoded Ed25519 seeds the access tokens are signed with, comma separated.
+	//
+	// The first key signs the new tokens, the others are kept to verify the tokens signed before the rotation, until
+	// they expire.
+	SigningKeys []string `split_words:"true"`
+	// MaxFailedLogins is the number of consecutive failed logins of an email before its logins are locked out.
+	MaxFailedLogins int `split_words:"true" default:"5"`
+	// LockoutDuration is the time the logins of an email are locked out.
+	LockoutDuration time.Duration `split_words:"true" default:"15m"`
+	// SessionScopes are the scopes granted to the access tokens issued to the users, comma separated.
+	SessionScopes []string `split_words:"true" default:"users:read,users:write"`
+	// JWKSFile is the path of a JSON Web Key Set with the public keys of other issuers of access tokens, e.g. an
+	// identity provider, trusted along the signing keys. Only Ed25519 keys are supported.
+	JWKSFile string `split_words:"true"`
+	// JWKSIssuer is the issuer of the access tokens signed by the keys of the JWKS file, the iss claim, other than
+	// Issuer. Required along the file.
+	JWKSIssuer string `split_words:"true"`
+	// JWKSBindUsers binds the subjects of the access tokens of the JWKS issuer to the users of the service, their
+	// ids, granting the roles assigned to them. Otherwise they only get the user role.
+	JWKSBindUsers bool `split_words:"true"`
+	// VerificationTokenTTL is the lifetime of the tokens verifying the email of the users.
+	VerificationTokenTTL time.Duration `split_words:"true" default:"24h"`
+	// PasswordResetTokenTTL is the lifetime of the tokens resetting the password of the users.
+	PasswordResetTokenTTL time.Duration `split_words:"true" default:"1h"`
+	// PasswordResetWindow is the window the password resets requested are counted in.
+	PasswordResetWindow time.Duration `split_words:"true" default:"1h"`
+	// PasswordResetMaxPerEmail is the number of password resets requested for an email allowed within the window.
+	PasswordResetMaxPerEmail int `split_words:"true" default:"3"`
+	// PasswordResetMaxPerIP is the number of password resets requested from an IP allowed within the window.
+	PasswordResetMaxPerIP int `split_words:"true" default:"20"`
+	// APIKeys is a JSON list of static API keys, e.g.
+	// [{"name": "backoffice", "key_sha256": "...", "scopes": ["users:read"]}].
+	APIKeys APIKeys `split_words:"true"`
+}
@@ -0,0 +1,3 @@
+// Package auth issues, rotates and verifies the session tokens, locks out the logins after repeated failures, and
+// authorizes the requests bearing an access token or an API key.
+package auth
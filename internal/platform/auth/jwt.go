@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrInvalidToken occurs when the access token is malformed, not signed by a known key, or expired.
+var ErrInvalidToken = errors.New("invalid access token")
+
+// algEdDSA is the JWS algorithm of the access tokens, Ed25519 signatures, RFC 8037.
+const algEdDSA = "EdDSA"
+
+// header is the JOSE header of the access tokens.
+type header struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ"`
+	KeyID     string `json:"kid"`
+}
+
+// Claims are the claims of the access tokens.
+type Claims struct {
+	Issuer    string   `json:"iss"`
+	Subject   string   `json:"sub"` // ID of the user
+	Audience  Audience `json:"aud,omitempty"`
+	IssuedAt  int64    `json:"iat"`
+	ExpiresAt int64    `json:"exp"`
+	ID        string   `json:"jti"`
+	Scope     string   `json:"scope,omitempty"` // Space separated scopes granted to the token, RFC 9068
+}
+
+// Audience is the aud claim, a single audience or a list of them, RFC 7519.
+type Audience []string
+
+// MarshalJSON encodes a single audience as a string.
+func (a Audience) MarshalJSON() ([]byte, error) {
+	if len(a) == 1 {
+		return json.Marshal(a[0])
+	}
+
+	return json.Marshal([]string(a))
+}
+
+// UnmarshalJSON decodes a single audience or a list of them.
+func (a *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = Audience{single}
+
+		return nil
+	}
+
+	return json.Unmarshal(data, (*[]string)(a))
+}
+
+// Scopes returns the scopes granted to the token.
+func (c Claims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+// Sign signs the claims into a JWT with the signing key.
+func (ks *KeySet) Sign(c Claims) (string, error) {
+	k := ks.signing()
+
+	h, err := json.Marshal(header{Algorithm: algEdDSA, Type: "JWT", KeyID: k.id})
+	if err != nil {
+		return "", err
+	}
+
+	p, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(p)
+	sig := ed25519.Sign(k.private, []byte(signingInput))
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// Verify verifies the signature of the JWT, returning its claims and the issuer owning the key verifying it, empty
+// for the signing keys.
+//
+// Only the signature is verified, the claims are validated by the caller.
+func (ks *KeySet) Verify(token string) (Claims, string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Claims{}, "", fmt.Errorf("%w: malformed", ErrInvalidToken)
+	}
+
+	var h header
+
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return Claims{}, "", fmt.Errorf("%w: header: %w", ErrInvalidToken, err)
+	}
+
+	// The algorithm is fixed, a token can not choose how it is verified.
+	if h.Algorithm != algEdDSA {
+		return Claims{}, "", fmt.Errorf("%w: unexpected algorithm %q", ErrInvalidToken, h.Algorithm)
+	}
+
+	k, ok := ks.find(h.KeyID)
+	if !ok {
+		return Claims{}, "", fmt.Errorf("%w: unknown key %q", ErrInvalidToken, h.KeyID)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Claims{}, "", fmt.Errorf("%w: signature: %w", ErrInvalidToken, err)
+	}
+
+	if !ed25519.Verify(k.public, []byte(parts[0]+"."+parts[1]), sig) {
+		return Claims{}, "", fmt.Errorf("%w: signature mismatch", ErrInvalidToken)
+	}
+
+	var c Claims
+
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return Claims{}, "", fmt.Errorf("%w: claims: %w", ErrInvalidToken, err)
+	}
+
+	return c, k.issuer, nil
+}
+
+// Validate validates the registered claims, the token must be issued by the issuer, meant for the audience, unless
+// empty, and not expired.
+func (c Claims) Validate(issuer, audience string, now time.Time) error {
+	if c.Issuer != issuer {
+		return fmt.Errorf("%w: unexpected issuer %q", ErrInvalidToken, c.Issuer)
+	}
+
+	if audience != "" && !slices.Contains(c.Audience, audience) {
+		return fmt.Errorf("%w: unexpected audience %q", ErrInvalidToken, c.Audience)
+	}
+
+	if now.Unix() >= c.ExpiresAt {
+		return fmt.Errorf("%w: expired", ErrInvalidToken)
+	}
+
+	return nil
+}
+
+func decodeSegment(seg string, v any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(raw, v)
+}
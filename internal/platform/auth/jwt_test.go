@@ -0,0 +1,186 @@
+package auth_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/platform/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeySet_Verify(t *testing.T) {
+	t.Parallel()
+
+	ks, err := auth.NewKeySet(bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, err)
+
+	claims := auth.Claims{
+		Issuer:    "faceit",
+		Subject:   "26ef0140-c436-4838-a271-32652c72f6f2",
+		IssuedAt:  1734598800,
+		ExpiresAt: 1734599700,
+		ID:        "1b1d5ae4-2b35-4b64-9d5c-4f6a2b0b8f0e",
+		Scope:     "users:read users:write",
+	}
+
+	token, err := ks.Sign(claims)
+	require.NoError(t, err)
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		got, issuer, err := ks.Verify(token)
+		require.NoError(t, err)
+		require.Equal(t, claims, got)
+		require.Empty(t, issuer)
+	})
+
+	t.Run("rotated", func(t *testing.T) {
+		t.Parallel()
+
+		// The new key signs, the previous one still verifies the tokens it signed.
+		rotated, err := auth.NewKeySet(bytes.Repeat([]byte{2}, 32), bytes.Repeat([]byte{1}, 32))
+		require.NoError(t, err)
+
+		got, _, err := rotated.Verify(token)
+		require.NoError(t, err)
+		require.Equal(t, claims, got)
+
+		newToken, err := rotated.Sign(claims)
+		require.NoError(t, err)
+
+		_, _, err = ks.Verify(newToken)
+		require.ErrorIs(t, err, auth.ErrInvalidToken)
+		require.ErrorContains(t, err, "unknown key")
+	})
+
+	t.Run("trusted", func(t *testing.T) {
+		t.Parallel()
+
+		// Tokens of another issuer are verified with the public keys it publishes.
+		other, err := auth.NewKeySet(bytes.Repeat([]byte{3}, 32))
+		require.NoError(t, err)
+
+		otherToken, err := other.Sign(claims)
+		require.NoError(t, err)
+
+		trusting, err := auth.NewKeySet(bytes.Repeat([]byte{1}, 32))
+		require.NoError(t, err)
+
+		_, _, err = trusting.Verify(otherToken)
+		require.ErrorIs(t, err, auth.ErrInvalidToken)
+
+		require.NoError(t, trusting.Trust("idp", other.JWKS()))
+
+		// The issuer owning the key is returned, the tokens are only valid for it.
+		got, issuer, err := trusting.Verify(otherToken)
+		require.NoError(t, err)
+		require.Equal(t, claims, got)
+		require.Equal(t, "idp", issuer)
+
+		// Trusted keys are not published.
+		require.Len(t, trusting.JWKS().Keys, 1)
+
+		require.ErrorIs(t, trusting.Trust("idp", auth.JWKS{Keys: []auth.JWK{{KeyType: "RSA"}}}), auth.ErrInvalidSigningKey)
+		require.ErrorIs(t, trusting.Trust("", other.JWKS()), auth.ErrInvalidSigningKey)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		t.Parallel()
+
+		parts := strings.Split(token, ".")
+		forged := base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"faceit","sub":"other","exp":9999999999}`))
+
+		_, _, err := ks.Verify(parts[0] + "." + forged + "." + parts[2])
+		require.ErrorIs(t, err, auth.ErrInvalidToken)
+		require.ErrorContains(t, err, "signature mismatch")
+	})
+
+	t.Run("algorithm none", func(t *testing.T) {
+		t.Parallel()
+
+		parts := strings.Split(token, ".")
+		none := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+
+		_, _, err := ks.Verify(none + "." + parts[1] + ".")
+		require.ErrorIs(t, err, auth.ErrInvalidToken)
+		require.ErrorContains(t, err, "unexpected algorithm")
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		t.Parallel()
+
+		for _, malformed := range []string{"", "a.b", "a.b.c.d", "!.b.c"} {
+			_, _, err := ks.Verify(malformed)
+			require.ErrorIs(t, err, auth.ErrInvalidToken, malformed)
+		}
+	})
+}
+
+func TestClaims_Validate(t *testing.T) {
+	t.Parallel()
+
+	c := auth.Claims{
+		Issuer:    "faceit",
+		Audience:  auth.Audience{"faceit-api", "other-api"},
+		ExpiresAt: time.Date(2024, 12, 19, 9, 15, 0, 0, time.UTC).Unix(),
+	}
+
+	require.NoError(t, c.Validate("faceit", "", time.Date(2024, 12, 19, 9, 14, 59, 0, time.UTC)))
+	require.NoError(t, c.Validate("faceit", "faceit-api", time.Date(2024, 12, 19, 9, 14, 59, 0, time.UTC)))
+	require.ErrorIs(t, c.Validate("faceit", "", time.Date(2024, 12, 19, 9, 15, 0, 0, time.UTC)), auth.ErrInvalidToken)
+	require.ErrorIs(t, c.Validate("other", "", time.Date(2024, 12, 19, 9, 0, 0, 0, time.UTC)), auth.ErrInvalidToken)
+	require.ErrorIs(t, c.Validate("faceit", "unknown-api", time.Date(2024, 12, 19, 9, 0, 0, 0, time.UTC)), auth.ErrInvalidToken)
+}
+
+func TestAudience(t *testing.T) {
+	t.Parallel()
+
+	var c auth.Claims
+
+	require.NoError(t, json.Unmarshal([]byte(`{"aud":"faceit-api"}`), &c))
+	require.Equal(t, auth.Audience{"faceit-api"}, c.Audience)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"aud":["faceit-api","other-api"]}`), &c))
+	require.Equal(t, auth.Audience{"faceit-api", "other-api"}, c.Audience)
+
+	data, err := json.Marshal(auth.Audience{"faceit-api"})
+	require.NoError(t, err)
+	require.JSONEq(t, `"faceit-api"`, string(data))
+}
+
+func TestKeySet_JWKS(t *testing.T) {
+	t.Parallel()
+
+	// RFC 8037, appendix A.1 and A.3.
+	seed, err := base64.RawURLEncoding.DecodeString("nWGxne_9WmC6hEr0kuwsxERJxWl7MmkZcDusAxyuf2A")
+	require.NoError(t, err)
+
+	ks, err := auth.NewKeySet(seed)
+	require.NoError(t, err)
+
+	require.Equal(t, auth.JWKS{Keys: []auth.JWK{{
+		KeyType:   "OKP",
+		Curve:     "Ed25519",
+		X:         "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo",
+		KeyID:     "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k",
+		Use:       "sig",
+		Algorithm: "EdDSA",
+	}}}, ks.JWKS())
+}
+
+func TestParseKeySet(t *testing.T) {
+	t.Parallel()
+
+	_, err := auth.ParseKeySet([]string{base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))})
+	require.NoError(t, err)
+
+	for _, invalid := range [][]string{nil, {"not base64!"}, {base64.StdEncoding.EncodeToString([]byte("short"))}} {
+		_, err := auth.ParseKeySet(invalid)
+		require.ErrorIs(t, err, auth.ErrInvalidSigningKey)
+	}
+}
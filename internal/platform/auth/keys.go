@@ -0,0 +1,202 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ErrInvalidSigningKey occurs when a signing key is not a base64 encoded Ed25519 seed.
+var ErrInvalidSigningKey = errors.New("invalid signing key")
+
+// key is an Ed25519 key identified by its JWK thumbprint.
+type key struct {
+	id      string
+	public  ed25519.PublicKey
+	private ed25519.PrivateKey // Only known for the signing keys
+	issuer  string             // Issuer of the tokens the key signs, only known for the trusted keys
+}
+
+// KeySet holds the keys the access tokens are signed with.
+//
+// The first key signs the tokens, all of them verify the tokens. Keys are rotated by prepending a new key, the
+// previous ones are kept until the tokens they signed expire.
+//
+// Public keys of other issuers can be trusted to verify the tokens they sign, they are not published. The tokens are
+// only valid for the issuer owning the key verifying them.
+type KeySet struct {
+	keys    []key
+	trusted []key
+}
+
+// NewKeySet creates a new KeySet from the Ed25519 seeds, the first one is the signing key.
+func NewKeySet(seeds ...[]byte) (*KeySet, error) {
+	if len(seeds) == 0 {
+		return nil, fmt.Errorf("%w: no keys", ErrInvalidSigningKey)
+	}
+
+	ks := &KeySet{keys: make([]key, 0, len(seeds))}
+
+	for i, seed := range seeds {
+		if len(seed) != ed25519.SeedSize {
+			return nil, fmt.Errorf("%w: key %d must have %d bytes", ErrInvalidSigningKey, i, ed25519.SeedSize)
+		}
+
+		private := ed25519.NewKeyFromSeed(seed)
+		public := private.Public().(ed25519.PublicKey) //nolint:forcetypeassert // Always a public key.
+
+		ks.keys = append(ks.keys, key{
+			id:      thumbprint(public),
+			public:  public,
+			private: private,
+		})
+	}
+
+	return ks, nil
+}
+
+// ParseKeySet creates a new KeySet from the base64 encoded Ed25519 seeds, the first one is the signing key.
+func ParseKeySet(encoded []string) (*KeySet, error) {
+	seeds := make([][]byte, 0, len(encoded))
+
+	for i, e := range encoded {
+		seed, err := base64.StdEncoding.DecodeString(e)
+		if err != nil {
+			return nil, fmt.Errorf("%w: key %d: %w", ErrInvalidSigningKey, i, err)
+		}
+
+		seeds = append(seeds, seed)
+	}
+
+	return NewKeySet(seeds...)
+}
+
+// GenerateKeySet creates a new KeySet with a random signing key.
+func GenerateKeySet() (*KeySet, error) {
+	seed := make([]byte, ed25519.SeedSize)
+
+	if _, err := rand.Read(seed); err != nil {
+		return nil, fmt.Errorf("generate signing key: %w", err)
+	}
+
+	return NewKeySet(seed)
+}
+
+// signing returns the key the tokens are signed with.
+func (ks *KeySet) signing() key {
+	return ks.keys[0]
+}
+
+// find returns the key with the id, signing or trusted.
+func (ks *KeySet) find(id string) (key, bool) {
+	for _, k := range ks.keys {
+		if k.id == id {
+			return k, true
+		}
+	}
+
+	for _, k := range ks.trusted {
+		if k.id == id {
+			return k, true
+		}
+	}
+
+	return key{}, false
+}
+
+// Trust trusts the public keys of the set to verify the access tokens of the issuer, only Ed25519 keys are supported.
+//
+// Keys are identified by their kid, their thumbprint when they have none.
+func (ks *KeySet) Trust(issuer string, set JWKS) error {
+	if issuer == "" {
+		return fmt.Errorf("%w: trusted keys without issuer", ErrInvalidSigningKey)
+	}
+
+	trusted := make([]key, 0, len(set.Keys))
+
+	for i, jwk := range set.Keys {
+		if jwk.KeyType != "OKP" || jwk.Curve != "Ed25519" {
+			return fmt.Errorf("%w: trusted key %d: unsupported key type %s %s", ErrInvalidSigningKey, i, jwk.KeyType, jwk.Curve)
+		}
+
+		public, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil || len(public) != ed25519.PublicKeySize {
+			return fmt.Errorf("%w: trusted key %d: malformed public key", ErrInvalidSigningKey, i)
+		}
+
+		id := jwk.KeyID
+		if id == "" {
+			id = thumbprint(public)
+		}
+
+		trusted = append(trusted, key{id: id, public: public, issuer: issuer})
+	}
+
+	ks.trusted = append(ks.trusted, trusted...)
+
+	return nil
+}
+
+// ReadJWKS reads the JSON Web Key Set from the file.
+func ReadJWKS(path string) (JWKS, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return JWKS{}, err
+	}
+
+	var set JWKS
+
+	if err := json.Unmarshal(data, &set); err != nil {
+		return JWKS{}, fmt.Errorf("decode %s: %w", path, err)
+	}
+
+	return set, nil
+}
+
+// JWK is the public part of a signing key as a JSON Web Key, RFC 8037.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	KeyID     string `json:"kid,omitempty"`
+	Use       string `json:"use,omitempty"`
+	Algorithm string `json:"alg,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set, RFC 7517.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public keys verifying the access tokens.
+func (ks *KeySet) JWKS() JWKS {
+	set := JWKS{Keys: make([]JWK, 0, len(ks.keys))}
+
+	for _, k := range ks.keys {
+		set.Keys = append(set.Keys, JWK{
+			KeyType:   "OKP",
+			Curve:     "Ed25519",
+			X:         base64.RawURLEncoding.EncodeToString(k.public),
+			KeyID:     k.id,
+			Use:       "sig",
+			Algorithm: algEdDSA,
+		})
+	}
+
+	return set
+}
+
+// thumbprint returns the JWK thumbprint of the public key, RFC 7638, used as key id.
+func thumbprint(public ed25519.PublicKey) string {
+	// Required members of the OKP key in lexicographic order, without whitespaces.
+	canonical := `{"crv":"Ed25519","kty":"OKP","x":"` + base64.RawURLEncoding.EncodeToString(public) + `"}`
+	sum := sha256.Sum256([]byte(canonical))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/nhatthm/go-clock"
+)
+
+// LockoutStore defines functionality to store the failed logins.
+type LockoutStore interface {
+	FindLoginAttempts(ctx context.Context, email string) (*storage.LoginAttempts, error)
+	RecordLoginFailure(ctx context.Context, email string, at time.Time) (int, error)
+	LockLogins(ctx context.Context, email string, until, at time.Time) error
+	ClearLoginAttempts(ctx context.Context, email string) error
+}
+
+// Lockout locks out the logins of an email for a while after a number of consecutive failed logins.
+//
+// Emails are compared case-insensitively, changing the case does not get around the lockout.
+type Lockout struct {
+	store LockoutStore
+	clock clock.Clock
+	cfg   Config
+}
+
+// NewLockout creates a new Lockout.
+func NewLockout(store LockoutStore, clk clock.Clock, cfg Config) *Lockout {
+	return &Lockout{
+		store: store,
+		clock: clk,
+		cfg:   cfg,
+	}
+}
+
+// Locked reports whether the logins of the email are locked out.
+func (l *Lockout) Locked(ctx context.Context, email string) (bool, error) {
+	a, err := l.store.FindLoginAttempts(ctx, normalizeEmail(email))
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("find login attempts: %w", err)
+	}
+
+	return a.LockedUntil.Valid && a.LockedUntil.Time.After(l.clock.Now().UTC()), nil
+}
+
+// Failed records a failed login of the email, locking out its logins once the failures reach the maximum.
+func (l *Lockout) Failed(ctx context.Context, email string) error {
+	email = normalizeEmail(email)
+	now := l.clock.Now().UTC()
+
+	failures, err := l.store.RecordLoginFailure(ctx, email, now)
+	if err != nil {
+		return fmt.Errorf("record login failure: %w", err)
+	}
+
+	if failures < l.cfg.MaxFailedLogins {
+		return nil
+	}
+
+	if err := l.store.LockLogins(ctx, email, now.Add(l.cfg.LockoutDuration), now); err != nil {
+		return fmt.Errorf("lock logins: %w", err)
+	}
+
+	return nil
+}
+
+// Succeeded clears the failed logins of the email.
+func (l *Lockout) Succeeded(ctx context.Context, email string) error {
+	if err := l.store.ClearLoginAttempts(ctx, normalizeEmail(email)); err != nil {
+		return fmt.Errorf("clear login attempts: %w", err)
+	}
+
+	return nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,115 @@
+package auth_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/platform/auth"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/require"
+)
+
+type lockoutStore struct {
+	attempts map[string]*storage.LoginAttempts
+}
+
+func (s *lockoutStore) FindLoginAttempts(_ context.Context, email string) (*storage.LoginAttempts, error) {
+	a, ok := s.attempts[email]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+
+	return a, nil
+}
+
+func (s *lockoutStore) RecordLoginFailure(_ context.Context, email string, at time.Time) (int, error) {
+	if s.attempts == nil {
+		s.attempts = make(map[string]*storage.LoginAttempts)
+	}
+
+	a, ok := s.attempts[email]
+	if !ok {
+		a = &storage.LoginAttempts{Email: email}
+		s.attempts[email] = a
+	}
+
+	a.Failures++
+	a.UpdatedAt = at
+
+	return a.Failures, nil
+}
+
+func (s *lockoutStore) LockLogins(_ context.Context, email string, until, at time.Time) error {
+	a := s.attempts[email]
+	a.Failures = 0
+	a.LockedUntil = sql.NullTime{Time: until, Valid: true}
+	a.UpdatedAt = at
+
+	return nil
+}
+
+func (s *lockoutStore) ClearLoginAttempts(_ context.Context, email string) error {
+	delete(s.attempts, email)
+
+	return nil
+}
+
+func TestLockout(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 12, 19, 9, 0, 0, 0, time.UTC)
+
+	cfg := auth.Config{
+		MaxFailedLogins: 3,
+		LockoutDuration: 15 * time.Minute,
+	}
+
+	t.Run("locked after max failures", func(t *testing.T) {
+		t.Parallel()
+
+		st := &lockoutStore{}
+		l := auth.NewLockout(st, clock.Fix(now), cfg)
+		ctx := context.Background()
+
+		for range 2 {
+			require.NoError(t, l.Failed(ctx, "alice@bob.com"))
+		}
+
+		locked, err := l.Locked(ctx, "alice@bob.com")
+		require.NoError(t, err)
+		require.False(t, locked)
+
+		// Emails are compared case-insensitively.
+		require.NoError(t, l.Failed(ctx, " Alice@Bob.com"))
+
+		locked, err = l.Locked(ctx, "alice@bob.com")
+		require.NoError(t, err)
+		require.True(t, locked)
+
+		// The lockout expires.
+		locked, err = auth.NewLockout(st, clock.Fix(now.Add(15*time.Minute)), cfg).Locked(ctx, "ALICE@bob.com")
+		require.NoError(t, err)
+		require.False(t, locked)
+	})
+
+	t.Run("cleared on success", func(t *testing.T) {
+		t.Parallel()
+
+		st := &lockoutStore{}
+		l := auth.NewLockout(st, clock.Fix(now), cfg)
+		ctx := context.Background()
+
+		require.NoError(t, l.Failed(ctx, "alice@bob.com"))
+		require.NoError(t, l.Failed(ctx, "alice@bob.com"))
+		require.NoError(t, l.Succeeded(ctx, "alice@bob.com"))
+		require.NoError(t, l.Failed(ctx, "alice@bob.com"))
+
+		locked, err := l.Locked(ctx, "alice@bob.com")
+		require.NoError(t, err)
+		require.False(t, locked)
+	})
+}
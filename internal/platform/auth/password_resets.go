@@ -0,0 +1,132 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/nhatthm/go-clock"
+)
+
+// passwordResetTokenSize is the number of random bytes of the password reset tokens.
+const passwordResetTokenSize = 32
+
+// PasswordResetStore defines functionality to store the password reset tokens.
+type PasswordResetStore interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	AddPasswordResetToken(ctx context.Context, t *storage.PasswordResetToken) error
+	DeletePendingPasswordResetTokens(ctx context.Context, id model.UserID) error
+	FindPasswordResetToken(ctx context.Context, hash string) (*storage.PasswordResetToken, error)
+	UsePasswordResetToken(ctx context.Context, hash string, at time.Time) (bool, error)
+}
+
+// PasswordResets issues and redeems the tokens resetting the password of the users.
+//
+// Tokens are valid once, until they expire. Issuing a token discards the tokens of the user not used yet, only the
+// last token delivered resets the password.
+type PasswordResets struct {
+	store PasswordResetStore
+	clock clock.Clock
+	cfg   Config
+}
+
+// NewPasswordResets creates a new PasswordResets.
+func NewPasswordResets(store PasswordResetStore, clk clock.Clock, cfg Config) *PasswordResets {
+	return &PasswordResets{
+		store: store,
+		clock: clk,
+		cfg:   cfg,
+	}
+}
+
+// Issue issues a new token resetting the password of the user.
+func (p *PasswordResets) Issue(ctx context.Context, id model.UserID, email string) (model.PasswordResetRequested, error) {
+	raw := make([]byte, passwordResetTokenSize)
+
+	if _, err := rand.Read(raw); err != nil {
+		return model.PasswordResetRequested{}, fmt.Errorf("generate password reset token: %w", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	now := p.clock.Now().UTC()
+
+	t := &storage.PasswordResetToken{
+		TokenHash: hashToken(token),
+		UserID:    id,
+		CreatedAt: now,
+		ExpiresAt: now.Add(p.cfg.PasswordResetTokenTTL),
+	}
+
+	err := p.store.InTx(ctx, func(ctx context.Context) error {
+		if err := p.store.DeletePendingPasswordResetTokens(ctx, id); err != nil {
+			return fmt.Errorf("discard pending password reset tokens: %w", err)
+		}
+
+		if err := p.store.AddPasswordResetToken(ctx, t); err != nil {
+			return fmt.Errorf("store password reset token: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.PasswordResetRequested{}, err
+	}
+
+	return model.PasswordResetRequested{
+		UserID:    id,
+		Email:     email,
+		Token:     token,
+		ExpiresAt: t.ExpiresAt,
+	}, nil
+}
+
+// Discard discards the pending tokens resetting the password of the user.
+func (p *PasswordResets) Discard(ctx context.Context, id model.UserID) error {
+	if err := p.store.DeletePendingPasswordResetTokens(ctx, id); err != nil {
+		return fmt.Errorf("discard pending password reset tokens: %w", err)
+	}
+
+	return nil
+}
+
+// Redeem uses the token, returning the user whose password it resets.
+func (p *PasswordResets) Redeem(ctx context.Context, token string) (model.UserID, error) {
+	t, err := p.store.FindPasswordResetToken(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return uuid.Nil, fmt.Errorf("%w: unknown", usecase.ErrInvalidResetToken)
+		}
+
+		return uuid.Nil, fmt.Errorf("find password reset token: %w", err)
+	}
+
+	now := p.clock.Now().UTC()
+
+	if t.UsedAt.Valid {
+		return uuid.Nil, fmt.Errorf("%w: already used", usecase.ErrInvalidResetToken)
+	}
+
+	if !now.Before(t.ExpiresAt) {
+		return uuid.Nil, fmt.Errorf("%w: expired", usecase.ErrInvalidResetToken)
+	}
+
+	used, err := p.store.UsePasswordResetToken(ctx, t.TokenHash, now)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("use password reset token: %w", err)
+	}
+
+	if !used {
+		// Used concurrently, the other reset changed the password.
+		return uuid.Nil, fmt.Errorf("%w: already used", usecase.ErrInvalidResetToken)
+	}
+
+	return t.UserID, nil
+}
@@ -0,0 +1,151 @@
+package auth_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/auth"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/require"
+)
+
+type passwordResetStore struct {
+	tokens map[string]*storage.PasswordResetToken
+}
+
+func (s *passwordResetStore) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (s *passwordResetStore) AddPasswordResetToken(_ context.Context, t *storage.PasswordResetToken) error {
+	if s.tokens == nil {
+		s.tokens = make(map[string]*storage.PasswordResetToken)
+	}
+
+	s.tokens[t.TokenHash] = t
+
+	return nil
+}
+
+func (s *passwordResetStore) DeletePendingPasswordResetTokens(_ context.Context, id model.UserID) error {
+	for hash, t := range s.tokens {
+		if t.UserID == id && !t.UsedAt.Valid {
+			delete(s.tokens, hash)
+		}
+	}
+
+	return nil
+}
+
+func (s *passwordResetStore) FindPasswordResetToken(_ context.Context, hash string) (*storage.PasswordResetToken, error) {
+	t, ok := s.tokens[hash]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+
+	return t, nil
+}
+
+func (s *passwordResetStore) UsePasswordResetToken(_ context.Context, hash string, at time.Time) (bool, error) {
+	t, ok := s.tokens[hash]
+	if !ok || t.UsedAt.Valid {
+		return false, nil
+	}
+
+	t.UsedAt = sql.NullTime{Time: at, Valid: true}
+
+	return true, nil
+}
+
+func TestPasswordResets(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 12, 22, 9, 0, 0, 0, time.UTC)
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+	cfg := auth.Config{PasswordResetTokenTTL: time.Hour}
+
+	t.Run("redeem", func(t *testing.T) {
+		t.Parallel()
+
+		st := &passwordResetStore{}
+		p := auth.NewPasswordResets(st, clock.Fix(now), cfg)
+
+		pr, err := p.Issue(context.Background(), userID, "alice@bob.com")
+		require.NoError(t, err)
+		require.Equal(t, userID, pr.UserID)
+		require.Equal(t, "alice@bob.com", pr.Email)
+		require.Equal(t, now.Add(time.Hour), pr.ExpiresAt)
+		require.NotEmpty(t, pr.Token)
+		require.Len(t, st.tokens, 1)
+
+		for hash := range st.tokens {
+			require.NotEqual(t, pr.Token, hash, "password reset token stored in plain")
+		}
+
+		id, err := p.Redeem(context.Background(), pr.Token)
+		require.NoError(t, err)
+		require.Equal(t, userID, id)
+
+		// Tokens are valid once.
+		_, err = p.Redeem(context.Background(), pr.Token)
+		require.ErrorIs(t, err, usecase.ErrInvalidResetToken)
+		require.ErrorContains(t, err, "already used")
+	})
+
+	t.Run("reissued", func(t *testing.T) {
+		t.Parallel()
+
+		p := auth.NewPasswordResets(&passwordResetStore{}, clock.Fix(now), cfg)
+
+		first, err := p.Issue(context.Background(), userID, "alice@bob.com")
+		require.NoError(t, err)
+
+		second, err := p.Issue(context.Background(), userID, "alice@bob.com")
+		require.NoError(t, err)
+
+		// Only the last token issued is valid.
+		_, err = p.Redeem(context.Background(), first.Token)
+		require.ErrorIs(t, err, usecase.ErrInvalidResetToken)
+		require.ErrorContains(t, err, "unknown")
+
+		_, err = p.Redeem(context.Background(), second.Token)
+		require.NoError(t, err)
+	})
+
+	t.Run("discarded", func(t *testing.T) {
+		t.Parallel()
+
+		p := auth.NewPasswordResets(&passwordResetStore{}, clock.Fix(now), cfg)
+
+		pr, err := p.Issue(context.Background(), userID, "alice@bob.com")
+		require.NoError(t, err)
+
+		// The email of the user changed, the token mailed to the former email is not valid anymore.
+		require.NoError(t, p.Discard(context.Background(), userID))
+
+		_, err = p.Redeem(context.Background(), pr.Token)
+		require.ErrorIs(t, err, usecase.ErrInvalidResetToken)
+		require.ErrorContains(t, err, "unknown")
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		t.Parallel()
+
+		st := &passwordResetStore{}
+
+		pr, err := auth.NewPasswordResets(st, clock.Fix(now), cfg).Issue(context.Background(), userID, "alice@bob.com")
+		require.NoError(t, err)
+
+		_, err = auth.NewPasswordResets(st, clock.Fix(now.Add(time.Hour)), cfg).Redeem(context.Background(), pr.Token)
+		require.ErrorIs(t, err, usecase.ErrInvalidResetToken)
+		require.ErrorContains(t, err, "expired")
+	})
+}
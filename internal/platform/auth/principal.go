@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+	"slices"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+// Scopes granted to the principals.
+const (
+	ScopeUsersRead  = "users:read"
+	ScopeUsersWrite = "users:write"
+)
+
+// PrincipalType is the type of credentials a principal authenticated with.
+type PrincipalType string
+
+// Principal types.
+const (
+	PrincipalUser   PrincipalType = "user"    // Bearer of an access token
+	PrincipalAPIKey PrincipalType = "api_key" // Bearer of a static API key
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	Type    PrincipalType
+	Subject string       // ID of the user, or name of the API key
+	Issuer  string       // Issuer of the access token, empty for the API keys
+	Scopes  []string     // Scopes granted to the credentials
+	Roles   []model.Role // Roles assigned to the principal, resolved once authorized
+}
+
+// HasScopes reports whether the principal is granted all the scopes.
+func (p Principal) HasScopes(scopes ...string) bool {
+	for _, s := range scopes {
+		if !slices.Contains(p.Scopes, s) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type principalCtxKey struct{}
+
+// ContextWithPrincipal returns a context carrying the principal.
+func ContextWithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalCtxKey{}, p)
+}
+
+// PrincipalFromContext returns the principal carried by the context, false for anonymous requests.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalCtxKey{}).(Principal)
+
+	return p, ok
+}
@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nhatthm/go-clock"
+)
+
+// RateLimitStore defines functionality to count the hits of the rate limited keys.
+type RateLimitStore interface {
+	Hit(ctx context.Context, key string, windowStart, at time.Time) (int, error)
+}
+
+// PasswordResetLimiter rate limits the password resets requested, per email and per IP, in fixed windows.
+//
+// Emails are compared case-insensitively, changing the case does not get around the limits. Every request counts,
+// whether the email is registered or not, so the limits do not reveal which emails are registered.
+type PasswordResetLimiter struct {
+	store RateLimitStore
+	clock clock.Clock
+	cfg   Config
+}
+
+// NewPasswordResetLimiter creates a new PasswordResetLimiter.
+func NewPasswordResetLimiter(store RateLimitStore, clk clock.Clock, cfg Config) *PasswordResetLimiter {
+	return &PasswordResetLimiter{
+		store: store,
+		clock: clk,
+		cfg:   cfg,
+	}
+}
+
+// Allow records a password reset requested for the email from the IP, reporting whether both are within the limits.
+//
+// The IP is not limited when unknown.
+func (l *PasswordResetLimiter) Allow(ctx context.Context, email, ip string) (bool, error) {
+	now := l.clock.Now().UTC()
+	windowStart := now.Add(-l.cfg.PasswordResetWindow)
+
+	hits, err := l.store.Hit(ctx, "password_reset:email:"+normalizeEmail(email), windowStart, now)
+	if err != nil {
+		return false, fmt.Errorf("count password resets of email: %w", err)
+	}
+
+	allowed := hits <= l.cfg.PasswordResetMaxPerEmail
+
+	if ip == "" {
+		return allowed, nil
+	}
+
+	hits, err = l.store.Hit(ctx, "password_reset:ip:"+ip, windowStart, now)
+	if err != nil {
+		return false, fmt.Errorf("count password resets of ip: %w", err)
+	}
+
+	return allowed && hits <= l.cfg.PasswordResetMaxPerIP, nil
+}
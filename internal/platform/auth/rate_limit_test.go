@@ -0,0 +1,90 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/platform/auth"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/require"
+)
+
+type rateLimitStore struct {
+	limits map[string]*storage.RateLimit
+}
+
+func (s *rateLimitStore) Hit(_ context.Context, key string, windowStart, at time.Time) (int, error) {
+	if s.limits == nil {
+		s.limits = make(map[string]*storage.RateLimit)
+	}
+
+	l, ok := s.limits[key]
+	if !ok || l.WindowStart.Before(windowStart) {
+		l = &storage.RateLimit{Key: key, WindowStart: at}
+		s.limits[key] = l
+	}
+
+	l.Hits++
+
+	return l.Hits, nil
+}
+
+func TestPasswordResetLimiter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 12, 22, 9, 0, 0, 0, time.UTC)
+
+	cfg := auth.Config{
+		PasswordResetWindow:      time.Hour,
+		PasswordResetMaxPerEmail: 2,
+		PasswordResetMaxPerIP:    3,
+	}
+
+	t.Run("per email", func(t *testing.T) {
+		t.Parallel()
+
+		st := &rateLimitStore{}
+		l := auth.NewPasswordResetLimiter(st, clock.Fix(now), cfg)
+		ctx := context.Background()
+
+		for _, ip := range []string{"192.0.2.1", "192.0.2.2"} {
+			allowed, err := l.Allow(ctx, "alice@bob.com", ip)
+			require.NoError(t, err)
+			require.True(t, allowed)
+		}
+
+		// Emails are compared case-insensitively, the IP does not matter.
+		allowed, err := l.Allow(ctx, " Alice@Bob.com", "192.0.2.3")
+		require.NoError(t, err)
+		require.False(t, allowed)
+
+		// The window starts over.
+		allowed, err = auth.NewPasswordResetLimiter(st, clock.Fix(now.Add(time.Hour+time.Second)), cfg).Allow(ctx, "alice@bob.com", "192.0.2.1")
+		require.NoError(t, err)
+		require.True(t, allowed)
+	})
+
+	t.Run("per ip", func(t *testing.T) {
+		t.Parallel()
+
+		l := auth.NewPasswordResetLimiter(&rateLimitStore{}, clock.Fix(now), cfg)
+		ctx := context.Background()
+
+		for _, email := range []string{"alice@bob.com", "carol@dan.com", "eve@frank.com"} {
+			allowed, err := l.Allow(ctx, email, "192.0.2.1")
+			require.NoError(t, err)
+			require.True(t, allowed)
+		}
+
+		allowed, err := l.Allow(ctx, "grace@heidi.com", "192.0.2.1")
+		require.NoError(t, err)
+		require.False(t, allowed)
+
+		// Unknown IPs are only limited per email.
+		allowed, err = l.Allow(ctx, "grace@heidi.com", "")
+		require.NoError(t, err)
+		require.True(t, allowed)
+	})
+}
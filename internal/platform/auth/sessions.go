@@ -0,0 +1,205 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/nhatthm/go-clock"
+)
+
+// refreshTokenSize is the number of random bytes of the refresh tokens.
+const refreshTokenSize = 32
+
+// SessionStore defines functionality to store the refresh tokens.
+type SessionStore interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	AddRefreshToken(ctx context.Context, t *storage.RefreshToken) error
+	FindRefreshToken(ctx context.Context, hash string) (*storage.RefreshToken, error)
+	RevokeRefreshToken(ctx context.Context, hash string, at time.Time) (bool, error)
+	RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID, at time.Time) error
+	RevokeUserRefreshTokens(ctx context.Context, id model.UserID, at time.Time) error
+}
+
+// Sessions issues the sessions of the users: a short-lived access token, a JWT signed with the key set, and a
+// refresh token to obtain a new session once the access token expires.
+//
+// Refresh tokens are valid once, every refresh rotates the refresh token. Presenting a refresh token already rotated
+// means it was stolen, or the client it was issued to was, so the whole session is revoked.
+type Sessions struct {
+	store SessionStore
+	keys  *KeySet
+	clock clock.Clock
+	cfg   Config
+}
+
+// NewSessions creates a new Sessions.
+func NewSessions(store SessionStore, keys *KeySet, clk clock.Clock, cfg Config) *Sessions {
+	return &Sessions{
+		store: store,
+		keys:  keys,
+		clock: clk,
+		cfg:   cfg,
+	}
+}
+
+// Keys returns the key set the access tokens are signed with.
+func (s *Sessions) Keys() *KeySet {
+	return s.keys
+}
+
+// Issue issues a new session to the user.
+func (s *Sessions) Issue(ctx context.Context, u *model.User) (model.Session, error) {
+	return s.issue(ctx, u.ID, uuid.New())
+}
+
+// Refresh exchanges the refresh token for a new session of the same family.
+func (s *Sessions) Refresh(ctx context.Context, refreshToken string) (model.Session, error) {
+	t, err := s.find(ctx, refreshToken)
+	if err != nil {
+		return model.Session{}, err
+	}
+
+	now := s.clock.Now().UTC()
+
+	if t.RevokedAt.Valid {
+		if err := s.store.RevokeRefreshTokenFamily(ctx, t.FamilyID, now); err != nil {
+			return model.Session{}, fmt.Errorf("revoke session: %w", err)
+		}
+
+		return model.Session{}, fmt.Errorf("%w: reused, session revoked", usecase.ErrInvalidRefreshToken)
+	}
+
+	if !now.Before(t.ExpiresAt) {
+		return model.Session{}, fmt.Errorf("%w: expired", usecase.ErrInvalidRefreshToken)
+	}
+
+	var sess model.Session
+
+	err = s.store.InTx(ctx, func(ctx context.Context) error {
+		revoked, err := s.store.RevokeRefreshToken(ctx, t.TokenHash, now)
+		if err != nil {
+			return fmt.Errorf("revoke refresh token: %w", err)
+		}
+
+		if !revoked {
+			// Rotated concurrently, the other refresh got the new session.
+			return fmt.Errorf("%w: already used", usecase.ErrInvalidRefreshToken)
+		}
+
+		sess, err = s.issue(ctx, t.UserID, t.FamilyID)
+
+		return err
+	})
+	if err != nil {
+		return model.Session{}, err
+	}
+
+	return sess, nil
+}
+
+// Revoke revokes the session the refresh token belongs to, all the refresh tokens rotated from the same login.
+func (s *Sessions) Revoke(ctx context.Context, refreshToken string) error {
+	t, err := s.find(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+
+	if err := s.store.RevokeRefreshTokenFamily(ctx, t.FamilyID, s.clock.Now().UTC()); err != nil {
+		return fmt.Errorf("revoke session: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeAll revokes all the sessions of the user.
+func (s *Sessions) RevokeAll(ctx context.Context, id model.UserID) error {
+	if err := s.store.RevokeUserRefreshTokens(ctx, id, s.clock.Now().UTC()); err != nil {
+		return fmt.Errorf("revoke sessions: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Sessions) find(ctx context.Context, refreshToken string) (*storage.RefreshToken, error) {
+	t, err := s.store.FindRefreshToken(ctx, hashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("%w: unknown", usecase.ErrInvalidRefreshToken)
+		}
+
+		return nil, fmt.Errorf("find refresh token: %w", err)
+	}
+
+	return t, nil
+}
+
+func (s *Sessions) issue(ctx context.Context, userID model.UserID, familyID uuid.UUID) (model.Session, error) {
+	now := s.clock.Now().UTC()
+	accessExpiresAt := now.Add(s.cfg.AccessTokenTTL).Truncate(time.Second)
+
+	c := Claims{
+		Issuer:    s.cfg.Issuer,
+		Subject:   userID.String(),
+		IssuedAt:  now.Unix(),
+		ExpiresAt: accessExpiresAt.Unix(),
+		ID:        uuid.NewString(),
+		Scope:     strings.Join(s.cfg.SessionScopes, " "),
+	}
+
+	if s.cfg.Audience != "" {
+		c.Audience = Audience{s.cfg.Audience}
+	}
+
+	access, err := s.keys.Sign(c)
+	if err != nil {
+		return model.Session{}, fmt.Errorf("sign access token: %w", err)
+	}
+
+	raw := make([]byte, refreshTokenSize)
+
+	if _, err := rand.Read(raw); err != nil {
+		return model.Session{}, fmt.Errorf("generate refresh token: %w", err)
+	}
+
+	refresh := base64.RawURLEncoding.EncodeToString(raw)
+
+	t := &storage.RefreshToken{
+		TokenHash: hashToken(refresh),
+		FamilyID:  familyID,
+		UserID:    userID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.cfg.RefreshTokenTTL),
+	}
+
+	if err := s.store.AddRefreshToken(ctx, t); err != nil {
+		return model.Session{}, fmt.Errorf("store refresh token: %w", err)
+	}
+
+	return model.Session{
+		AccessToken:           access,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          refresh,
+		RefreshTokenExpiresAt: t.ExpiresAt,
+	}, nil
+}
+
+// hashToken hashes the refresh or verification token to store it, a leak of the table does not leak usable tokens.
+//
+// Tokens are random, unlike passwords a fast unsalted hash is enough.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
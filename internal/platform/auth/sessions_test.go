@@ -0,0 +1,239 @@
+package auth_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/auth"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/require"
+)
+
+type sessionStore struct {
+	tokens map[string]*storage.RefreshToken
+}
+
+func (s *sessionStore) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (s *sessionStore) AddRefreshToken(_ context.Context, t *storage.RefreshToken) error {
+	if s.tokens == nil {
+		s.tokens = make(map[string]*storage.RefreshToken)
+	}
+
+	s.tokens[t.TokenHash] = t
+
+	return nil
+}
+
+func (s *sessionStore) FindRefreshToken(_ context.Context, hash string) (*storage.RefreshToken, error) {
+	t, ok := s.tokens[hash]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+
+	return t, nil
+}
+
+func (s *sessionStore) RevokeRefreshToken(_ context.Context, hash string, at time.Time) (bool, error) {
+	t, ok := s.tokens[hash]
+	if !ok || t.RevokedAt.Valid {
+		return false, nil
+	}
+
+	t.RevokedAt = sql.NullTime{Time: at, Valid: true}
+
+	return true, nil
+}
+
+func (s *sessionStore) RevokeRefreshTokenFamily(_ context.Context, familyID uuid.UUID, at time.Time) error {
+	for _, t := range s.tokens {
+		if t.FamilyID == familyID && !t.RevokedAt.Valid {
+			t.RevokedAt = sql.NullTime{Time: at, Valid: true}
+		}
+	}
+
+	return nil
+}
+
+func (s *sessionStore) RevokeUserRefreshTokens(_ context.Context, id model.UserID, at time.Time) error {
+	for _, t := range s.tokens {
+		if t.UserID == id && !t.RevokedAt.Valid {
+			t.RevokedAt = sql.NullTime{Time: at, Valid: true}
+		}
+	}
+
+	return nil
+}
+
+func (s *sessionStore) revoked() int {
+	var n int
+
+	for _, t := range s.tokens {
+		if t.RevokedAt.Valid {
+			n++
+		}
+	}
+
+	return n
+}
+
+func TestSessions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 12, 19, 9, 0, 0, 0, time.UTC)
+	user := &model.User{ID: uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")}
+
+	cfg := auth.Config{
+		Issuer:          "faceit",
+		Audience:        "faceit-api",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 24 * time.Hour,
+		SessionScopes:   []string{auth.ScopeUsersRead, auth.ScopeUsersWrite},
+	}
+
+	ks, err := auth.NewKeySet(bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, err)
+
+	t.Run("issue", func(t *testing.T) {
+		t.Parallel()
+
+		st := &sessionStore{}
+		s := auth.NewSessions(st, ks, clock.Fix(now), cfg)
+
+		sess, err := s.Issue(context.Background(), user)
+		require.NoError(t, err)
+		require.Equal(t, now.Add(15*time.Minute), sess.AccessTokenExpiresAt)
+		require.Equal(t, now.Add(24*time.Hour), sess.RefreshTokenExpiresAt)
+		require.NotEmpty(t, sess.RefreshToken)
+		require.Len(t, st.tokens, 1)
+
+		for hash := range st.tokens {
+			require.NotEqual(t, sess.RefreshToken, hash, "refresh token stored in plain")
+		}
+
+		c, _, err := ks.Verify(sess.AccessToken)
+		require.NoError(t, err)
+		require.Equal(t, user.ID.String(), c.Subject)
+		require.Equal(t, "faceit", c.Issuer)
+		require.Equal(t, auth.Audience{"faceit-api"}, c.Audience)
+		require.Equal(t, "users:read users:write", c.Scope)
+
+		p, err := auth.NewAuthenticator(ks, nil, clock.Fix(now), cfg).Authenticate(sess.AccessToken)
+		require.NoError(t, err)
+		require.Equal(t, auth.Principal{
+			Type:    auth.PrincipalUser,
+			Subject: user.ID.String(),
+			Issuer:  "faceit",
+			Scopes:  []string{auth.ScopeUsersRead, auth.ScopeUsersWrite},
+		}, p)
+	})
+
+	t.Run("access token expired", func(t *testing.T) {
+		t.Parallel()
+
+		sess, err := auth.NewSessions(&sessionStore{}, ks, clock.Fix(now), cfg).Issue(context.Background(), user)
+		require.NoError(t, err)
+
+		later := auth.NewAuthenticator(ks, nil, clock.Fix(now.Add(15*time.Minute)), cfg)
+
+		_, err = later.Authenticate(sess.AccessToken)
+		require.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+
+	t.Run("refresh rotates", func(t *testing.T) {
+		t.Parallel()
+
+		st := &sessionStore{}
+		s := auth.NewSessions(st, ks, clock.Fix(now), cfg)
+
+		sess, err := s.Issue(context.Background(), user)
+		require.NoError(t, err)
+
+		refreshed, err := s.Refresh(context.Background(), sess.RefreshToken)
+		require.NoError(t, err)
+		require.NotEqual(t, sess.RefreshToken, refreshed.RefreshToken)
+		require.Equal(t, 1, st.revoked())
+
+		// The rotated token is reused, the whole session is revoked.
+		_, err = s.Refresh(context.Background(), sess.RefreshToken)
+		require.ErrorIs(t, err, usecase.ErrInvalidRefreshToken)
+		require.ErrorContains(t, err, "reused")
+		require.Equal(t, 2, st.revoked())
+
+		_, err = s.Refresh(context.Background(), refreshed.RefreshToken)
+		require.ErrorIs(t, err, usecase.ErrInvalidRefreshToken)
+	})
+
+	t.Run("refresh expired", func(t *testing.T) {
+		t.Parallel()
+
+		st := &sessionStore{}
+
+		sess, err := auth.NewSessions(st, ks, clock.Fix(now), cfg).Issue(context.Background(), user)
+		require.NoError(t, err)
+
+		_, err = auth.NewSessions(st, ks, clock.Fix(now.Add(24*time.Hour)), cfg).Refresh(context.Background(), sess.RefreshToken)
+		require.ErrorIs(t, err, usecase.ErrInvalidRefreshToken)
+		require.ErrorContains(t, err, "expired")
+	})
+
+	t.Run("refresh unknown", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := auth.NewSessions(&sessionStore{}, ks, clock.Fix(now), cfg).Refresh(context.Background(), "unknown")
+		require.ErrorIs(t, err, usecase.ErrInvalidRefreshToken)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		t.Parallel()
+
+		st := &sessionStore{}
+		s := auth.NewSessions(st, ks, clock.Fix(now), cfg)
+
+		sess, err := s.Issue(context.Background(), user)
+		require.NoError(t, err)
+
+		refreshed, err := s.Refresh(context.Background(), sess.RefreshToken)
+		require.NoError(t, err)
+
+		// Revoking with any token of the session revokes the session.
+		require.NoError(t, s.Revoke(context.Background(), sess.RefreshToken))
+		require.Equal(t, 2, st.revoked())
+
+		_, err = s.Refresh(context.Background(), refreshed.RefreshToken)
+		require.ErrorIs(t, err, usecase.ErrInvalidRefreshToken)
+
+		require.ErrorIs(t, s.Revoke(context.Background(), "unknown"), usecase.ErrInvalidRefreshToken)
+	})
+
+	t.Run("revoke all", func(t *testing.T) {
+		t.Parallel()
+
+		st := &sessionStore{}
+		s := auth.NewSessions(st, ks, clock.Fix(now), cfg)
+
+		first, err := s.Issue(context.Background(), user)
+		require.NoError(t, err)
+
+		second, err := s.Issue(context.Background(), user)
+		require.NoError(t, err)
+
+		require.NoError(t, s.RevokeAll(context.Background(), user.ID))
+		require.Equal(t, 2, st.revoked())
+
+		for _, sess := range []model.Session{first, second} {
+			_, err = s.Refresh(context.Background(), sess.RefreshToken)
+			require.ErrorIs(t, err, usecase.ErrInvalidRefreshToken)
+		}
+	})
+}
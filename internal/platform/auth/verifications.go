@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/nhatthm/go-clock"
+)
+
+// verificationTokenSize is the number of random bytes of the verification tokens.
+const verificationTokenSize = 32
+
+// VerificationStore defines functionality to store the email verification tokens.
+type VerificationStore interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	AddVerificationToken(ctx context.Context, t *storage.VerificationToken) error
+	DeletePendingVerificationTokens(ctx context.Context, id model.UserID) error
+	FindVerificationToken(ctx context.Context, hash string) (*storage.VerificationToken, error)
+	UseVerificationToken(ctx context.Context, hash string, at time.Time) (bool, error)
+	MarkEmailVerified(ctx context.Context, id model.UserID, email string, at time.Time) error
+}
+
+// Verifications issues and verifies the tokens proving the ownership of the email of the users.
+//
+// Tokens are valid once, until they expire, for the email they were issued for. Issuing a token discards the tokens
+// of the user not used yet, only the last token delivered verifies the email.
+type Verifications struct {
+	store VerificationStore
+	clock clock.Clock
+	cfg   Config
+}
+
+// NewVerifications creates a new Verifications.
+func NewVerifications(store VerificationStore, clk clock.Clock, cfg Config) *Verifications {
+	return &Verifications{
+		store: store,
+		clock: clk,
+		cfg:   cfg,
+	}
+}
+
+// Issue issues a new token verifying the email of the user.
+func (v *Verifications) Issue(ctx context.Context, id model.UserID, email string) (model.VerificationRequested, error) {
+	raw := make([]byte, verificationTokenSize)
+
+	if _, err := rand.Read(raw); err != nil {
+		return model.VerificationRequested{}, fmt.Errorf("generate verification token: %w", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	now := v.clock.Now().UTC()
+
+	t := &storage.VerificationToken{
+		TokenHash: hashToken(token),
+		UserID:    id,
+		Email:     email,
+		CreatedAt: now,
+		ExpiresAt: now.Add(v.cfg.VerificationTokenTTL),
+	}
+
+	err := v.store.InTx(ctx, func(ctx context.Context) error {
+		if err := v.store.DeletePendingVerificationTokens(ctx, id); err != nil {
+			return fmt.Errorf("discard pending verification tokens: %w", err)
+		}
+
+		if err := v.store.AddVerificationToken(ctx, t); err != nil {
+			return fmt.Errorf("store verification token: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.VerificationRequested{}, err
+	}
+
+	return model.VerificationRequested{
+		UserID:    id,
+		Email:     email,
+		Token:     token,
+		ExpiresAt: t.ExpiresAt,
+	}, nil
+}
+
+// Verify uses the token, marking the email it was issued for as verified.
+func (v *Verifications) Verify(ctx context.Context, token string) (model.UserID, error) {
+	t, err := v.store.FindVerificationToken(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return uuid.Nil, fmt.Errorf("%w: unknown", usecase.ErrInvalidVerificationToken)
+		}
+
+		return uuid.Nil, fmt.Errorf("find verification token: %w", err)
+	}
+
+	now := v.clock.Now().UTC()
+
+	if t.UsedAt.Valid {
+		return uuid.Nil, fmt.Errorf("%w: already used", usecase.ErrInvalidVerificationToken)
+	}
+
+	if !now.Before(t.ExpiresAt) {
+		return uuid.Nil, fmt.Errorf("%w: expired", usecase.ErrInvalidVerificationToken)
+	}
+
+	err = v.store.InTx(ctx, func(ctx context.Context) error {
+		used, err := v.store.UseVerificationToken(ctx, t.TokenHash, now)
+		if err != nil {
+			return fmt.Errorf("use verification token: %w", err)
+		}
+
+		if !used {
+			// Used concurrently, the other verification verified the email.
+			return fmt.Errorf("%w: already used", usecase.ErrInvalidVerificationToken)
+		}
+
+		if err := v.store.MarkEmailVerified(ctx, t.UserID, t.Email, now); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return fmt.Errorf("%w: email changed", usecase.ErrInvalidVerificationToken)
+			}
+
+			return fmt.Errorf("mark email verified: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return t.UserID, nil
+}
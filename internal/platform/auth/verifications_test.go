@@ -0,0 +1,173 @@
+package auth_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/auth"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/require"
+)
+
+type verificationStore struct {
+	tokens   map[string]*storage.VerificationToken
+	emails   map[model.UserID]string
+	verified map[model.UserID]time.Time
+}
+
+func (s *verificationStore) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (s *verificationStore) AddVerificationToken(_ context.Context, t *storage.VerificationToken) error {
+	if s.tokens == nil {
+		s.tokens = make(map[string]*storage.VerificationToken)
+	}
+
+	s.tokens[t.TokenHash] = t
+
+	return nil
+}
+
+func (s *verificationStore) DeletePendingVerificationTokens(_ context.Context, id model.UserID) error {
+	for hash, t := range s.tokens {
+		if t.UserID == id && !t.UsedAt.Valid {
+			delete(s.tokens, hash)
+		}
+	}
+
+	return nil
+}
+
+func (s *verificationStore) FindVerificationToken(_ context.Context, hash string) (*storage.VerificationToken, error) {
+	t, ok := s.tokens[hash]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+
+	return t, nil
+}
+
+func (s *verificationStore) UseVerificationToken(_ context.Context, hash string, at time.Time) (bool, error) {
+	t, ok := s.tokens[hash]
+	if !ok || t.UsedAt.Valid {
+		return false, nil
+	}
+
+	t.UsedAt = sql.NullTime{Time: at, Valid: true}
+
+	return true, nil
+}
+
+func (s *verificationStore) MarkEmailVerified(_ context.Context, id model.UserID, email string, at time.Time) error {
+	if s.emails[id] != email {
+		return database.ErrNotFound
+	}
+
+	if s.verified == nil {
+		s.verified = make(map[model.UserID]time.Time)
+	}
+
+	s.verified[id] = at
+
+	return nil
+}
+
+func TestVerifications(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 12, 21, 9, 0, 0, 0, time.UTC)
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+	cfg := auth.Config{VerificationTokenTTL: 24 * time.Hour}
+
+	newStore := func() *verificationStore {
+		return &verificationStore{emails: map[model.UserID]string{userID: "alice@bob.com"}}
+	}
+
+	t.Run("verify", func(t *testing.T) {
+		t.Parallel()
+
+		st := newStore()
+		v := auth.NewVerifications(st, clock.Fix(now), cfg)
+
+		req, err := v.Issue(context.Background(), userID, "alice@bob.com")
+		require.NoError(t, err)
+		require.Equal(t, userID, req.UserID)
+		require.Equal(t, "alice@bob.com", req.Email)
+		require.Equal(t, now.Add(24*time.Hour), req.ExpiresAt)
+		require.NotEmpty(t, req.Token)
+		require.Len(t, st.tokens, 1)
+
+		for hash := range st.tokens {
+			require.NotEqual(t, req.Token, hash, "verification token stored in plain")
+		}
+
+		id, err := v.Verify(context.Background(), req.Token)
+		require.NoError(t, err)
+		require.Equal(t, userID, id)
+		require.Equal(t, now, st.verified[userID])
+
+		// Tokens are valid once.
+		_, err = v.Verify(context.Background(), req.Token)
+		require.ErrorIs(t, err, usecase.ErrInvalidVerificationToken)
+		require.ErrorContains(t, err, "already used")
+	})
+
+	t.Run("reissued", func(t *testing.T) {
+		t.Parallel()
+
+		v := auth.NewVerifications(newStore(), clock.Fix(now), cfg)
+
+		first, err := v.Issue(context.Background(), userID, "alice@bob.com")
+		require.NoError(t, err)
+
+		second, err := v.Issue(context.Background(), userID, "alice@bob.com")
+		require.NoError(t, err)
+
+		// Only the last token issued is valid.
+		_, err = v.Verify(context.Background(), first.Token)
+		require.ErrorIs(t, err, usecase.ErrInvalidVerificationToken)
+		require.ErrorContains(t, err, "unknown")
+
+		_, err = v.Verify(context.Background(), second.Token)
+		require.NoError(t, err)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		t.Parallel()
+
+		st := newStore()
+
+		req, err := auth.NewVerifications(st, clock.Fix(now), cfg).Issue(context.Background(), userID, "alice@bob.com")
+		require.NoError(t, err)
+
+		_, err = auth.NewVerifications(st, clock.Fix(now.Add(24*time.Hour)), cfg).Verify(context.Background(), req.Token)
+		require.ErrorIs(t, err, usecase.ErrInvalidVerificationToken)
+		require.ErrorContains(t, err, "expired")
+	})
+
+	t.Run("email changed", func(t *testing.T) {
+		t.Parallel()
+
+		st := newStore()
+		v := auth.NewVerifications(st, clock.Fix(now), cfg)
+
+		req, err := v.Issue(context.Background(), userID, "alice@bob.com")
+		require.NoError(t, err)
+
+		st.emails[userID] = "alice@example.com"
+
+		_, err = v.Verify(context.Background(), req.Token)
+		require.ErrorIs(t, err, usecase.ErrInvalidVerificationToken)
+		require.ErrorContains(t, err, "email changed")
+		require.Empty(t, st.verified)
+	})
+}
@@ -1,12 +1,87 @@
 package config
 
 import (
+	"time"
+
+	"github.com/dohernandez/faceit/internal/platform/auth"
+	"github.com/dohernandez/faceit/internal/platform/credentials"
+	"github.com/dohernandez/faceit/internal/platform/feed"
+	"github.com/dohernandez/faceit/internal/platform/notifier"
+	"github.com/dohernandez/faceit/internal/platform/outbox"
+	"github.com/dohernandez/faceit/internal/platform/scheduler"
+	"github.com/dohernandez/faceit/internal/platform/service"
 	sapp "github.com/dohernandez/go-grpc-service/app"
 )
 
+// Notifiers the user events can be delivered to.
+const (
+	NotifierNoop    = "noop"
+	NotifierWebhook = "webhook"
+	NotifierBroker  = "broker"
+)
+
+// Moments the deletion of a user is notified at.
+const (
+	DeletionNotifyOnPurge     = "purge"
+	DeletionNotifyOnTombstone = "tombstone"
+)
+
+// DeletionConfig represents the configuration of the deleted users.
+type DeletionConfig struct {
+	// GracePeriod is the time the deleted users can be restored within.
+	GracePeriod time.Duration `split_words:"true" default:"720h"`
+	// Retention is the time the deleted users are kept before being purged, at least the grace period.
+	Retention time.Duration `default:"720h"`
+	// NotifyOn is the moment the deletion is notified at, one of purge or tombstone.
+	NotifyOn string `split_words:"true" default:"purge"`
+}
+
 // Config represents config with variables needed for an app.
 type Config struct {
 	*sapp.Config
 
-	// Add your custom config variables here.
+	// Outbox configuration, used to relay the user events.
+	Outbox outbox.Config `split_words:"true"`
+
+	// Scheduler configuration, used to run the periodic jobs, e.g. lifting the expired suspensions.
+	Scheduler scheduler.Config `split_words:"true"`
+
+	// Deletion configuration, used to restore and purge the deleted users.
+	Deletion DeletionConfig `split_words:"true"`
+
+	// Notifier is the notifier the user events are delivered to, one of noop, webhook or broker.
+	Notifier string `default:"noop"`
+
+	// EventSource is the CloudEvents source of the user events.
+	EventSource string `split_words:"true" default:"/faceit/users"`
+
+	// Webhook configuration, used to deliver the user events to the subscribers.
+	Webhook notifier.WebhookConfig `split_words:"true"`
+
+	// Broker configuration, used to publish the user events to a message broker.
+	Broker notifier.BrokerConfig `split_words:"true"`
+
+	// Mailer configuration, used to email the verification links to the users.
+	Mailer notifier.MailerConfig `split_words:"true"`
+
+	// Feed configuration, used to stream the user events to the watchers.
+	Feed feed.Config `split_words:"true"`
+
+	// PageTokenSecret is the secret key the page tokens are signed with, shared by all the instances.
+	PageTokenSecret string `split_words:"true"`
+
+	// Argon2 configuration, the parameters the passwords are hashed with.
+	Argon2 credentials.Config `split_words:"true"`
+
+	// Auth configuration, used to issue the sessions of the authenticated users and lock out the failed logins.
+	Auth auth.Config `split_words:"true"`
+
+	// SSE configuration, used to stream the user events to the watchers over Server-Sent Events.
+	SSE service.SSEConfig `split_words:"true"`
+
+	// Request configuration, used to carry the information of the requests audited, e.g. the address of the client.
+	Request service.RequestConfig `split_words:"true"`
+
+	// Idempotency configuration, used to replay the outcome of the mutations retried with an idempotency key.
+	Idempotency service.IdempotencyConfig `split_words:"true"`
 }
@@ -0,0 +1,18 @@
+package credentials
+
+// Config holds the Argon2id parameters the passwords are hashed with.
+//
+// Hashes keep the parameters they were computed with, changing them does not invalidate the stored hashes, they are
+// rehashed with the new parameters on the next successful login.
+type Config struct {
+	// Memory is the memory used by the hashing, in KiB.
+	Memory uint32 `default:"65536"`
+	// Iterations is the number of passes over the memory.
+	Iterations uint32 `default:"3"`
+	// Parallelism is the number of threads used by the hashing.
+	Parallelism uint8 `default:"2"`
+	// SaltLength is the length of the random salt generated per password, in bytes.
+	SaltLength uint32 `split_words:"true" default:"16"`
+	// KeyLength is the length of the hash, in bytes.
+	KeyLength uint32 `split_words:"true" default:"32"`
+}
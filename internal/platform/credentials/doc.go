@@ -0,0 +1,2 @@
+// Package credentials hashes and verifies the user passwords.
+package credentials
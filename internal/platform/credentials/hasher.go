@@ -0,0 +1,144 @@
+package credentials
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// ErrUnknownHashFormat occurs when the stored hash is neither an Argon2id PHC string nor a legacy SHA-256 hash.
+var ErrUnknownHashFormat = errors.New("unknown password hash format")
+
+// argon2idPrefix is the prefix of the Argon2id hashes in PHC string format.
+const argon2idPrefix = "$argon2id$"
+
+// Hasher hashes the passwords with Argon2id, encoded in PHC string format.
+//
+// It verifies as well the legacy hashes, the unsalted SHA-256 of the password hex encoded, reporting they must be
+// rehashed.
+type Hasher struct {
+	cfg Config
+}
+
+// NewHasher creates a new Hasher with the Argon2id parameters.
+func NewHasher(cfg Config) *Hasher {
+	return &Hasher{cfg: cfg}
+}
+
+// Hash hashes the password with a random salt, e.g. $argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>.
+func (h *Hasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.cfg.SaltLength)
+
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("generate salt: %w", err)
+	}
+
+	p := phc{
+		memory:      h.cfg.Memory,
+		iterations:  h.cfg.Iterations,
+		parallelism: h.cfg.Parallelism,
+		salt:        salt,
+	}
+
+	p.key = argon2.IDKey([]byte(password), p.salt, p.iterations, p.memory, p.parallelism, h.cfg.KeyLength)
+
+	return p.String(), nil
+}
+
+// Verify reports whether the password matches the encoded hash, and whether the hash must be rehashed as it is a
+// legacy hash or was computed with other parameters than the configured ones.
+func (h *Hasher) Verify(password, encodedHash string) (match, rehash bool, err error) {
+	if !strings.HasPrefix(encodedHash, argon2idPrefix) {
+		return h.verifyLegacy(password, encodedHash)
+	}
+
+	p, err := parsePHC(encodedHash)
+	if err != nil {
+		return false, false, err
+	}
+
+	key := argon2.IDKey([]byte(password), p.salt, p.iterations, p.memory, p.parallelism, uint32(len(p.key))) //nolint:gosec // Key length is small.
+
+	if subtle.ConstantTimeCompare(key, p.key) != 1 {
+		return false, false, nil
+	}
+
+	rehash = p.memory != h.cfg.Memory || p.iterations != h.cfg.Iterations || p.parallelism != h.cfg.Parallelism ||
+		uint32(len(p.salt)) != h.cfg.SaltLength || uint32(len(p.key)) != h.cfg.KeyLength //nolint:gosec // Lengths are small.
+
+	return true, rehash, nil
+}
+
+// verifyLegacy verifies the password against the legacy hash, the unsalted SHA-256 of the password hex encoded.
+func (h *Hasher) verifyLegacy(password, encodedHash string) (match, rehash bool, err error) {
+	stored, err := hex.DecodeString(encodedHash)
+	if err != nil || len(stored) != sha256.Size {
+		return false, false, ErrUnknownHashFormat
+	}
+
+	sum := sha256.Sum256([]byte(password))
+
+	if subtle.ConstantTimeCompare(sum[:], stored) != 1 {
+		return false, false, nil
+	}
+
+	return true, true, nil
+}
+
+// phc is an Argon2id hash in PHC string format.
+type phc struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	salt        []byte
+	key         []byte
+}
+
+// String encodes the hash in PHC string format.
+func (p phc) String() string {
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix, argon2.Version, p.memory, p.iterations, p.parallelism,
+		base64.RawStdEncoding.EncodeToString(p.salt), base64.RawStdEncoding.EncodeToString(p.key),
+	)
+}
+
+// parsePHC parses the Argon2id hash in PHC string format.
+func parsePHC(encodedHash string) (phc, error) {
+	// "", "argon2id", "v=19", "m=65536,t=3,p=2", salt, key
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 {
+		return phc{}, ErrUnknownHashFormat
+	}
+
+	var (
+		p       phc
+		version int
+	)
+
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return phc{}, fmt.Errorf("%w: unsupported version %q", ErrUnknownHashFormat, parts[2])
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.iterations, &p.parallelism); err != nil {
+		return phc{}, fmt.Errorf("%w: %w", ErrUnknownHashFormat, err)
+	}
+
+	var err error
+
+	if p.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return phc{}, fmt.Errorf("%w: %w", ErrUnknownHashFormat, err)
+	}
+
+	if p.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(p.key) == 0 {
+		return phc{}, fmt.Errorf("%w: invalid key", ErrUnknownHashFormat)
+	}
+
+	return p, nil
+}
@@ -0,0 +1,90 @@
+package credentials_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/dohernandez/faceit/internal/platform/credentials"
+	"github.com/stretchr/testify/require"
+)
+
+// cfg keeps the hashing cheap for the tests.
+var cfg = credentials.Config{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+func TestHasher_Hash(t *testing.T) {
+	t.Parallel()
+
+	h := credentials.NewHasher(cfg)
+
+	hash, err := h.Hash("s3cr3t-passw0rd")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"), hash)
+
+	other, err := h.Hash("s3cr3t-passw0rd")
+	require.NoError(t, err)
+	require.NotEqual(t, hash, other, "salt must be random")
+
+	match, rehash, err := h.Verify("s3cr3t-passw0rd", hash)
+	require.NoError(t, err)
+	require.True(t, match)
+	require.False(t, rehash)
+
+	match, rehash, err = h.Verify("wrong-password", hash)
+	require.NoError(t, err)
+	require.False(t, match)
+	require.False(t, rehash)
+}
+
+func TestHasher_Verify_rehash(t *testing.T) {
+	t.Parallel()
+
+	old, err := credentials.NewHasher(cfg).Hash("s3cr3t-passw0rd")
+	require.NoError(t, err)
+
+	stronger := cfg
+	stronger.Iterations = 2
+
+	match, rehash, err := credentials.NewHasher(stronger).Verify("s3cr3t-passw0rd", old)
+	require.NoError(t, err)
+	require.True(t, match)
+	require.True(t, rehash, "parameters changed")
+}
+
+func TestHasher_Verify_legacy(t *testing.T) {
+	t.Parallel()
+
+	h := credentials.NewHasher(cfg)
+
+	sum := sha256.Sum256([]byte("s3cr3t-passw0rd"))
+	legacy := hex.EncodeToString(sum[:])
+
+	match, rehash, err := h.Verify("s3cr3t-passw0rd", legacy)
+	require.NoError(t, err)
+	require.True(t, match)
+	require.True(t, rehash)
+
+	match, rehash, err = h.Verify("wrong-password", legacy)
+	require.NoError(t, err)
+	require.False(t, match)
+	require.False(t, rehash)
+}
+
+func TestHasher_Verify_unknownFormat(t *testing.T) {
+	t.Parallel()
+
+	h := credentials.NewHasher(cfg)
+
+	for _, hash := range []string{
+		"",
+		"f6b7e19e0d867de6c039187905",
+		"$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy",
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA",
+		"$argon2id$v=16$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=x,t=1,p=1$c2FsdA$a2V5",
+	} {
+		_, _, err := h.Verify("s3cr3t-passw0rd", hash)
+		require.ErrorIs(t, err, credentials.ErrUnknownHashFormat, hash)
+	}
+}
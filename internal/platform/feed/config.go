@@ -0,0 +1,13 @@
+package feed
+
+import "time"
+
+// Config represents the feed configuration.
+type Config struct {
+	// PollInterval is the time between two consecutive reads of the events relayed.
+	PollInterval time.Duration `split_words:"true" default:"500ms"`
+	// BatchSize is the maximum number of events read at once.
+	BatchSize uint64 `split_words:"true" default:"100"`
+	// BufferSize is the number of events buffered per watcher, a watcher falling further behind is disconnected.
+	BufferSize int `split_words:"true" default:"256"`
+}
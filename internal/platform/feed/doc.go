@@ -0,0 +1,2 @@
+// Package feed streams the user events relayed from the outbox to the watchers.
+package feed
@@ -0,0 +1,268 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/outbox"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+)
+
+// Store defines functionality to read the events relayed from the outbox.
+type Store interface {
+	ListPublishedAfter(ctx context.Context, after storage.OutboxPosition, limit uint64) ([]*storage.OutboxEvent, error)
+	LastPublished(ctx context.Context) (storage.OutboxPosition, error)
+}
+
+// eventTypes maps the outbox event types to the user event types.
+var eventTypes = map[string]model.UserEventType{
+	storage.EventUserAdded:         model.UserAddedV1,
+	storage.EventUserUpdated:       model.UserUpdatedV1,
+	storage.EventUserDeleted:       model.UserDeletedV1,
+	storage.EventUserRestored:      model.UserRestoredV1,
+	storage.EventUserStatusChanged: model.UserStatusChangedV1,
+	storage.EventUserPasswordReset: model.UserPasswordResetV1,
+}
+
+// entry is an event broadcast to the watchers along with its position.
+type entry struct {
+	event    model.UserEvent
+	position storage.OutboxPosition
+}
+
+type watcher struct {
+	entries chan entry
+	slow    chan struct{}
+}
+
+// Feed streams the user events relayed from the outbox.
+//
+// It reads the events in the order they were relayed, once per poll interval whatever the number of watchers, and
+// broadcasts them to every watcher. The events carry a resume token, a watcher reconnecting with it gets the events
+// relayed since, then the live ones. Delivery is at least once, watchers discard duplicates by event id.
+type Feed struct {
+	store  Store
+	source string
+	cfg    Config
+
+	mu       sync.Mutex
+	position storage.OutboxPosition
+	watchers map[*watcher]struct{}
+
+	// OnError is called when reading the events fails.
+	OnError func(ctx context.Context, err error)
+}
+
+// New creates a new Feed, the events are emitted from the given source.
+func New(store Store, source string, cfg Config) *Feed {
+	return &Feed{
+		store:    store,
+		source:   source,
+		cfg:      cfg,
+		watchers: make(map[*watcher]struct{}),
+	}
+}
+
+// Run reads the events relayed from now on every poll interval until the context is done.
+func (f *Feed) Run(ctx context.Context) {
+	ticker := time.NewTicker(f.cfg.PollInterval)
+	defer ticker.Stop()
+
+	started := false
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			var err error
+
+			if started {
+				err = f.Poll(ctx)
+			} else {
+				err = f.Start(ctx)
+				started = err == nil
+			}
+
+			if err != nil && f.OnError != nil && ctx.Err() == nil {
+				f.OnError(ctx, err)
+			}
+		}
+	}
+}
+
+// Start positions the feed after the last event relayed.
+func (f *Feed) Start(ctx context.Context) error {
+	p, err := f.store.LastPublished(ctx)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.position = p
+
+	return nil
+}
+
+// Poll reads the events relayed since the last poll and broadcasts them to the watchers.
+func (f *Feed) Poll(ctx context.Context) error {
+	f.mu.Lock()
+	position := f.position
+	f.mu.Unlock()
+
+	for {
+		events, err := f.store.ListPublishedAfter(ctx, position, f.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, oe := range events {
+			e, err := f.userEvent(oe)
+			if err != nil {
+				return err
+			}
+
+			position = oe.Position()
+
+			f.broadcast(entry{event: e, position: position})
+		}
+
+		if uint64(len(events)) < f.cfg.BatchSize {
+			return nil
+		}
+	}
+}
+
+func (f *Feed) broadcast(e entry) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.position = e.position
+
+	for w := range f.watchers {
+		select {
+		case w.entries <- e:
+		default:
+			// The watcher does not keep up, it is disconnected so it resumes from its last event.
+			close(w.slow)
+			delete(f.watchers, w)
+		}
+	}
+}
+
+func (f *Feed) watch() *watcher {
+	w := &watcher{
+		entries: make(chan entry, f.cfg.BufferSize),
+		slow:    make(chan struct{}),
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.watchers[w] = struct{}{}
+
+	return w
+}
+
+func (f *Feed) unwatch(w *watcher) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.watchers, w)
+}
+
+// StreamUserEvents calls fn with every user event after the one of the resume token, or with the events relayed from
+// now on when the token is empty, until the context is done or fn fails.
+//
+// It fails with usecase.ErrSlowSubscriber when fn does not keep up with the events.
+func (f *Feed) StreamUserEvents(ctx context.Context, resumeToken string, fn func(e model.UserEvent) error) error {
+	var (
+		last   storage.OutboxPosition
+		replay = resumeToken != ""
+	)
+
+	if replay {
+		var err error
+
+		if last, err = decodeToken(resumeToken); err != nil {
+			return err
+		}
+	}
+
+	// Watch before replaying, so no event is missed in between.
+	w := f.watch()
+	defer f.unwatch(w)
+
+	for replay {
+		events, err := f.store.ListPublishedAfter(ctx, last, f.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, oe := range events {
+			e, err := f.userEvent(oe)
+			if err != nil {
+				return err
+			}
+
+			if err := fn(e); err != nil {
+				return err
+			}
+
+			last = oe.Position()
+		}
+
+		replay = uint64(len(events)) == f.cfg.BatchSize
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-w.slow:
+			return usecase.ErrSlowSubscriber
+		case e := <-w.entries:
+			// Skip the events already replayed.
+			if !after(e.position, last) {
+				continue
+			}
+
+			if err := fn(e.event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// userEvent maps the event relayed from the outbox to the user event.
+func (f *Feed) userEvent(e *storage.OutboxEvent) (model.UserEvent, error) {
+	t, ok := eventTypes[e.Type]
+	if !ok {
+		return model.UserEvent{}, fmt.Errorf("%w: %s", outbox.ErrUnknownEvent, e.Type)
+	}
+
+	u, err := e.User()
+	if err != nil {
+		return model.UserEvent{}, fmt.Errorf("decode event payload: %w", err)
+	}
+
+	data := model.NewUserEventData(e.UserID, u.UserState)
+	data.Status = string(u.Status) // Only the payload of the status changes carries the status.
+
+	return model.UserEvent{
+		ID:          e.EventID,
+		Source:      f.source,
+		Type:        t,
+		Subject:     e.UserID,
+		Time:        e.CreatedAt.UTC(),
+		Data:        data,
+		Country:     e.Country.String,
+		ResumeToken: encodeToken(e.Position()),
+	}, nil
+}
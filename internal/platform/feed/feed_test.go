@@ -0,0 +1,208 @@
+package feed
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type store struct {
+	mu     sync.Mutex
+	events []*storage.OutboxEvent
+}
+
+func (s *store) add(t *testing.T, id int64, eventType string, u model.User) {
+	t.Helper()
+
+	payload, err := json.Marshal(u)
+	require.NoError(t, err)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, &storage.OutboxEvent{
+		ID:          id,
+		EventID:     uuid.New(),
+		UserID:      u.ID,
+		Type:        eventType,
+		Payload:     payload,
+		Country:     sql.NullString{String: u.Country, Valid: u.Country != ""},
+		PublishedAt: sql.NullTime{Time: time.Date(2024, 12, 16, 10, 0, 0, 0, time.UTC).Add(time.Duration(id) * time.Second), Valid: true},
+	})
+}
+
+func (s *store) ListPublishedAfter(_ context.Context, after storage.OutboxPosition, limit uint64) ([]*storage.OutboxEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var events []*storage.OutboxEvent
+
+	for _, e := range s.events {
+		if e.PublishedAt.Time.After(after.PublishedAt) && uint64(len(events)) < limit {
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
+
+func (s *store) LastPublished(_ context.Context) (storage.OutboxPosition, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.events) == 0 {
+		return storage.OutboxPosition{}, nil
+	}
+
+	return s.events[len(s.events)-1].Position(), nil
+}
+
+func TestFeed_StreamUserEvents(t *testing.T) {
+	t.Parallel()
+
+	cfg := Config{BatchSize: 2, BufferSize: 10}
+
+	alice := model.User{ID: uuid.New(), UserState: model.UserState{FirstName: "Alice", Country: "UK"}}
+
+	newStore := func(t *testing.T) *store {
+		t.Helper()
+
+		st := &store{}
+		st.add(t, 1, storage.EventUserAdded, alice)
+		st.add(t, 2, storage.EventUserUpdated, model.User{ID: alice.ID, UserState: model.UserState{Nickname: "AB123"}})
+		st.add(t, 3, storage.EventUserUpdated, model.User{ID: alice.ID, UserState: model.UserState{LastName: "Bob"}})
+
+		return st
+	}
+
+	t.Run("success, replay then live", func(t *testing.T) {
+		t.Parallel()
+
+		st := newStore(t)
+		f := New(st, "/faceit/users", cfg)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var received []model.UserEvent
+
+		// The watcher knows the first event, the following ones are delivered once, whether replayed or broadcast.
+		err := f.StreamUserEvents(ctx, encodeToken(st.events[0].Position()), func(e model.UserEvent) error {
+			received = append(received, e)
+
+			switch len(received) {
+			case 2:
+				st.add(t, 4, storage.EventUserDeleted, model.User{ID: alice.ID})
+
+				// Broadcasts events 1 to 4 while replaying, the ones replayed are skipped.
+				require.NoError(t, f.Poll(ctx))
+			case 3:
+				cancel()
+			}
+
+			return nil
+		})
+		require.ErrorIs(t, err, context.Canceled)
+
+		require.Len(t, received, 3)
+
+		assert.Equal(t, model.UserEvent{
+			ID:          st.events[1].EventID,
+			Source:      "/faceit/users",
+			Type:        model.UserUpdatedV1,
+			Subject:     alice.ID,
+			Data:        model.UserEventData{ID: alice.ID.String(), Nickname: "AB123"},
+			ResumeToken: encodeToken(st.events[1].Position()),
+		}, received[0])
+
+		assert.Equal(t, model.UserUpdatedV1, received[1].Type)
+		assert.Equal(t, model.UserDeletedV1, received[2].Type)
+		assert.Equal(t, st.events[3].EventID, received[2].ID)
+	})
+
+	t.Run("success, live from the last event relayed", func(t *testing.T) {
+		t.Parallel()
+
+		st := newStore(t)
+		f := New(st, "/faceit/users", cfg)
+
+		require.NoError(t, f.Start(context.Background()))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		done := make(chan error)
+
+		var received []model.UserEvent
+
+		go func() {
+			done <- f.StreamUserEvents(ctx, "", func(e model.UserEvent) error {
+				received = append(received, e)
+				cancel()
+
+				return nil
+			})
+		}()
+
+		st.add(t, 4, storage.EventUserAdded, model.User{ID: uuid.New(), UserState: model.UserState{Country: "DE"}})
+
+		// Poll until the watcher is registered and gets the event.
+		require.Eventually(t, func() bool {
+			f.mu.Lock()
+			watching := len(f.watchers) == 1
+			f.mu.Unlock()
+
+			return watching
+		}, time.Second, time.Millisecond)
+
+		require.NoError(t, f.Poll(ctx))
+		require.ErrorIs(t, <-done, context.Canceled)
+
+		require.Len(t, received, 1)
+		assert.Equal(t, st.events[3].EventID, received[0].ID)
+		assert.Equal(t, "DE", received[0].Country)
+	})
+
+	t.Run("error, slow subscriber", func(t *testing.T) {
+		t.Parallel()
+
+		st := newStore(t)
+		f := New(st, "/faceit/users", Config{BatchSize: 10, BufferSize: 1})
+
+		err := f.StreamUserEvents(context.Background(), encodeToken(st.events[1].Position()), func(_ model.UserEvent) error {
+			// Broadcasting 3 events overflows the buffer of the watcher.
+			return f.Poll(context.Background())
+		})
+		require.ErrorIs(t, err, usecase.ErrSlowSubscriber)
+	})
+
+	t.Run("error, invalid resume token", func(t *testing.T) {
+		t.Parallel()
+
+		f := New(&store{}, "/faceit/users", cfg)
+
+		err := f.StreamUserEvents(context.Background(), "invalid", nil)
+		require.ErrorIs(t, err, usecase.ErrInvalidResumeToken)
+	})
+}
+
+func TestToken(t *testing.T) {
+	t.Parallel()
+
+	p := storage.OutboxPosition{PublishedAt: time.Date(2024, 12, 16, 10, 0, 0, 123456000, time.UTC), ID: 42}
+
+	decoded, err := decodeToken(encodeToken(p))
+	require.NoError(t, err)
+
+	assert.Equal(t, p, decoded)
+}
@@ -0,0 +1,55 @@
+package feed
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+)
+
+// encodeToken encodes the position of the event into an opaque resume token.
+func encodeToken(p storage.OutboxPosition) string {
+	raw := strconv.FormatInt(p.PublishedAt.UnixMicro(), 10) + "." + strconv.FormatInt(p.ID, 10)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeToken decodes the position of the event from the resume token.
+func decodeToken(token string) (storage.OutboxPosition, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return storage.OutboxPosition{}, fmt.Errorf("%w: %w", usecase.ErrInvalidResumeToken, err)
+	}
+
+	at, id, ok := strings.Cut(string(raw), ".")
+	if !ok {
+		return storage.OutboxPosition{}, usecase.ErrInvalidResumeToken
+	}
+
+	micro, err := strconv.ParseInt(at, 10, 64)
+	if err != nil {
+		return storage.OutboxPosition{}, fmt.Errorf("%w: %w", usecase.ErrInvalidResumeToken, err)
+	}
+
+	p := storage.OutboxPosition{PublishedAt: time.UnixMicro(micro).UTC()}
+
+	p.ID, err = strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return storage.OutboxPosition{}, fmt.Errorf("%w: %w", usecase.ErrInvalidResumeToken, err)
+	}
+
+	return p, nil
+}
+
+// after reports whether the position a comes after the position b.
+func after(a, b storage.OutboxPosition) bool {
+	if a.PublishedAt.Equal(b.PublishedAt) {
+		return a.ID > b.ID
+	}
+
+	return a.PublishedAt.After(b.PublishedAt)
+}
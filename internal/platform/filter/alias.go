@@ -0,0 +1,53 @@
+package filter
+
+// Aliases maps alternative names of the fields, like the names exposed by an API, to the names of their columns.
+type Aliases map[string]string
+
+// Expr returns the expression with the aliased fields renamed to their columns.
+func (a Aliases) Expr(e Expr) Expr {
+	switch e := e.(type) {
+	case And:
+		exprs := make([]Expr, 0, len(e.Exprs))
+
+		for _, sub := range e.Exprs {
+			exprs = append(exprs, a.Expr(sub))
+		}
+
+		return And{Exprs: exprs}
+	case Or:
+		exprs := make([]Expr, 0, len(e.Exprs))
+
+		for _, sub := range e.Exprs {
+			exprs = append(exprs, a.Expr(sub))
+		}
+
+		return Or{Exprs: exprs}
+	case Not:
+		return Not{Expr: a.Expr(e.Expr)}
+	case Restriction:
+		e.Field = a.field(e.Field)
+
+		return e
+	default:
+		return e
+	}
+}
+
+// Orders returns the orders with the aliased fields renamed to their columns.
+func (a Aliases) Orders(orders []Order) []Order {
+	renamed := make([]Order, 0, len(orders))
+
+	for _, o := range orders {
+		renamed = append(renamed, Order{Field: a.field(o.Field), Desc: o.Desc})
+	}
+
+	return renamed
+}
+
+func (a Aliases) field(name string) string {
+	if col, ok := a[name]; ok {
+		return col
+	}
+
+	return name
+}
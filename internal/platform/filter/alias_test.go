@@ -0,0 +1,30 @@
+package filter_test
+
+import (
+	"testing"
+
+	"github.com/dohernandez/faceit/internal/platform/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAliases(t *testing.T) {
+	t.Parallel()
+
+	aliases := filter.Aliases{"create_time": "created_at", "update_time": "updated_at"}
+
+	e, err := filter.Parse(`update_time >= "2024-12-01" AND NOT (create_time < "2024-01-01" OR country = "UK")`)
+	require.NoError(t, err)
+
+	require.Equal(t, filter.And{Exprs: []filter.Expr{
+		filter.Restriction{Field: "updated_at", Op: filter.OpGreaterOrEqual, Value: filter.Value{Text: "2024-12-01", Quoted: true}},
+		filter.Not{Expr: filter.Or{Exprs: []filter.Expr{
+			filter.Restriction{Field: "created_at", Op: filter.OpLess, Value: filter.Value{Text: "2024-01-01", Quoted: true}},
+			filter.Restriction{Field: "country", Op: filter.OpEqual, Value: filter.Value{Text: "UK", Quoted: true}},
+		}}},
+	}}, aliases.Expr(e))
+
+	orders, err := filter.ParseOrderBy("update_time, id desc")
+	require.NoError(t, err)
+
+	require.Equal(t, []filter.Order{{Field: "updated_at"}, {Field: "id", Desc: true}}, aliases.Orders(orders))
+}
@@ -0,0 +1,53 @@
+package filter
+
+// Operator is the comparator of a restriction.
+type Operator string
+
+// Supported operators.
+const (
+	OpEqual          Operator = "="
+	OpNotEqual       Operator = "!="
+	OpLess           Operator = "<"
+	OpLessOrEqual    Operator = "<="
+	OpGreater        Operator = ">"
+	OpGreaterOrEqual Operator = ">="
+	OpHas            Operator = ":"
+)
+
+// Expr is a node of the filter expression tree.
+type Expr interface {
+	expr()
+}
+
+// And is the conjunction of the expressions.
+type And struct {
+	Exprs []Expr
+}
+
+// Or is the disjunction of the expressions.
+type Or struct {
+	Exprs []Expr
+}
+
+// Not is the negation of the expression.
+type Not struct {
+	Expr Expr
+}
+
+// Restriction compares the field with the value.
+type Restriction struct {
+	Field string
+	Op    Operator
+	Value Value
+}
+
+// Value is the argument of a restriction.
+type Value struct {
+	Text   string
+	Quoted bool // Whether the value was given as a string literal
+}
+
+func (And) expr()         {}
+func (Or) expr()          {}
+func (Not) expr()         {}
+func (Restriction) expr() {}
@@ -0,0 +1,4 @@
+// Package filter parses the AIP-160 filter and AIP-132 order by expressions, and translates them to SQL predicates.
+//
+// See https://google.aip.dev/160 and https://google.aip.dev/132#ordering.
+package filter
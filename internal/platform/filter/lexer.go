@@ -0,0 +1,140 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// tokenKind represents the kind of token.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenText
+	tokenString
+	tokenComparator
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+// token is a lexical token of the expression.
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// SyntaxError occurs when the expression is malformed.
+type SyntaxError struct {
+	Pos int // Byte offset of the error in the expression
+	Msg string
+}
+
+// Error implements error.
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Msg, e.Pos+1)
+}
+
+// lex splits the expression into tokens.
+func lex(s string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", pos: i})
+			i++
+		case c == '=' || c == ':':
+			tokens = append(tokens, token{kind: tokenComparator, text: string(c), pos: i})
+			i++
+		case c == '<' || c == '>' || c == '!':
+			op := string(c)
+			if i+1 < len(s) && s[i+1] == '=' {
+				op += "="
+			}
+
+			if op == "!" {
+				return nil, &SyntaxError{Pos: i, Msg: `unexpected "!"`}
+			}
+
+			tokens = append(tokens, token{kind: tokenComparator, text: op, pos: i})
+			i += len(op)
+		case c == '"' || c == '\'':
+			text, n, err := lexString(s[i:])
+			if err != nil {
+				return nil, &SyntaxError{Pos: i, Msg: err.Error()}
+			}
+
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: i})
+			i += n
+		default:
+			start := i
+
+			for i < len(s) && !isDelimiter(s[i]) {
+				i++
+			}
+
+			text := s[start:i]
+			if !isPrintable(text) {
+				return nil, &SyntaxError{Pos: start, Msg: "unexpected character"}
+			}
+
+			tokens = append(tokens, token{kind: tokenText, text: text, pos: start})
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(s)}), nil
+}
+
+// lexString reads the quoted string at the beginning of s, returning its unescaped text and length.
+func lexString(s string) (string, int, error) {
+	quote := s[0]
+
+	var sb strings.Builder
+
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; c {
+		case quote:
+			return sb.String(), i + 1, nil
+		case '\\':
+			if i+1 == len(s) {
+				return "", 0, fmt.Errorf("unterminated string")
+			}
+
+			i++
+			sb.WriteByte(s[i])
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+// isDelimiter reports whether c ends an unquoted text.
+func isDelimiter(c byte) bool {
+	return strings.IndexByte(" \t\n\r(),=:<>!\"'", c) >= 0
+}
+
+// isPrintable reports whether the text holds only printable characters.
+func isPrintable(text string) bool {
+	for _, r := range text {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+
+	return true
+}
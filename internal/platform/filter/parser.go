@@ -0,0 +1,306 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxDepth is the maximum nesting of composite expressions.
+const maxDepth = 32
+
+// Keywords of the filter grammar.
+const (
+	keywordAnd = "AND"
+	keywordOr  = "OR"
+	keywordNot = "NOT"
+)
+
+// Parse parses the filter expression into the expression tree.
+//
+// It returns nil when the filter is empty. Global restrictions, a value without field and comparator, and traversal
+// of nested fields are not supported.
+func Parse(filter string) (Expr, error) {
+	tokens, err := lex(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	p := parser{tokens: tokens}
+
+	if p.peek().kind == tokenEOF {
+		return nil, nil //nolint:nilnil // Empty filter matches everything.
+	}
+
+	e, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, p.unexpected(t)
+	}
+
+	return e, nil
+}
+
+// parser is a recursive descent parser of the AIP-160 grammar.
+//
+//	expression : sequence { "AND" sequence }
+//	sequence   : factor { factor }
+//	factor     : term { "OR" term }
+//	term       : [ "NOT" | "-" ] simple
+//	simple     : restriction | "(" expression ")"
+//	restriction: field comparator arg
+type parser struct {
+	tokens []token
+	pos    int
+	depth  int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+
+	return t
+}
+
+func (p *parser) isKeyword(t token, keyword string) bool {
+	return t.kind == tokenText && t.text == keyword
+}
+
+func (p *parser) expression() (Expr, error) {
+	e, err := p.sequence()
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := []Expr{e}
+
+	for p.isKeyword(p.peek(), keywordAnd) {
+		p.next()
+
+		if e, err = p.sequence(); err != nil {
+			return nil, err
+		}
+
+		exprs = append(exprs, e)
+	}
+
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+
+	return And{Exprs: exprs}, nil
+}
+
+func (p *parser) sequence() (Expr, error) {
+	e, err := p.factor()
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := []Expr{e}
+
+	for p.startsTerm(p.peek()) {
+		if e, err = p.factor(); err != nil {
+			return nil, err
+		}
+
+		exprs = append(exprs, e)
+	}
+
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+
+	return And{Exprs: exprs}, nil
+}
+
+func (p *parser) factor() (Expr, error) {
+	e, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := []Expr{e}
+
+	for p.isKeyword(p.peek(), keywordOr) {
+		p.next()
+
+		if e, err = p.term(); err != nil {
+			return nil, err
+		}
+
+		exprs = append(exprs, e)
+	}
+
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+
+	return Or{Exprs: exprs}, nil
+}
+
+// startsTerm reports whether the token starts a term, implicitly joined to the previous one.
+func (p *parser) startsTerm(t token) bool {
+	switch t.kind {
+	case tokenLParen:
+		return true
+	case tokenText:
+		return t.text != keywordAnd && t.text != keywordOr
+	default:
+		return false
+	}
+}
+
+func (p *parser) term() (Expr, error) {
+	t := p.peek()
+
+	switch {
+	case p.isKeyword(t, keywordNot):
+		p.next()
+
+		e, err := p.simple()
+		if err != nil {
+			return nil, err
+		}
+
+		return Not{Expr: e}, nil
+	case t.kind == tokenText && len(t.text) > 1 && t.text[0] == '-':
+		// Negation prefix, the token holds the field of the restriction.
+		p.tokens[p.pos].text = t.text[1:]
+		p.tokens[p.pos].pos++
+
+		e, err := p.simple()
+		if err != nil {
+			return nil, err
+		}
+
+		return Not{Expr: e}, nil
+	default:
+		return p.simple()
+	}
+}
+
+func (p *parser) simple() (Expr, error) {
+	t := p.next()
+
+	switch {
+	case t.kind == tokenLParen:
+		if p.depth++; p.depth > maxDepth {
+			return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("too deeply nested, maximum %d levels", maxDepth)}
+		}
+
+		e, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+
+		if r := p.next(); r.kind != tokenRParen {
+			return nil, p.unexpected(r)
+		}
+
+		p.depth--
+
+		return e, nil
+	case t.kind == tokenText && !p.isKeyword(t, keywordAnd) && !p.isKeyword(t, keywordOr) &&
+		!p.isKeyword(t, keywordNot):
+		return p.restriction(t)
+	default:
+		return nil, p.unexpected(t)
+	}
+}
+
+func (p *parser) restriction(field token) (Expr, error) {
+	op := p.next()
+	if op.kind != tokenComparator {
+		return nil, &SyntaxError{Pos: op.pos, Msg: fmt.Sprintf("expected comparator after %q", field.text)}
+	}
+
+	arg := p.next()
+
+	switch {
+	case arg.kind == tokenString:
+		return Restriction{Field: field.text, Op: Operator(op.text), Value: Value{Text: arg.text, Quoted: true}}, nil
+	case arg.kind == tokenText && arg.text != keywordAnd && arg.text != keywordOr && arg.text != keywordNot:
+		return Restriction{Field: field.text, Op: Operator(op.text), Value: Value{Text: arg.text}}, nil
+	default:
+		return nil, &SyntaxError{Pos: arg.pos, Msg: fmt.Sprintf("expected value after %q %s", field.text, op.text)}
+	}
+}
+
+func (p *parser) unexpected(t token) error {
+	if t.kind == tokenEOF {
+		return &SyntaxError{Pos: t.pos, Msg: "unexpected end of filter"}
+	}
+
+	return &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unexpected %q", t.text)}
+}
+
+// Order is a field to sort by.
+type Order struct {
+	Field string
+	Desc  bool
+}
+
+// ParseOrderBy parses the comma separated list of fields to sort by, each one optionally followed by "asc" or "desc".
+func ParseOrderBy(orderBy string) ([]Order, error) {
+	tokens, err := lex(orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		orders []Order
+		seen   = make(map[string]bool)
+	)
+
+	for i := 0; tokens[i].kind != tokenEOF; i++ {
+		t := tokens[i]
+		if t.kind != tokenText {
+			return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unexpected %q", t.text)}
+		}
+
+		if seen[t.text] {
+			return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("duplicated field %q", t.text)}
+		}
+
+		seen[t.text] = true
+		o := Order{Field: t.text}
+
+		if next := tokens[i+1]; next.kind == tokenText {
+			switch strings.ToLower(next.text) {
+			case "asc":
+			case "desc":
+				o.Desc = true
+			default:
+				return nil, &SyntaxError{Pos: next.pos, Msg: fmt.Sprintf("unexpected %q, expected asc or desc", next.text)}
+			}
+
+			i++
+		}
+
+		orders = append(orders, o)
+
+		switch next := tokens[i+1]; next.kind {
+		case tokenEOF:
+		case tokenComma:
+			i++
+
+			if tokens[i+1].kind == tokenEOF {
+				return nil, &SyntaxError{Pos: tokens[i+1].pos, Msg: "unexpected end of order by"}
+			}
+		default:
+			return nil, &SyntaxError{Pos: next.pos, Msg: fmt.Sprintf("unexpected %q", next.text)}
+		}
+	}
+
+	return orders, nil
+}
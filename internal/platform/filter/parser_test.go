@@ -0,0 +1,133 @@
+package filter_test
+
+import (
+	"testing"
+
+	"github.com/dohernandez/faceit/internal/platform/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	country := filter.Restriction{Field: "country", Op: filter.OpEqual, Value: filter.Value{Text: "UK", Quoted: true}}
+	nickname := filter.Restriction{Field: "nickname", Op: filter.OpHas, Value: filter.Value{Text: "pro*", Quoted: true}}
+	createdAt := filter.Restriction{Field: "created_at", Op: filter.OpGreater, Value: filter.Value{Text: "2024-01-01"}}
+
+	for _, tc := range []struct {
+		name   string
+		filter string
+		want   filter.Expr
+	}{
+		{
+			name:   "empty",
+			filter: "  ",
+			want:   nil,
+		},
+		{
+			name:   "restriction",
+			filter: `country = "UK"`,
+			want:   country,
+		},
+		{
+			name:   "and",
+			filter: `country = "UK" AND created_at > 2024-01-01 AND nickname:"pro*"`,
+			want:   filter.And{Exprs: []filter.Expr{country, createdAt, nickname}},
+		},
+		{
+			name:   "implicit and",
+			filter: `country="UK" created_at>2024-01-01`,
+			want:   filter.And{Exprs: []filter.Expr{country, createdAt}},
+		},
+		{
+			name:   "or binds tighter than and",
+			filter: `country = "UK" AND created_at > 2024-01-01 OR nickname:"pro*"`,
+			want: filter.And{Exprs: []filter.Expr{
+				country,
+				filter.Or{Exprs: []filter.Expr{createdAt, nickname}},
+			}},
+		},
+		{
+			name:   "composite",
+			filter: `(country = "UK" AND created_at > 2024-01-01) OR nickname:"pro*"`,
+			want: filter.Or{Exprs: []filter.Expr{
+				filter.And{Exprs: []filter.Expr{country, createdAt}},
+				nickname,
+			}},
+		},
+		{
+			name:   "negation",
+			filter: `NOT country = "UK" AND -nickname:"pro*"`,
+			want: filter.And{Exprs: []filter.Expr{
+				filter.Not{Expr: country},
+				filter.Not{Expr: nickname},
+			}},
+		},
+		{
+			name:   "escaped string",
+			filter: `nickname != 'it\'s'`,
+			want:   filter.Restriction{Field: "nickname", Op: filter.OpNotEqual, Value: filter.Value{Text: "it's", Quoted: true}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			e, err := filter.Parse(tc.filter)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, e)
+		})
+	}
+}
+
+func TestParse_error(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		filter string
+		err    string
+	}{
+		{name: "global restriction", filter: `UK`, err: `expected comparator after "UK" at position 3`},
+		{name: "missing value", filter: `country =`, err: `expected value after "country" = at position 10`},
+		{name: "keyword value", filter: `country = AND`, err: `expected value after "country" = at position 11`},
+		{name: "dangling and", filter: `country = "UK" AND`, err: `unexpected end of filter at position 19`},
+		{name: "unbalanced", filter: `(country = "UK"`, err: `unexpected end of filter at position 16`},
+		{name: "unexpected paren", filter: `country = "UK")`, err: `unexpected ")" at position 15`},
+		{name: "unterminated string", filter: `country = "UK`, err: `unterminated string at position 11`},
+		{name: "bang", filter: `country ! "UK"`, err: `unexpected "!" at position 9`},
+		{name: "too deep", filter: `(((((((((((((((((((((((((((((((((country = "UK")))))))))))))))))))))))))))))))))`, err: `too deeply nested, maximum 32 levels at position 33`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			e, err := filter.Parse(tc.filter)
+			require.Nil(t, e)
+
+			var syntaxErr *filter.SyntaxError
+
+			require.ErrorAs(t, err, &syntaxErr)
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestParseOrderBy(t *testing.T) {
+	t.Parallel()
+
+	orders, err := filter.ParseOrderBy("created_at desc, country,nickname ASC")
+	require.NoError(t, err)
+	require.Equal(t, []filter.Order{
+		{Field: "created_at", Desc: true},
+		{Field: "country"},
+		{Field: "nickname"},
+	}, orders)
+
+	orders, err = filter.ParseOrderBy("")
+	require.NoError(t, err)
+	require.Empty(t, orders)
+
+	for _, orderBy := range []string{"country,", "country sideways", "country desc nickname", "country, country desc", "(country)"} {
+		_, err = filter.ParseOrderBy(orderBy)
+		require.Error(t, err, orderBy)
+	}
+}
@@ -0,0 +1,273 @@
+package filter
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// ColumnType is the type of the column values.
+type ColumnType int
+
+// Supported column types.
+const (
+	TypeText ColumnType = iota
+	TypeTime
+	TypeUUID
+	// TypeNullableText is a text column holding NULL, sorted as the empty string so the rows holding NULL keep a
+	// position a cursor can resume from.
+	TypeNullableText
+)
+
+// Columns is the allow-list of columns the expressions can refer to, by name.
+type Columns map[string]ColumnType
+
+// Where translates the expression to the SQL predicate on the columns.
+//
+// Values are bound as arguments, only the names of the allowed columns make it into the SQL. A string value
+// compared by "=", "!=" or ":" holding the "*" wildcard translates into a LIKE pattern.
+func Where(e Expr, cols Columns) (squirrel.Sqlizer, error) {
+	switch e := e.(type) {
+	case And:
+		and := make(squirrel.And, 0, len(e.Exprs))
+
+		for _, sub := range e.Exprs {
+			pred, err := Where(sub, cols)
+			if err != nil {
+				return nil, err
+			}
+
+			and = append(and, pred)
+		}
+
+		return and, nil
+	case Or:
+		or := make(squirrel.Or, 0, len(e.Exprs))
+
+		for _, sub := range e.Exprs {
+			pred, err := Where(sub, cols)
+			if err != nil {
+				return nil, err
+			}
+
+			or = append(or, pred)
+		}
+
+		return or, nil
+	case Not:
+		pred, err := Where(e.Expr, cols)
+		if err != nil {
+			return nil, err
+		}
+
+		sql, args, err := pred.ToSql()
+		if err != nil {
+			return nil, err
+		}
+
+		return squirrel.Expr("NOT ("+sql+")", args...), nil
+	case Restriction:
+		return restriction(e, cols)
+	default:
+		return nil, fmt.Errorf("unsupported expression %T", e)
+	}
+}
+
+func restriction(r Restriction, cols Columns) (squirrel.Sqlizer, error) {
+	typ, ok := cols[r.Field]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q", r.Field)
+	}
+
+	if (typ == TypeText || typ == TypeNullableText) && strings.Contains(r.Value.Text, "*") {
+		pattern := likePattern(r.Value.Text)
+
+		switch r.Op { //nolint:exhaustive // Wildcards only apply to equality.
+		case OpEqual, OpHas:
+			return squirrel.Like{r.Field: pattern}, nil
+		case OpNotEqual:
+			return squirrel.NotLike{r.Field: pattern}, nil
+		}
+	}
+
+	value, err := columnValue(r.Field, r.Value.Text, typ)
+	if err != nil {
+		return nil, err
+	}
+
+	switch r.Op {
+	case OpEqual, OpHas:
+		return squirrel.Eq{r.Field: value}, nil
+	case OpNotEqual:
+		return squirrel.NotEq{r.Field: value}, nil
+	case OpLess:
+		return squirrel.Lt{r.Field: value}, nil
+	case OpLessOrEqual:
+		return squirrel.LtOrEq{r.Field: value}, nil
+	case OpGreater:
+		return squirrel.Gt{r.Field: value}, nil
+	case OpGreaterOrEqual:
+		return squirrel.GtOrEq{r.Field: value}, nil
+	default:
+		return nil, fmt.Errorf("unsupported comparator %q", r.Op)
+	}
+}
+
+// columnValue converts the text value of the field to the type of the column.
+func columnValue(field, text string, typ ColumnType) (any, error) {
+	switch typ {
+	case TypeTime:
+		for _, layout := range []string{time.RFC3339Nano, time.DateOnly} {
+			if t, err := time.Parse(layout, text); err == nil {
+				return t, nil
+			}
+		}
+
+		return nil, fmt.Errorf("invalid value %q of field %q, expected RFC 3339 timestamp or date", text, field)
+	case TypeUUID:
+		id, err := uuid.Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q of field %q, expected UUID", text, field)
+		}
+
+		return id, nil
+	default:
+		return text, nil
+	}
+}
+
+// likePattern converts the "*" wildcards to the LIKE pattern, escaping the LIKE special characters.
+func likePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`, `*`, `%`).Replace(s)
+}
+
+// OrderBy translates the orders to the SQL ORDER BY clauses on the columns.
+func OrderBy(orders []Order, cols Columns) ([]string, error) {
+	clauses := make([]string, 0, len(orders))
+
+	for _, o := range orders {
+		typ, ok := cols[o.Field]
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q", o.Field)
+		}
+
+		if o.Desc {
+			clauses = append(clauses, sortKey(o.Field, typ)+" DESC")
+
+			continue
+		}
+
+		clauses = append(clauses, sortKey(o.Field, typ)+" ASC")
+	}
+
+	return clauses, nil
+}
+
+// After translates the cursor to the SQL predicate matching the rows sorted after it.
+//
+// The cursor holds the values of the order fields of the last row seen, as returned by Values. The orders must end
+// with a unique field, so the position is unambiguous.
+func After(orders []Order, cols Columns, cursor []string) (squirrel.Sqlizer, error) {
+	if len(cursor) != len(orders) {
+		return nil, fmt.Errorf("cursor holds %d values, expected %d", len(cursor), len(orders))
+	}
+
+	keys := make([]string, len(orders))
+	values := make([]any, len(orders))
+
+	for i, o := range orders {
+		typ, ok := cols[o.Field]
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q", o.Field)
+		}
+
+		v, err := columnValue(o.Field, cursor[i], typ)
+		if err != nil {
+			return nil, err
+		}
+
+		keys[i] = sortKey(o.Field, typ)
+		values[i] = v
+	}
+
+	// (a > x) OR (a = x AND b > y) OR ..., with < for the descending fields.
+	or := make(squirrel.Or, 0, len(orders))
+
+	for i, o := range orders {
+		and := make(squirrel.And, 0, i+1)
+
+		for j := range i {
+			and = append(and, squirrel.Eq{keys[j]: values[j]})
+		}
+
+		if o.Desc {
+			and = append(and, squirrel.Lt{keys[i]: values[i]})
+		} else {
+			and = append(and, squirrel.Gt{keys[i]: values[i]})
+		}
+
+		or = append(or, and)
+	}
+
+	return or, nil
+}
+
+// sortKey returns the expression the column is sorted by, the nullable text columns sort their NULL as the empty string
+// like Values renders it.
+func sortKey(field string, typ ColumnType) string {
+	if typ == TypeNullableText {
+		return "COALESCE(" + field + ", '')"
+	}
+
+	return field
+}
+
+// Values returns the values of the order fields of the struct, found by db tag, as the cursor of its position.
+func Values(v any, orders []Order) []string {
+	fields := make(map[string]reflect.Value)
+
+	collectValues(reflect.ValueOf(v), fields)
+
+	cursor := make([]string, 0, len(orders))
+
+	for _, o := range orders {
+		switch f := fields[o.Field].Interface().(type) {
+		case time.Time:
+			cursor = append(cursor, f.UTC().Format(time.RFC3339Nano))
+		case fmt.Stringer:
+			cursor = append(cursor, f.String())
+		default:
+			cursor = append(cursor, fmt.Sprint(f))
+		}
+	}
+
+	return cursor
+}
+
+func collectValues(v reflect.Value, fields map[string]reflect.Value) {
+	for v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+
+	t := v.Type()
+
+	for i := range t.NumField() {
+		f := t.Field(i)
+
+		name, _, _ := strings.Cut(f.Tag.Get("db"), ",")
+
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			collectValues(v.Field(i), fields)
+
+			continue
+		}
+
+		if name != "" && name != "-" && f.IsExported() {
+			fields[name] = v.Field(i)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package filter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/platform/filter"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+var cols = filter.Columns{
+	"id":         filter.TypeUUID,
+	"email":      filter.TypeText,
+	"first_name": filter.TypeText,
+	"last_name":  filter.TypeText,
+	"nickname":   filter.TypeNullableText,
+	"country":    filter.TypeNullableText,
+	"created_at": filter.TypeTime,
+	"updated_at": filter.TypeTime,
+}
+
+func TestWhere(t *testing.T) {
+	t.Parallel()
+
+
+	for _, tc := range []struct {
+		name   string
+		filter string
+		sql    string
+		args   []any
+	}{
+		{
+			name:   "and",
+			filter: `country = "UK" AND created_at > "2024-01-01" AND nickname:"pro*"`,
+			sql:    "(country = ? AND created_at > ? AND nickname LIKE ?)",
+			args:   []any{"UK", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), "pro%"},
+		},
+		{
+			name:   "or not",
+			filter: `-nickname = "a_b*" OR id != 26ef0140-c436-4838-a271-32652c72f6f2`,
+			sql:    "(NOT (nickname LIKE ?) OR id <> ?)",
+			args:   []any{`a\_b%`, uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2").String()},
+		},
+		{
+			name:   "comparators",
+			filter: `first_name < "b" first_name <= "c" first_name >= "a" updated_at <= "2024-01-01T10:00:00Z"`,
+			sql:    "(first_name < ? AND first_name <= ? AND first_name >= ? AND updated_at <= ?)",
+			args:   []any{"b", "c", "a", time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			e, err := filter.Parse(tc.filter)
+			require.NoError(t, err)
+
+			pred, err := filter.Where(e, cols)
+			require.NoError(t, err)
+
+			sql, args, err := pred.ToSql()
+			require.NoError(t, err)
+			require.Equal(t, tc.sql, sql)
+			require.Equal(t, tc.args, args)
+		})
+	}
+}
+
+func TestWhere_error(t *testing.T) {
+	t.Parallel()
+
+
+	for filt, msg := range map[string]string{
+		`password_hash = "x"`:    `unknown field "password_hash"`,
+		`country.code = "UK"`:    `unknown field "country.code"`,
+		`created_at > yesterday`: `invalid value "yesterday" of field "created_at", expected RFC 3339 timestamp or date`,
+		`id = 42`:                `invalid value "42" of field "id", expected UUID`,
+	} {
+		e, err := filter.Parse(filt)
+		require.NoError(t, err)
+
+		_, err = filter.Where(e, cols)
+		require.EqualError(t, err, msg)
+	}
+}
+
+func TestOrderBy(t *testing.T) {
+	t.Parallel()
+
+
+	clauses, err := filter.OrderBy([]filter.Order{{Field: "created_at", Desc: true}, {Field: "id"}}, cols)
+	require.NoError(t, err)
+	require.Equal(t, []string{"created_at DESC", "id ASC"}, clauses)
+
+	// NULL sorts as the empty string.
+	clauses, err = filter.OrderBy([]filter.Order{{Field: "nickname"}, {Field: "id"}}, cols)
+	require.NoError(t, err)
+	require.Equal(t, []string{"COALESCE(nickname, '') ASC", "id ASC"}, clauses)
+
+	_, err = filter.OrderBy([]filter.Order{{Field: "password_hash"}}, cols)
+	require.EqualError(t, err, `unknown field "password_hash"`)
+
+	_, err = filter.OrderBy([]filter.Order{{Field: "deleted_at"}}, cols)
+	require.EqualError(t, err, `unknown field "deleted_at"`)
+}
+
+func TestAfter(t *testing.T) {
+	t.Parallel()
+
+	orders := []filter.Order{{Field: "country"}, {Field: "created_at", Desc: true}, {Field: "id"}}
+
+	pred, err := filter.After(orders, cols, []string{"UK", "2024-01-01T10:00:00.123456Z", "26ef0140-c436-4838-a271-32652c72f6f2"})
+	require.NoError(t, err)
+
+	sql, args, err := pred.ToSql()
+	require.NoError(t, err)
+	require.Equal(t, "((COALESCE(country, '') > ?) OR (COALESCE(country, '') = ? AND created_at < ?) OR (COALESCE(country, '') = ? AND created_at = ? AND id > ?))", sql)
+
+	createdAt := time.Date(2024, 1, 1, 10, 0, 0, 123456000, time.UTC)
+	id := "26ef0140-c436-4838-a271-32652c72f6f2"
+
+	require.Equal(t, []any{"UK", "UK", createdAt, "UK", createdAt, id}, args)
+
+	_, err = filter.After(orders, cols, []string{"UK"})
+	require.EqualError(t, err, "cursor holds 1 values, expected 3")
+
+	_, err = filter.After(orders, cols, []string{"UK", "yesterday", id})
+	require.Error(t, err)
+}
+
+func TestValues(t *testing.T) {
+	t.Parallel()
+
+	u := &model.User{
+		ID:        uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2"),
+		UserState: model.UserState{Country: "UK"},
+		CreatedAt: time.Date(2024, 1, 1, 11, 0, 0, 123456000, time.FixedZone("CET", 3600)),
+	}
+
+	require.Equal(t,
+		[]string{"UK", "2024-01-01T10:00:00.123456Z", "26ef0140-c436-4838-a271-32652c72f6f2"},
+		filter.Values(u, []filter.Order{{Field: "country"}, {Field: "created_at", Desc: true}, {Field: "id"}}),
+	)
+}
@@ -0,0 +1,71 @@
+package notifier
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen occurs when the circuit of an endpoint is open and the request is not sent.
+var ErrCircuitOpen = errors.New("circuit open")
+
+// breaker is a circuit breaker for a single endpoint.
+//
+// The circuit opens after threshold consecutive failures and rejects requests until the cooldown elapses. Then a
+// single request is let through (half-open), closing the circuit on success or opening it again on failure.
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+// allow reports whether a request can be sent.
+func (b *breaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.threshold <= 0 || b.failures < b.threshold {
+		return true
+	}
+
+	if b.probing || now.Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+
+	b.probing = true
+
+	return true
+}
+
+// success records a successful request.
+func (b *breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.probing = false
+}
+
+// failure records a failed request.
+func (b *breaker) failure(now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+
+	if b.failures >= b.threshold {
+		b.openedAt = now
+	}
+}
@@ -0,0 +1,133 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// BrokerHeaderPrefix is the prefix of the message headers carrying the CloudEvents attributes.
+const BrokerHeaderPrefix = "ce_"
+
+// BrokerHeaderContentType is the message header carrying the content type of the value.
+const BrokerHeaderContentType = "content-type"
+
+// BrokerConfig represents the broker notifier configuration.
+type BrokerConfig struct {
+	// Topic is the topic the user events are published to.
+	Topic string `split_words:"true" default:"faceit.users"`
+}
+
+// Broker is a notifier that publishes the user events as protobuf messages to a broker.
+//
+// Messages follow the CloudEvents binary content mode of the Kafka protocol binding: the value is the event data and
+// the event attributes are sent as ce_* headers. Messages are keyed by user id, so the events of a user are delivered
+// in order.
+type Broker struct {
+	publisher Publisher
+	envelope  *Envelope
+	topic     string
+}
+
+// NewBroker creates a new Broker notifier.
+func NewBroker(publisher Publisher, cfg BrokerConfig, envelope *Envelope) *Broker {
+	return &Broker{
+		publisher: publisher,
+		envelope:  envelope,
+		topic:     cfg.Topic,
+	}
+}
+
+// NotifyUserAdded publishes the model.UserAddedV1 event as api.UserAdded message.
+func (b *Broker) NotifyUserAdded(ctx context.Context, u *model.User) error {
+	e := b.envelope.wrap(ctx, model.UserAddedV1, u.ID, u.UserState)
+
+	return b.publish(ctx, e, &api.UserAdded{
+		Id:        e.Data.ID,
+		FirstName: e.Data.FirstName,
+		LastName:  e.Data.LastName,
+		Nickname:  e.Data.Nickname,
+		Email:     e.Data.Email,
+		Country:   e.Data.Country,
+	})
+}
+
+// NotifyUserUpdated publishes the model.UserUpdatedV1 event as api.UserUpdated message.
+func (b *Broker) NotifyUserUpdated(ctx context.Context, id model.UserID, info model.UserState) error {
+	e := b.envelope.wrap(ctx, model.UserUpdatedV1, id, info)
+
+	return b.publish(ctx, e, &api.UserUpdated{
+		Id:        e.Data.ID,
+		FirstName: optional(e.Data.FirstName),
+		LastName:  optional(e.Data.LastName),
+		Nickname:  optional(e.Data.Nickname),
+		Email:     optional(e.Data.Email),
+		Country:   optional(e.Data.Country),
+	})
+}
+
+// NotifyUserDeleted publishes the model.UserDeletedV1 event as api.UserDeleted message.
+func (b *Broker) NotifyUserDeleted(ctx context.Context, id model.UserID) error {
+	e := b.envelope.wrap(ctx, model.UserDeletedV1, id, model.UserState{})
+
+	return b.publish(ctx, e, &api.UserDeleted{
+		Id: e.Data.ID,
+	})
+}
+
+// NotifyUserRestored publishes the model.UserRestoredV1 event as api.UserRestored message.
+func (b *Broker) NotifyUserRestored(ctx context.Context, id model.UserID) error {
+	e := b.envelope.wrap(ctx, model.UserRestoredV1, id, model.UserState{})
+
+	return b.publish(ctx, e, &api.UserRestored{
+		Id: e.Data.ID,
+	})
+}
+
+// NotifyUserStatusChanged publishes the model.UserStatusChangedV1 event as api.UserStatusChanged message.
+func (b *Broker) NotifyUserStatusChanged(ctx context.Context, id model.UserID, status model.UserStatus) error {
+	e := b.envelope.wrap(ctx, model.UserStatusChangedV1, id, model.UserState{})
+	e.Data.Status = string(status)
+
+	return b.publish(ctx, e, &api.UserStatusChanged{
+		Id:     e.Data.ID,
+		Status: e.Data.Status,
+	})
+}
+
+// NotifyPasswordReset publishes the model.UserPasswordResetV1 event as api.UserPasswordReset message.
+func (b *Broker) NotifyPasswordReset(ctx context.Context, id model.UserID) error {
+	e := b.envelope.wrap(ctx, model.UserPasswordResetV1, id, model.UserState{})
+
+	return b.publish(ctx, e, &api.UserPasswordReset{
+		Id: e.Data.ID,
+	})
+}
+
+func (b *Broker) publish(ctx context.Context, e model.UserEvent, data proto.Message) error {
+	value, err := proto.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	headers := attributes(BrokerHeaderPrefix, e)
+	headers[BrokerHeaderContentType] = ContentTypeProtobuf
+
+	return b.publisher.Publish(ctx, Message{
+		Topic:   b.topic,
+		Key:     []byte(e.Subject.String()),
+		Headers: headers,
+		Value:   value,
+	})
+}
+
+// optional returns a pointer to the value, nil when the value is empty.
+func optional(v string) *string {
+	if v == "" {
+		return nil
+	}
+
+	return &v
+}
@@ -0,0 +1,185 @@
+package notifier_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/platform/notifier"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/google/uuid"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestBroker(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+	eventID := uuid.MustParse("9b8c4a34-42b0-4cb4-9d0f-5bd8e2a38bc1")
+
+	ctx := notifier.ContextWithEventID(context.Background(), eventID)
+	ctx = notifier.ContextWithEventTime(ctx, time.Date(2024, 12, 16, 9, 59, 58, 0, time.UTC))
+
+	envelope := notifier.NewEnvelope("/faceit/users", clock.Fix(time.Unix(1734343200, 0)))
+
+	t.Run("user added", func(t *testing.T) {
+		t.Parallel()
+
+		b := notifier.NewInProcessBroker()
+
+		err := notifier.NewBroker(b, notifier.BrokerConfig{Topic: "faceit.users"}, envelope).NotifyUserAdded(ctx, &model.User{
+			ID: id,
+			UserState: model.UserState{
+				PasswordHash: "f6b7e19e0d867de6c0391879050e8297165728d89d7c4e9e8839972b356c4d9d",
+				Email:        "alice@bob.com",
+				FirstName:    "Alice",
+				LastName:     "Bob",
+				Nickname:     "AB123",
+				Country:      "UK",
+			},
+		})
+		require.NoError(t, err)
+
+		msgs := b.Messages()
+		require.Len(t, msgs, 1)
+
+		assert.Equal(t, "faceit.users", msgs[0].Topic)
+		assert.Equal(t, []byte(id.String()), msgs[0].Key)
+		assert.Equal(t, map[string]string{
+			"ce_specversion": "1.0",
+			"ce_id":          eventID.String(),
+			"ce_source":      "/faceit/users",
+			"ce_type":        "com.faceit.user.added.v1",
+			"ce_subject":     id.String(),
+			"ce_time":        "2024-12-16T09:59:58Z",
+			"content-type":   "application/protobuf",
+		}, msgs[0].Headers)
+
+		var e api.UserAdded
+
+		require.NoError(t, proto.Unmarshal(msgs[0].Value, &e))
+
+		assert.True(t, proto.Equal(&api.UserAdded{
+			Id:        id.String(),
+			FirstName: "Alice",
+			LastName:  "Bob",
+			Nickname:  "AB123",
+			Email:     "alice@bob.com",
+			Country:   "UK",
+		}, &e))
+	})
+
+	t.Run("user updated", func(t *testing.T) {
+		t.Parallel()
+
+		b := notifier.NewInProcessBroker()
+
+		err := notifier.NewBroker(b, notifier.BrokerConfig{Topic: "faceit.users"}, envelope).NotifyUserUpdated(ctx, id, model.UserState{
+			PasswordHash: "f6b7e19e0d867de6c0391879050e8297165728d89d7c4e9e8839972b356c4d9d",
+			Country:      "DE",
+		})
+		require.NoError(t, err)
+
+		msgs := b.Messages()
+		require.Len(t, msgs, 1)
+
+		assert.Equal(t, "com.faceit.user.updated.v1", msgs[0].Headers["ce_type"])
+
+		var e api.UserUpdated
+
+		require.NoError(t, proto.Unmarshal(msgs[0].Value, &e))
+
+		assert.Equal(t, id.String(), e.GetId())
+		assert.Equal(t, "DE", e.GetCountry())
+		assert.Nil(t, e.FirstName)
+		assert.Nil(t, e.Email)
+	})
+
+	t.Run("user deleted", func(t *testing.T) {
+		t.Parallel()
+
+		b := notifier.NewInProcessBroker()
+
+		err := notifier.NewBroker(b, notifier.BrokerConfig{Topic: "faceit.users"}, envelope).NotifyUserDeleted(ctx, id)
+		require.NoError(t, err)
+
+		msgs := b.Messages()
+		require.Len(t, msgs, 1)
+
+		assert.Equal(t, "com.faceit.user.deleted.v1", msgs[0].Headers["ce_type"])
+
+		var e api.UserDeleted
+
+		require.NoError(t, proto.Unmarshal(msgs[0].Value, &e))
+
+		assert.Equal(t, id.String(), e.GetId())
+
+		b.Reset()
+		assert.Empty(t, b.Messages())
+	})
+
+	t.Run("user restored", func(t *testing.T) {
+		t.Parallel()
+
+		b := notifier.NewInProcessBroker()
+
+		err := notifier.NewBroker(b, notifier.BrokerConfig{Topic: "faceit.users"}, envelope).NotifyUserRestored(ctx, id)
+		require.NoError(t, err)
+
+		msgs := b.Messages()
+		require.Len(t, msgs, 1)
+
+		assert.Equal(t, "com.faceit.user.restored.v1", msgs[0].Headers["ce_type"])
+
+		var e api.UserRestored
+
+		require.NoError(t, proto.Unmarshal(msgs[0].Value, &e))
+
+		assert.Equal(t, id.String(), e.GetId())
+	})
+
+	t.Run("user status changed", func(t *testing.T) {
+		t.Parallel()
+
+		b := notifier.NewInProcessBroker()
+
+		err := notifier.NewBroker(b, notifier.BrokerConfig{Topic: "faceit.users"}, envelope).NotifyUserStatusChanged(ctx, id, model.StatusBanned)
+		require.NoError(t, err)
+
+		msgs := b.Messages()
+		require.Len(t, msgs, 1)
+
+		assert.Equal(t, "com.faceit.user.status_changed.v1", msgs[0].Headers["ce_type"])
+
+		var e api.UserStatusChanged
+
+		require.NoError(t, proto.Unmarshal(msgs[0].Value, &e))
+
+		assert.Equal(t, id.String(), e.GetId())
+		assert.Equal(t, "banned", e.GetStatus())
+	})
+
+	t.Run("password reset", func(t *testing.T) {
+		t.Parallel()
+
+		b := notifier.NewInProcessBroker()
+
+		err := notifier.NewBroker(b, notifier.BrokerConfig{Topic: "faceit.users"}, envelope).NotifyPasswordReset(ctx, id)
+		require.NoError(t, err)
+
+		msgs := b.Messages()
+		require.Len(t, msgs, 1)
+
+		assert.Equal(t, "com.faceit.user.password_reset.v1", msgs[0].Headers["ce_type"])
+
+		var e api.UserPasswordReset
+
+		require.NoError(t, proto.Unmarshal(msgs[0].Value, &e))
+
+		assert.Equal(t, id.String(), e.GetId())
+	})
+}
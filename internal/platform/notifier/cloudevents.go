@@ -0,0 +1,84 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/nhatthm/go-clock"
+)
+
+// Content types of the CloudEvents bindings.
+const (
+	ContentTypeCloudEventsJSON = "application/cloudevents+json"
+	ContentTypeJSON            = "application/json"
+	ContentTypeProtobuf        = "application/protobuf"
+)
+
+// Envelope wraps the user events in the CloudEvents envelope.
+type Envelope struct {
+	source string
+	clock  clock.Clock
+}
+
+// NewEnvelope creates a new Envelope, the events are emitted from the given source.
+func NewEnvelope(source string, clk clock.Clock) *Envelope {
+	return &Envelope{
+		source: source,
+		clock:  clk,
+	}
+}
+
+// wrap wraps the user state in the event of the given type.
+//
+// The event id and time are taken from the context when it carries them.
+func (e *Envelope) wrap(ctx context.Context, t model.UserEventType, id model.UserID, info model.UserState) model.UserEvent {
+	return model.UserEvent{
+		ID:      eventID(ctx),
+		Source:  e.source,
+		Type:    t,
+		Subject: id,
+		Time:    eventTime(ctx, e.clock),
+		Data:    model.NewUserEventData(id, info),
+	}
+}
+
+// structuredEvent is the CloudEvents JSON event format.
+type structuredEvent struct {
+	SpecVersion     string              `json:"specversion"`
+	ID              string              `json:"id"`
+	Source          string              `json:"source"`
+	Type            string              `json:"type"`
+	Subject         string              `json:"subject"`
+	Time            time.Time           `json:"time"`
+	DataContentType string              `json:"datacontenttype"`
+	Data            model.UserEventData `json:"data"`
+}
+
+// MarshalStructured encodes the event in the CloudEvents JSON event format.
+func MarshalStructured(e model.UserEvent) ([]byte, error) {
+	return json.Marshal(structuredEvent{
+		SpecVersion:     model.CloudEventsSpecVersion,
+		ID:              e.ID.String(),
+		Source:          e.Source,
+		Type:            string(e.Type),
+		Subject:         e.Subject.String(),
+		Time:            e.Time,
+		DataContentType: ContentTypeJSON,
+		Data:            e.Data,
+	})
+}
+
+// attributes returns the CloudEvents context attributes of the event named with the binding prefix, used by the
+// binary content mode, where the data is carried as is.
+func attributes(prefix string, e model.UserEvent) map[string]string {
+	return map[string]string{
+		prefix + "specversion": model.CloudEventsSpecVersion,
+		prefix + "id":          e.ID.String(),
+		prefix + "source":      e.Source,
+		prefix + "type":        string(e.Type),
+		prefix + "subject":     e.Subject.String(),
+		prefix + "time":        e.Time.Format(time.RFC3339Nano),
+	}
+}
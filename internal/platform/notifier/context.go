@@ -0,0 +1,44 @@
+package notifier
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhatthm/go-clock"
+)
+
+type (
+	eventIDCtxKey   struct{}
+	eventTimeCtxKey struct{}
+)
+
+// ContextWithEventID returns a context carrying the id of the event being notified.
+//
+// Notifiers use it to identify the event, so a receiver gets the same id when the event is delivered more than once.
+func ContextWithEventID(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, eventIDCtxKey{}, id)
+}
+
+// ContextWithEventTime returns a context carrying the time the event being notified happened.
+func ContextWithEventTime(ctx context.Context, t time.Time) context.Context {
+	return context.WithValue(ctx, eventTimeCtxKey{}, t)
+}
+
+// eventID returns the id of the event carried by the context, a new one when the context carries none.
+func eventID(ctx context.Context) uuid.UUID {
+	if id, ok := ctx.Value(eventIDCtxKey{}).(uuid.UUID); ok {
+		return id
+	}
+
+	return uuid.New()
+}
+
+// eventTime returns the time of the event carried by the context, the current time when the context carries none.
+func eventTime(ctx context.Context, clk clock.Clock) time.Time {
+	if t, ok := ctx.Value(eventTimeCtxKey{}).(time.Time); ok && !t.IsZero() {
+		return t.UTC()
+	}
+
+	return clk.Now().UTC()
+}
@@ -0,0 +1,45 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+)
+
+// InProcessBroker is an in memory Publisher standing in for a message broker.
+//
+// It keeps every message published, so they can be inspected, e.g. by tests.
+type InProcessBroker struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+// NewInProcessBroker creates a new InProcessBroker.
+func NewInProcessBroker() *InProcessBroker {
+	return &InProcessBroker{}
+}
+
+// Publish stores the message.
+func (b *InProcessBroker) Publish(_ context.Context, msg Message) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.messages = append(b.messages, msg)
+
+	return nil
+}
+
+// Messages returns the messages published in order.
+func (b *InProcessBroker) Messages() []Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]Message(nil), b.messages...)
+}
+
+// Reset discards the messages published.
+func (b *InProcessBroker) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.messages = nil
+}
@@ -0,0 +1,119 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"net/url"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+// MailerConfig represents the mailer configuration.
+type MailerConfig struct {
+	// SMTPAddr is the host:port of the SMTP server the emails are sent through, emails are not sent when empty.
+	SMTPAddr string `split_words:"true"`
+	// SMTPUsername is the username to authenticate with, no authentication when empty.
+	SMTPUsername string `split_words:"true"`
+	// SMTPPassword is the password to authenticate with.
+	SMTPPassword string `split_words:"true"`
+	// From is the sender address of the emails.
+	From string `default:"no-reply@faceit.local"`
+	// VerificationURL is the page the verification links point to, the token is sent as token query parameter.
+	VerificationURL string `split_words:"true" default:"http://localhost:8080/verify-email"`
+	// PasswordResetURL is the page the password reset links point to, the token is sent as token query parameter.
+	PasswordResetURL string `split_words:"true" default:"http://localhost:8080/reset-password"`
+}
+
+// Mailer is a notifier that emails the users through an SMTP server.
+type Mailer struct {
+	cfg MailerConfig
+}
+
+// NewMailer creates a new Mailer notifier.
+func NewMailer(cfg MailerConfig) *Mailer {
+	return &Mailer{
+		cfg: cfg,
+	}
+}
+
+// NotifyVerificationRequested emails the verification link to the address to verify.
+func (m *Mailer) NotifyVerificationRequested(_ context.Context, v model.VerificationRequested) error {
+	link, err := tokenLink(m.cfg.VerificationURL, v.Token)
+	if err != nil {
+		return fmt.Errorf("parse verification url: %w", err)
+	}
+
+	return m.send(v.Email, m.message(v.Email, "Verify your email",
+		fmt.Sprintf("Verify your email by following the link below, it expires on %s.",
+			v.ExpiresAt.UTC().Format(time.RFC1123)),
+		link,
+	))
+}
+
+// NotifyPasswordResetRequested emails the password reset link to the address of the user.
+func (m *Mailer) NotifyPasswordResetRequested(_ context.Context, r model.PasswordResetRequested) error {
+	link, err := tokenLink(m.cfg.PasswordResetURL, r.Token)
+	if err != nil {
+		return fmt.Errorf("parse password reset url: %w", err)
+	}
+
+	return m.send(r.Email, m.message(r.Email, "Reset your password",
+		fmt.Sprintf("Reset your password by following the link below, it expires on %s. "+
+			"If you did not ask to reset your password, you can ignore this email.",
+			r.ExpiresAt.UTC().Format(time.RFC1123)),
+		link,
+	))
+}
+
+// message builds a plain text message with the text followed by the link.
+func (m *Mailer) message(to, subject, text, link string) []byte {
+	var body bytes.Buffer
+
+	_, _ = fmt.Fprintf(&body, "From: %s\r\n", m.cfg.From)
+	_, _ = fmt.Fprintf(&body, "To: %s\r\n", to)
+	_, _ = fmt.Fprintf(&body, "Subject: %s\r\n", subject)
+	_, _ = fmt.Fprintf(&body, "Content-Type: text/plain; charset=utf-8\r\n")
+	_, _ = fmt.Fprintf(&body, "\r\n")
+	_, _ = fmt.Fprintf(&body, "%s\r\n\r\n", text)
+	_, _ = fmt.Fprintf(&body, "%s\r\n", link)
+
+	return body.Bytes()
+}
+
+// tokenLink adds the token as token query parameter of the url.
+func tokenLink(rawURL, token string) (string, error) {
+	link, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	q := link.Query()
+	q.Set("token", token)
+	link.RawQuery = q.Encode()
+
+	return link.String(), nil
+}
+
+// send sends the message to the recipient.
+func (m *Mailer) send(to string, msg []byte) error {
+	var a smtp.Auth
+
+	if m.cfg.SMTPUsername != "" {
+		host, _, err := net.SplitHostPort(m.cfg.SMTPAddr)
+		if err != nil {
+			return fmt.Errorf("parse smtp address: %w", err)
+		}
+
+		a = smtp.PlainAuth("", m.cfg.SMTPUsername, m.cfg.SMTPPassword, host)
+	}
+
+	if err := smtp.SendMail(m.cfg.SMTPAddr, a, m.cfg.From, []string{to}, msg); err != nil {
+		return fmt.Errorf("send mail: %w", err)
+	}
+
+	return nil
+}
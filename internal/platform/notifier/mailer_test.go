@@ -0,0 +1,81 @@
+package notifier_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/platform/notifier"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMailer_NotifyVerificationRequested(t *testing.T) {
+	t.Parallel()
+
+	srv, err := notifier.NewLocalSMTPServer()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, srv.Close())
+	})
+
+	m := notifier.NewMailer(notifier.MailerConfig{
+		SMTPAddr:        srv.Addr(),
+		From:            "no-reply@faceit.local",
+		VerificationURL: "https://faceit.local/verify-email?lang=en",
+	})
+
+	err = m.NotifyVerificationRequested(context.Background(), model.VerificationRequested{
+		UserID:    uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2"),
+		Email:     "alice@bob.com",
+		Token:     "dG9rZW4",
+		ExpiresAt: time.Date(2024, 12, 22, 9, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	mails := srv.Mails()
+	require.Len(t, mails, 1)
+	require.Equal(t, "no-reply@faceit.local", mails[0].From)
+	require.Equal(t, []string{"alice@bob.com"}, mails[0].To)
+	require.Equal(t, "alice@bob.com", mails[0].Message.Header.Get("To"))
+	require.Equal(t, "Verify your email", mails[0].Message.Header.Get("Subject"))
+	require.Contains(t, mails[0].Body, "https://faceit.local/verify-email?lang=en&token=dG9rZW4")
+	require.Contains(t, mails[0].Body, "Sun, 22 Dec 2024 09:00:00 UTC")
+
+	srv.Reset()
+	require.Empty(t, srv.Mails())
+}
+
+func TestMailer_NotifyPasswordResetRequested(t *testing.T) {
+	t.Parallel()
+
+	srv, err := notifier.NewLocalSMTPServer()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, srv.Close())
+	})
+
+	m := notifier.NewMailer(notifier.MailerConfig{
+		SMTPAddr:         srv.Addr(),
+		From:             "no-reply@faceit.local",
+		PasswordResetURL: "https://faceit.local/reset-password",
+	})
+
+	err = m.NotifyPasswordResetRequested(context.Background(), model.PasswordResetRequested{
+		UserID:    uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2"),
+		Email:     "alice@bob.com",
+		Token:     "dG9rZW4",
+		ExpiresAt: time.Date(2024, 12, 22, 10, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	mails := srv.Mails()
+	require.Len(t, mails, 1)
+	require.Equal(t, []string{"alice@bob.com"}, mails[0].To)
+	require.Equal(t, "Reset your password", mails[0].Message.Header.Get("Subject"))
+	require.Contains(t, mails[0].Body, "https://faceit.local/reset-password?token=dG9rZW4")
+	require.Contains(t, mails[0].Body, "Sun, 22 Dec 2024 10:00:00 UTC")
+}
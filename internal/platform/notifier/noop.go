@@ -28,3 +28,28 @@ func (n *NoopNotifier) NotifyUserUpdated(_ context.Context, _ model.UserID, _ mo
 func (n *NoopNotifier) NotifyUserDeleted(_ context.Context, _ model.UserID) error {
 	return nil
 }
+
+// NotifyUserRestored does nothing.
+func (n *NoopNotifier) NotifyUserRestored(_ context.Context, _ model.UserID) error {
+	return nil
+}
+
+// NotifyUserStatusChanged does nothing.
+func (n *NoopNotifier) NotifyUserStatusChanged(_ context.Context, _ model.UserID, _ model.UserStatus) error {
+	return nil
+}
+
+// NotifyVerificationRequested does nothing.
+func (n *NoopNotifier) NotifyVerificationRequested(_ context.Context, _ model.VerificationRequested) error {
+	return nil
+}
+
+// NotifyPasswordResetRequested does nothing.
+func (n *NoopNotifier) NotifyPasswordResetRequested(_ context.Context, _ model.PasswordResetRequested) error {
+	return nil
+}
+
+// NotifyPasswordReset does nothing.
+func (n *NoopNotifier) NotifyPasswordReset(_ context.Context, _ model.UserID) error {
+	return nil
+}
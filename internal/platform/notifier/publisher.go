@@ -0,0 +1,23 @@
+package notifier
+
+import "context"
+
+// Message is a message published to a broker.
+//
+// It maps to a Kafka record (topic, key, headers, value) and to a NATS message (subject, headers, data), with the key
+// sent as header.
+type Message struct {
+	// Topic is the topic, or subject, the message is published to.
+	Topic string
+	// Key is the partition key of the message, messages with the same key are delivered in order.
+	Key []byte
+	// Headers are the message headers.
+	Headers map[string]string
+	// Value is the message payload.
+	Value []byte
+}
+
+// Publisher defines functionality to publish a message to a broker.
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+}
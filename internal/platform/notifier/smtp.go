@@ -0,0 +1,194 @@
+package notifier
+
+import (
+	"bufio"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+)
+
+// Mail is an email received by the LocalSMTPServer.
+type Mail struct {
+	// From is the envelope sender.
+	From string
+	// To are the envelope recipients.
+	To []string
+	// Message is the message received.
+	Message *mail.Message
+	// Body is the message body.
+	Body string
+}
+
+// LocalSMTPServer is an SMTP server listening on the loopback interface, standing in for a mail server.
+//
+// It speaks just enough SMTP to receive the emails sent by the Mailer and keeps every email received, so they can be
+// inspected, e.g. by tests. Emails are never relayed.
+type LocalSMTPServer struct {
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mu    sync.Mutex
+	mails []Mail
+}
+
+// NewLocalSMTPServer creates a new LocalSMTPServer, listening on a random port until closed.
+func NewLocalSMTPServer() (*LocalSMTPServer, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &LocalSMTPServer{
+		listener: l,
+	}
+
+	s.wg.Add(1)
+
+	go s.serve()
+
+	return s, nil
+}
+
+// Addr returns the host:port the server listens on.
+func (s *LocalSMTPServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Mails returns the emails received in order.
+func (s *LocalSMTPServer) Mails() []Mail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Mail(nil), s.mails...)
+}
+
+// Reset discards the emails received.
+func (s *LocalSMTPServer) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.mails = nil
+}
+
+// Close stops the server.
+func (s *LocalSMTPServer) Close() error {
+	err := s.listener.Close()
+
+	s.wg.Wait()
+
+	return err
+}
+
+func (s *LocalSMTPServer) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.wg.Add(1)
+
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close() //nolint:errcheck
+
+			s.handle(textproto.NewConn(conn))
+		}()
+	}
+}
+
+// handle runs an SMTP session.
+func (s *LocalSMTPServer) handle(c *textproto.Conn) {
+	var m Mail
+
+	reply := func(code int, msg string) bool {
+		return c.PrintfLine("%d %s", code, msg) == nil
+	}
+
+	if !reply(220, "localhost ESMTP") {
+		return
+	}
+
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+
+		verb, arg, _ := strings.Cut(line, " ")
+
+		var ok bool
+
+		switch strings.ToUpper(verb) {
+		case "HELO", "EHLO":
+			ok = reply(250, "localhost")
+		case "MAIL":
+			m = Mail{From: addrArg(arg)}
+			ok = reply(250, "OK")
+		case "RCPT":
+			m.To = append(m.To, addrArg(arg))
+			ok = reply(250, "OK")
+		case "DATA":
+			if !reply(354, "end data with <CR><LF>.<CR><LF>") {
+				return
+			}
+
+			ok = s.receive(c, m) && reply(250, "OK")
+		case "RSET":
+			m = Mail{}
+			ok = reply(250, "OK")
+		case "NOOP":
+			ok = reply(250, "OK")
+		case "QUIT":
+			reply(221, "bye")
+
+			return
+		default:
+			ok = reply(502, "command not implemented")
+		}
+
+		if !ok {
+			return
+		}
+	}
+}
+
+// receive reads the message of the email and keeps it.
+func (s *LocalSMTPServer) receive(c *textproto.Conn, m Mail) bool {
+	msg, err := mail.ReadMessage(bufio.NewReader(c.DotReader()))
+	if err != nil {
+		return false
+	}
+
+	var body strings.Builder
+
+	if _, err := bufio.NewReader(msg.Body).WriteTo(&body); err != nil {
+		return false
+	}
+
+	m.Message = msg
+	m.Body = body.String()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.mails = append(s.mails, m)
+
+	return true
+}
+
+// addrArg returns the address of the MAIL FROM:<addr> and RCPT TO:<addr> arguments.
+func addrArg(arg string) string {
+	_, addr, _ := strings.Cut(arg, ":")
+	addr = strings.TrimSpace(addr)
+
+	if i := strings.IndexByte(addr, ' '); i >= 0 {
+		addr = addr[:i]
+	}
+
+	return strings.Trim(addr, "<>")
+}
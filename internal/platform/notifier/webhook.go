@@ -0,0 +1,333 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/google/uuid"
+	"github.com/nhatthm/go-clock"
+)
+
+// Webhook headers sent along with the payload.
+const (
+	HeaderTimestamp = "X-Faceit-Timestamp"
+	HeaderSignature = "X-Faceit-Signature"
+)
+
+// Webhook CloudEvents content modes.
+const (
+	// WebhookModeStructured posts the event in the CloudEvents JSON event format.
+	WebhookModeStructured = "structured"
+	// WebhookModeBinary posts the event data, the event attributes are sent as ce-* headers.
+	WebhookModeBinary = "binary"
+)
+
+// ErrWebhookDelivery occurs when the payload could not be delivered to a subscriber.
+var ErrWebhookDelivery = errors.New("webhook delivery")
+
+// WebhookConfig represents the webhook notifier configuration.
+type WebhookConfig struct {
+	// Subscribers is a JSON list of subscribers, e.g.
+	// [{"url": "https://...", "secret": "...", "events": ["user.added"]}].
+	Subscribers WebhookSubscribers `split_words:"true"`
+	// Timeout is the maximum time to wait for a subscriber response.
+	Timeout time.Duration `split_words:"true" default:"5s"`
+	// RedeliveryWindow is the time the subscribers having accepted an event are remembered, so the outbox retries of
+	// the event are only delivered to the subscribers that failed it.
+	RedeliveryWindow time.Duration `split_words:"true" default:"1h"`
+	// BreakerThreshold is the number of consecutive failures opening the circuit of a subscriber.
+	BreakerThreshold int `split_words:"true" default:"5"`
+	// BreakerCooldown is the time the circuit of a subscriber stays open.
+	BreakerCooldown time.Duration `split_words:"true" default:"30s"`
+}
+
+// WebhookSubscriber represents a subscriber of the user events.
+type WebhookSubscriber struct {
+	// URL is the endpoint the events are posted to.
+	URL string `json:"url"`
+	// Secret is the key used to sign the payload.
+	Secret string `json:"secret"`
+	// Events is the list of event types the subscriber is interested in, all when empty.
+	Events []string `json:"events,omitempty"`
+	// Mode is the CloudEvents content mode, structured (default) or binary.
+	Mode string `json:"mode,omitempty"`
+}
+
+// WebhookSubscribers is a list of subscribers, decoded from JSON.
+type WebhookSubscribers []WebhookSubscriber
+
+// Decode implements envconfig.Decoder.
+func (s *WebhookSubscribers) Decode(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	return json.Unmarshal([]byte(value), (*[]WebhookSubscriber)(s))
+}
+
+// accepts reports whether the subscriber is interested in the event type.
+func (s WebhookSubscriber) accepts(eventType string) bool {
+	if len(s.Events) == 0 {
+		return true
+	}
+
+	for _, e := range s.Events {
+		if e == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+type webhookEndpoint struct {
+	WebhookSubscriber
+
+	breaker *breaker
+}
+
+// deliveredEvent records the endpoints having accepted an event that failed to be delivered to others.
+type deliveredEvent struct {
+	at        time.Time
+	endpoints map[*webhookEndpoint]struct{}
+}
+
+// Webhook is a notifier that posts the user events, wrapped in CloudEvents, to a list of subscribers.
+//
+// Every request is signed with the subscriber secret: the HeaderSignature holds the hex encoded HMAC-SHA256 of
+// "<timestamp>.<body>", where timestamp is the value of HeaderTimestamp. The event id is stable across redeliveries,
+// so subscribers can discard duplicates.
+//
+// Each subscriber is attempted once per notification, a failed delivery is retried by the outbox relay with its
+// backoff. The retries skip the subscribers having already accepted the event.
+type Webhook struct {
+	endpoints []*webhookEndpoint
+	client    *http.Client
+	envelope  *Envelope
+	clock     clock.Clock
+	cfg       WebhookConfig
+
+	mu        sync.Mutex
+	delivered map[uuid.UUID]deliveredEvent
+}
+
+// NewWebhook creates a new Webhook notifier.
+func NewWebhook(cfg WebhookConfig, envelope *Envelope, clk clock.Clock) *Webhook {
+	endpoints := make([]*webhookEndpoint, 0, len(cfg.Subscribers))
+
+	for _, s := range cfg.Subscribers {
+		endpoints = append(endpoints, &webhookEndpoint{
+			WebhookSubscriber: s,
+			breaker:           newBreaker(cfg.BreakerThreshold, cfg.BreakerCooldown),
+		})
+	}
+
+	return &Webhook{
+		endpoints: endpoints,
+		client:    &http.Client{Timeout: cfg.Timeout},
+		envelope:  envelope,
+		clock:     clk,
+		cfg:       cfg,
+		delivered: make(map[uuid.UUID]deliveredEvent),
+	}
+}
+
+// NotifyUserAdded posts the model.UserAddedV1 event.
+func (w *Webhook) NotifyUserAdded(ctx context.Context, u *model.User) error {
+	return w.notify(ctx, w.envelope.wrap(ctx, model.UserAddedV1, u.ID, u.UserState))
+}
+
+// NotifyUserUpdated posts the model.UserUpdatedV1 event.
+func (w *Webhook) NotifyUserUpdated(ctx context.Context, id model.UserID, info model.UserState) error {
+	return w.notify(ctx, w.envelope.wrap(ctx, model.UserUpdatedV1, id, info))
+}
+
+// NotifyUserDeleted posts the model.UserDeletedV1 event.
+func (w *Webhook) NotifyUserDeleted(ctx context.Context, id model.UserID) error {
+	return w.notify(ctx, w.envelope.wrap(ctx, model.UserDeletedV1, id, model.UserState{}))
+}
+
+// NotifyUserRestored posts the model.UserRestoredV1 event.
+func (w *Webhook) NotifyUserRestored(ctx context.Context, id model.UserID) error {
+	return w.notify(ctx, w.envelope.wrap(ctx, model.UserRestoredV1, id, model.UserState{}))
+}
+
+// NotifyUserStatusChanged posts the model.UserStatusChangedV1 event.
+func (w *Webhook) NotifyUserStatusChanged(ctx context.Context, id model.UserID, status model.UserStatus) error {
+	e := w.envelope.wrap(ctx, model.UserStatusChangedV1, id, model.UserState{})
+	e.Data.Status = string(status)
+
+	return w.notify(ctx, e)
+}
+
+// NotifyPasswordReset posts the model.UserPasswordResetV1 event.
+func (w *Webhook) NotifyPasswordReset(ctx context.Context, id model.UserID) error {
+	return w.notify(ctx, w.envelope.wrap(ctx, model.UserPasswordResetV1, id, model.UserState{}))
+}
+
+// webhookRequest is the encoded event posted to a subscriber.
+type webhookRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// notify posts the event to every subscriber interested in it, in the content mode of the subscriber, skipping the
+// subscribers having accepted it already.
+//
+// It fails when the event could not be delivered to any of them.
+func (w *Webhook) notify(ctx context.Context, e model.UserEvent) error {
+	structuredBody, err := MarshalStructured(e)
+	if err != nil {
+		return err
+	}
+
+	binaryBody, err := json.Marshal(e.Data)
+	if err != nil {
+		return err
+	}
+
+	requests := map[string]webhookRequest{
+		WebhookModeStructured: {
+			header: http.Header{"Content-Type": {ContentTypeCloudEventsJSON}},
+			body:   structuredBody,
+		},
+		WebhookModeBinary: {
+			header: http.Header{"Content-Type": {ContentTypeJSON}},
+			body:   binaryBody,
+		},
+	}
+
+	for k, v := range attributes("ce-", e) {
+		requests[WebhookModeBinary].header.Set(k, v)
+	}
+
+	var errs []error
+
+	accepted := w.accepted(e.ID)
+
+	for _, ep := range w.endpoints {
+		if _, ok := accepted[ep]; ok || !ep.accepts(string(e.Type)) {
+			continue
+		}
+
+		req, ok := requests[ep.Mode]
+		if !ok {
+			req = requests[WebhookModeStructured]
+		}
+
+		if err := w.deliver(ctx, ep, req); err != nil {
+			errs = append(errs, fmt.Errorf("%w to %s: %w", ErrWebhookDelivery, ep.URL, err))
+
+			continue
+		}
+
+		accepted[ep] = struct{}{}
+	}
+
+	w.remember(e.ID, accepted, len(errs) > 0)
+
+	return errors.Join(errs...)
+}
+
+// accepted returns the endpoints having accepted the event, forgetting the events out of the redelivery window.
+func (w *Webhook) accepted(id uuid.UUID) map[*webhookEndpoint]struct{} {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := w.clock.Now()
+
+	for k, d := range w.delivered {
+		if now.Sub(d.at) >= w.cfg.RedeliveryWindow {
+			delete(w.delivered, k)
+		}
+	}
+
+	accepted := make(map[*webhookEndpoint]struct{}, len(w.delivered[id].endpoints))
+
+	for ep := range w.delivered[id].endpoints {
+		accepted[ep] = struct{}{}
+	}
+
+	return accepted
+}
+
+// remember records the endpoints having accepted the event while it is going to be retried, forgets them otherwise.
+func (w *Webhook) remember(id uuid.UUID, accepted map[*webhookEndpoint]struct{}, retried bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !retried || len(accepted) == 0 {
+		delete(w.delivered, id)
+
+		return
+	}
+
+	w.delivered[id] = deliveredEvent{at: w.clock.Now(), endpoints: accepted}
+}
+
+// deliver posts the body to the endpoint once, unless its circuit is open.
+//
+// It runs within the outbox relay transaction, the failed deliveries are retried by the relay with its backoff.
+func (w *Webhook) deliver(ctx context.Context, e *webhookEndpoint, r webhookRequest) error {
+	if !e.breaker.allow(w.clock.Now()) {
+		return ErrCircuitOpen
+	}
+
+	if err := w.post(ctx, e, r); err != nil {
+		e.breaker.failure(w.clock.Now())
+
+		return err
+	}
+
+	e.breaker.success()
+
+	return nil
+}
+
+// post sends a signed request to the endpoint.
+func (w *Webhook) post(ctx context.Context, e *webhookEndpoint, r webhookRequest) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.URL, bytes.NewReader(r.body))
+	if err != nil {
+		return err
+	}
+
+	ts := strconv.FormatInt(w.clock.Now().Unix(), 10)
+
+	req.Header = r.header.Clone()
+	req.Header.Set(HeaderTimestamp, ts)
+	req.Header.Set(HeaderSignature, "sha256="+Sign(e.Secret, ts, r.body))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	_ = resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+
+	return fmt.Errorf("unexpected status %d", resp.StatusCode) //nolint:err113
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of the body sent at the given timestamp.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+
+	_, _ = mac.Write([]byte(timestamp + ".")) //nolint:errcheck // hash.Hash never returns an error.
+	_, _ = mac.Write(body)                    //nolint:errcheck // hash.Hash never returns an error.
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,379 @@
+package notifier_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/platform/notifier"
+	"github.com/google/uuid"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type request struct {
+	header http.Header
+	body   []byte
+}
+
+// subscriber is a webhook subscriber responding with the given statuses in order, the last one is repeated.
+type subscriber struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	statuses []int
+	requests []request
+}
+
+func newSubscriber(t *testing.T, statuses ...int) *subscriber {
+	t.Helper()
+
+	s := &subscriber{statuses: statuses}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.requests = append(s.requests, request{header: r.Header.Clone(), body: body})
+
+		status := http.StatusNoContent
+
+		if len(s.statuses) > 0 {
+			status = s.statuses[0]
+
+			if len(s.statuses) > 1 {
+				s.statuses = s.statuses[1:]
+			}
+		}
+
+		w.WriteHeader(status)
+	}))
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *subscriber) received() []request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]request(nil), s.requests...)
+}
+
+func newWebhook(subscribers ...notifier.WebhookSubscriber) *notifier.Webhook {
+	clk := clock.Fix(time.Unix(1734343200, 0))
+
+	return notifier.NewWebhook(notifier.WebhookConfig{
+		Subscribers:      subscribers,
+		Timeout:          time.Second,
+		RedeliveryWindow: time.Hour,
+		BreakerThreshold: 3,
+		BreakerCooldown:  time.Hour,
+	}, notifier.NewEnvelope("/faceit/users", clk), clk)
+}
+
+func TestWebhook_NotifyUserAdded(t *testing.T) {
+	t.Parallel()
+
+	user := &model.User{
+		ID: uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2"),
+		UserState: model.UserState{
+			PasswordHash: "f6b7e19e0d867de6c0391879050e8297165728d89d7c4e9e8839972b356c4d9d",
+			Email:        "alice@bob.com",
+			FirstName:    "Alice",
+			LastName:     "Bob",
+			Nickname:     "AB123",
+			Country:      "UK",
+		},
+	}
+
+	eventID := uuid.MustParse("9b8c4a34-42b0-4cb4-9d0f-5bd8e2a38bc1")
+
+	ctx := notifier.ContextWithEventID(context.Background(), eventID)
+	ctx = notifier.ContextWithEventTime(ctx, time.Date(2024, 12, 16, 9, 59, 58, 0, time.UTC))
+
+	t.Run("success, signed structured event", func(t *testing.T) {
+		t.Parallel()
+
+		sub := newSubscriber(t)
+
+		wh := newWebhook(notifier.WebhookSubscriber{URL: sub.URL, Secret: "secret"})
+
+		err := wh.NotifyUserAdded(ctx, user)
+		require.NoError(t, err)
+
+		reqs := sub.received()
+		require.Len(t, reqs, 1)
+
+		h := reqs[0].header
+		assert.Equal(t, "application/cloudevents+json", h.Get("Content-Type"))
+		assert.Equal(t, "1734343200", h.Get(notifier.HeaderTimestamp))
+		assert.Equal(t, "sha256="+notifier.Sign("secret", "1734343200", reqs[0].body), h.Get(notifier.HeaderSignature))
+		assert.Empty(t, h.Get("ce-id"))
+
+		assert.JSONEq(t, `{
+			"specversion": "1.0",
+			"id": "9b8c4a34-42b0-4cb4-9d0f-5bd8e2a38bc1",
+			"source": "/faceit/users",
+			"type": "com.faceit.user.added.v1",
+			"subject": "26ef0140-c436-4838-a271-32652c72f6f2",
+			"time": "2024-12-16T09:59:58Z",
+			"datacontenttype": "application/json",
+			"data": {
+				"id": "26ef0140-c436-4838-a271-32652c72f6f2",
+				"email": "alice@bob.com",
+				"first_name": "Alice",
+				"last_name": "Bob",
+				"nickname": "AB123",
+				"country": "UK"
+			}
+		}`, string(reqs[0].body))
+	})
+
+	t.Run("success, signed binary event", func(t *testing.T) {
+		t.Parallel()
+
+		sub := newSubscriber(t)
+
+		wh := newWebhook(notifier.WebhookSubscriber{URL: sub.URL, Secret: "secret", Mode: notifier.WebhookModeBinary})
+
+		err := wh.NotifyUserAdded(ctx, user)
+		require.NoError(t, err)
+
+		reqs := sub.received()
+		require.Len(t, reqs, 1)
+
+		h := reqs[0].header
+		assert.Equal(t, "application/json", h.Get("Content-Type"))
+		assert.Equal(t, "1.0", h.Get("ce-specversion"))
+		assert.Equal(t, eventID.String(), h.Get("ce-id"))
+		assert.Equal(t, "/faceit/users", h.Get("ce-source"))
+		assert.Equal(t, "com.faceit.user.added.v1", h.Get("ce-type"))
+		assert.Equal(t, "26ef0140-c436-4838-a271-32652c72f6f2", h.Get("ce-subject"))
+		assert.Equal(t, "2024-12-16T09:59:58Z", h.Get("ce-time"))
+		assert.Equal(t, "sha256="+notifier.Sign("secret", "1734343200", reqs[0].body), h.Get(notifier.HeaderSignature))
+
+		assert.JSONEq(t, `{
+			"id": "26ef0140-c436-4838-a271-32652c72f6f2",
+			"email": "alice@bob.com",
+			"first_name": "Alice",
+			"last_name": "Bob",
+			"nickname": "AB123",
+			"country": "UK"
+		}`, string(reqs[0].body))
+	})
+
+	t.Run("success, filtered by event type", func(t *testing.T) {
+		t.Parallel()
+
+		interested := newSubscriber(t)
+		uninterested := newSubscriber(t)
+
+		wh := newWebhook(
+			notifier.WebhookSubscriber{URL: interested.URL, Secret: "secret", Events: []string{"com.faceit.user.added.v1"}},
+			notifier.WebhookSubscriber{URL: uninterested.URL, Secret: "secret", Events: []string{"com.faceit.user.deleted.v1"}},
+		)
+
+		err := wh.NotifyUserAdded(context.Background(), user)
+		require.NoError(t, err)
+
+		assert.Len(t, interested.received(), 1)
+		assert.Empty(t, uninterested.received())
+	})
+
+	t.Run("error, attempted once", func(t *testing.T) {
+		t.Parallel()
+
+		sub := newSubscriber(t, http.StatusServiceUnavailable)
+
+		wh := newWebhook(notifier.WebhookSubscriber{URL: sub.URL, Secret: "secret"})
+
+		err := wh.NotifyUserAdded(context.Background(), user)
+		require.Error(t, err)
+		require.ErrorIs(t, err, notifier.ErrWebhookDelivery)
+
+		assert.Len(t, sub.received(), 1)
+	})
+
+	t.Run("success on redelivery, only to the subscribers that failed", func(t *testing.T) {
+		t.Parallel()
+
+		failing := newSubscriber(t, http.StatusServiceUnavailable, http.StatusOK)
+		healthy := newSubscriber(t)
+
+		wh := newWebhook(
+			notifier.WebhookSubscriber{URL: failing.URL, Secret: "secret"},
+			notifier.WebhookSubscriber{URL: healthy.URL, Secret: "secret"},
+		)
+
+		err := wh.NotifyUserAdded(ctx, user)
+		require.Error(t, err)
+
+		// The outbox relay retries the event, with the same id.
+		err = wh.NotifyUserAdded(ctx, user)
+		require.NoError(t, err)
+
+		reqs := failing.received()
+		require.Len(t, reqs, 2)
+		assert.Equal(t, reqs[0].body, reqs[1].body)
+
+		assert.Len(t, healthy.received(), 1)
+
+		// Once delivered to every subscriber, a further redelivery is posted to all of them.
+		err = wh.NotifyUserAdded(ctx, user)
+		require.NoError(t, err)
+
+		assert.Len(t, failing.received(), 3)
+		assert.Len(t, healthy.received(), 2)
+	})
+
+	t.Run("error, circuit open", func(t *testing.T) {
+		t.Parallel()
+
+		failing := newSubscriber(t, http.StatusInternalServerError)
+		healthy := newSubscriber(t)
+
+		wh := newWebhook(
+			notifier.WebhookSubscriber{URL: failing.URL, Secret: "secret"},
+			notifier.WebhookSubscriber{URL: healthy.URL, Secret: "secret"},
+		)
+
+		// Three failed deliveries open the circuit.
+		for range 3 {
+			err := wh.NotifyUserAdded(context.Background(), user)
+			require.Error(t, err)
+		}
+
+		require.Len(t, failing.received(), 3)
+
+		err := wh.NotifyUserAdded(context.Background(), user)
+		require.Error(t, err)
+		require.ErrorIs(t, err, notifier.ErrCircuitOpen)
+
+		assert.Len(t, failing.received(), 3)
+		assert.Len(t, healthy.received(), 4)
+	})
+}
+
+func TestWebhook_NotifyUserUpdated(t *testing.T) {
+	t.Parallel()
+
+	sub := newSubscriber(t)
+
+	wh := newWebhook(notifier.WebhookSubscriber{URL: sub.URL, Secret: "secret"})
+
+	err := wh.NotifyUserUpdated(context.Background(), uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2"), model.UserState{
+		PasswordHash: "f6b7e19e0d867de6c0391879050e8297165728d89d7c4e9e8839972b356c4d9d",
+		Country:      "DE",
+	})
+	require.NoError(t, err)
+
+	reqs := sub.received()
+	require.Len(t, reqs, 1)
+
+	var payload struct {
+		Type string         `json:"type"`
+		Data map[string]any `json:"data"`
+	}
+
+	require.NoError(t, json.Unmarshal(reqs[0].body, &payload))
+
+	assert.Equal(t, "com.faceit.user.updated.v1", payload.Type)
+	assert.Equal(t, map[string]any{"id": "26ef0140-c436-4838-a271-32652c72f6f2", "country": "DE"}, payload.Data)
+}
+
+func TestWebhook_NotifyUserDeleted(t *testing.T) {
+	t.Parallel()
+
+	sub := newSubscriber(t)
+
+	wh := newWebhook(notifier.WebhookSubscriber{URL: sub.URL, Secret: "secret"})
+
+	err := wh.NotifyUserDeleted(context.Background(), uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2"))
+	require.NoError(t, err)
+
+	reqs := sub.received()
+	require.Len(t, reqs, 1)
+
+	assert.Contains(t, string(reqs[0].body), `"type":"com.faceit.user.deleted.v1"`)
+}
+
+func TestWebhook_NotifyUserRestored(t *testing.T) {
+	t.Parallel()
+
+	sub := newSubscriber(t)
+
+	wh := newWebhook(notifier.WebhookSubscriber{URL: sub.URL, Secret: "secret"})
+
+	err := wh.NotifyUserRestored(context.Background(), uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2"))
+	require.NoError(t, err)
+
+	reqs := sub.received()
+	require.Len(t, reqs, 1)
+
+	assert.Contains(t, string(reqs[0].body), `"type":"com.faceit.user.restored.v1"`)
+}
+
+func TestWebhook_NotifyUserStatusChanged(t *testing.T) {
+	t.Parallel()
+
+	sub := newSubscriber(t)
+
+	wh := newWebhook(notifier.WebhookSubscriber{URL: sub.URL, Secret: "secret"})
+
+	err := wh.NotifyUserStatusChanged(context.Background(), uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2"), model.StatusSuspended)
+	require.NoError(t, err)
+
+	reqs := sub.received()
+	require.Len(t, reqs, 1)
+
+	var payload struct {
+		Type string         `json:"type"`
+		Data map[string]any `json:"data"`
+	}
+
+	require.NoError(t, json.Unmarshal(reqs[0].body, &payload))
+
+	assert.Equal(t, "com.faceit.user.status_changed.v1", payload.Type)
+	assert.Equal(t, map[string]any{"id": "26ef0140-c436-4838-a271-32652c72f6f2", "status": "suspended"}, payload.Data)
+}
+
+func TestWebhook_NotifyPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	sub := newSubscriber(t)
+
+	wh := newWebhook(notifier.WebhookSubscriber{URL: sub.URL, Secret: "secret"})
+
+	err := wh.NotifyPasswordReset(context.Background(), uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2"))
+	require.NoError(t, err)
+
+	reqs := sub.received()
+	require.Len(t, reqs, 1)
+
+	assert.Contains(t, string(reqs[0].body), `"type":"com.faceit.user.password_reset.v1"`)
+}
+
+func TestWebhookSubscribers_Decode(t *testing.T) {
+	t.Parallel()
+
+	var subs notifier.WebhookSubscribers
+
+	err := subs.Decode(`[{"url": "https://example.com/hook", "secret": "s3cr3t", "events": ["com.faceit.user.added.v1"], "mode": "binary"}]`)
+	require.NoError(t, err)
+
+	assert.Equal(t, notifier.WebhookSubscribers{
+		{URL: "https://example.com/hook", Secret: "s3cr3t", Events: []string{"com.faceit.user.added.v1"}, Mode: "binary"},
+	}, subs)
+}
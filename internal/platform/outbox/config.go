@@ -0,0 +1,19 @@
+package outbox
+
+import "time"
+
+// Config represents the outbox relay configuration.
+type Config struct {
+	// PollInterval is the time between two consecutive outbox drains.
+	PollInterval time.Duration `split_words:"true" default:"1s"`
+	// BatchSize is the maximum number of events relayed per drain.
+	BatchSize uint64 `split_words:"true" default:"100"`
+	// ClaimTTL is the time the events are reserved to the relay draining them, publishing stops once it elapses.
+	ClaimTTL time.Duration `split_words:"true" default:"1m"`
+	// MaxAttempts is the number of attempts to relay an event before giving up on it.
+	MaxAttempts int `split_words:"true" default:"10"`
+	// RetryBackoff is the initial time to wait before retrying a failed event, doubled on every attempt.
+	RetryBackoff time.Duration `split_words:"true" default:"1s"`
+	// RetryMaxBackoff is the maximum time to wait before retrying a failed event.
+	RetryMaxBackoff time.Duration `split_words:"true" default:"5m"`
+}
@@ -0,0 +1,2 @@
+// Package outbox relays the events recorded in the outbox to the notifiers.
+package outbox
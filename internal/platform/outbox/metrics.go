@@ -0,0 +1,69 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics holds the outbox relay metrics.
+//
+// It is a single prometheus.Collector, so it can be exposed through the metrics server.
+type Metrics struct {
+	published *prometheus.CounterVec
+	failed    *prometheus.CounterVec
+	dead      *prometheus.CounterVec
+	lag       prometheus.Histogram
+}
+
+// NewMetrics creates the outbox relay metrics.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		published: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "outbox_events_published_total",
+			Help: "Number of outbox events published.",
+		}, []string{"event_type"}),
+		failed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "outbox_events_failed_total",
+			Help: "Number of failed attempts to publish an outbox event.",
+		}, []string{"event_type"}),
+		dead: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "outbox_events_dead_total",
+			Help: "Number of outbox events given up after reaching the maximum number of attempts.",
+		}, []string{"event_type"}),
+		lag: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "outbox_events_lag_seconds",
+			Help:    "Time elapsed between the event was recorded and published.",
+			Buckets: prometheus.ExponentialBuckets(0.01, 4, 10),
+		}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.published.Describe(ch)
+	m.failed.Describe(ch)
+	m.dead.Describe(ch)
+	m.lag.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.published.Collect(ch)
+	m.failed.Collect(ch)
+	m.dead.Collect(ch)
+	m.lag.Collect(ch)
+}
+
+func (m *Metrics) observePublished(eventType string, lag time.Duration) {
+	m.published.WithLabelValues(eventType).Inc()
+	m.lag.Observe(lag.Seconds())
+}
+
+func (m *Metrics) observeFailed(eventType string, dead bool) {
+	m.failed.WithLabelValues(eventType).Inc()
+
+	if dead {
+		m.dead.WithLabelValues(eventType).Inc()
+	}
+}
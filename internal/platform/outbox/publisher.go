@@ -0,0 +1,65 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/notifier"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+)
+
+// Publisher defines functionality to publish an event recorded in the outbox.
+type Publisher interface {
+	Publish(ctx context.Context, e *storage.OutboxEvent) error
+}
+
+// Notifier defines the user notifiers an event can be delivered to.
+type Notifier interface {
+	usecase.UserAddedNotifier
+	usecase.UserUpdatedNotifier
+	usecase.UserDeletedNotifier
+	usecase.UserRestoredNotifier
+	usecase.UserStatusChangedNotifier
+	usecase.UserPasswordResetNotifier
+}
+
+// NotifierPublisher is a Publisher that delivers the events to a Notifier.
+type NotifierPublisher struct {
+	notifier Notifier
+}
+
+// NewNotifierPublisher creates a new NotifierPublisher.
+func NewNotifierPublisher(notifier Notifier) *NotifierPublisher {
+	return &NotifierPublisher{
+		notifier: notifier,
+	}
+}
+
+// Publish decodes the event and calls the notifier according to the event type.
+func (p *NotifierPublisher) Publish(ctx context.Context, e *storage.OutboxEvent) error {
+	u, err := e.User()
+	if err != nil {
+		return fmt.Errorf("decode event payload: %w", err)
+	}
+
+	ctx = notifier.ContextWithEventID(ctx, e.EventID)
+	ctx = notifier.ContextWithEventTime(ctx, e.CreatedAt)
+
+	switch e.Type {
+	case storage.EventUserAdded:
+		return p.notifier.NotifyUserAdded(ctx, u)
+	case storage.EventUserUpdated:
+		return p.notifier.NotifyUserUpdated(ctx, u.ID, u.UserState)
+	case storage.EventUserDeleted:
+		return p.notifier.NotifyUserDeleted(ctx, u.ID)
+	case storage.EventUserRestored:
+		return p.notifier.NotifyUserRestored(ctx, u.ID)
+	case storage.EventUserStatusChanged:
+		return p.notifier.NotifyUserStatusChanged(ctx, u.ID, u.Status)
+	case storage.EventUserPasswordReset:
+		return p.notifier.NotifyPasswordReset(ctx, u.ID)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownEvent, e.Type)
+	}
+}
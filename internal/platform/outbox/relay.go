@@ -0,0 +1,195 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/nhatthm/go-clock"
+)
+
+// ErrUnknownEvent occurs when the event type is not known by the publisher.
+var ErrUnknownEvent = errors.New("unknown event type")
+
+// Store defines functionality to access the outbox.
+type Store interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	TryLock(ctx context.Context) (bool, error)
+	ListPending(ctx context.Context, limit uint64) ([]*storage.OutboxEvent, error)
+	Claim(ctx context.Context, ids []int64, ttl time.Duration) error
+	Release(ctx context.Context, ids []int64) error
+	MarkPublished(ctx context.Context, id int64) error
+	MarkRetry(ctx context.Context, id int64, reason string, backoff time.Duration) error
+	MarkFailed(ctx context.Context, id int64, reason string) error
+}
+
+// Relay drains the outbox into a Publisher.
+//
+// Events are published in the order they were recorded per user: once an event of a user fails, the following events
+// of the same user wait until it is published or given up. Only one relay drains the outbox at a time, so ordering
+// holds with several instances of the service running: the events are claimed for ClaimTTL, then published outside of
+// any transaction. Delivery is at least once.
+type Relay struct {
+	store     Store
+	publisher Publisher
+	clock     clock.Clock
+	cfg       Config
+
+	metrics *Metrics
+
+	// OnError is called when draining the outbox fails.
+	OnError func(ctx context.Context, err error)
+}
+
+// NewRelay creates a new Relay.
+func NewRelay(store Store, publisher Publisher, clk clock.Clock, cfg Config) *Relay {
+	return &Relay{
+		store:     store,
+		publisher: publisher,
+		clock:     clk,
+		cfg:       cfg,
+		metrics:   NewMetrics(),
+	}
+}
+
+// Metrics returns the relay metrics.
+func (r *Relay) Metrics() *Metrics {
+	return r.metrics
+}
+
+// Run drains the outbox every poll interval until the context is done.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Drain(ctx); err != nil && r.OnError != nil && ctx.Err() == nil {
+				r.OnError(ctx, err)
+			}
+		}
+	}
+}
+
+// Drain publishes the pending events once.
+func (r *Relay) Drain(ctx context.Context) (err error) {
+	events, err := r.claim(ctx)
+	if err != nil || len(events) == 0 {
+		return err
+	}
+
+	ids := make([]int64, 0, len(events))
+
+	for _, e := range events {
+		ids = append(ids, e.ID)
+	}
+
+	defer func() {
+		if rerr := r.store.Release(ctx, ids); rerr != nil && err == nil {
+			err = rerr
+		}
+	}()
+
+	// Publishing stops before the claim expires, the events left are published by the next drain.
+	pctx, cancel := context.WithTimeout(ctx, r.cfg.ClaimTTL)
+	defer cancel()
+
+	blocked := make(map[model.UserID]struct{})
+
+	for _, e := range events {
+		if pctx.Err() != nil {
+			return nil
+		}
+
+		if _, ok := blocked[e.UserID]; ok {
+			continue
+		}
+
+		if err := r.publish(ctx, pctx, e); err != nil {
+			return err
+		}
+
+		if !e.PublishedAt.Valid {
+			blocked[e.UserID] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// claim lists the pending events and claims them, holding the outbox lock only for the time of claiming.
+func (r *Relay) claim(ctx context.Context) ([]*storage.OutboxEvent, error) {
+	var events []*storage.OutboxEvent
+
+	err := r.store.InTx(ctx, func(ctx context.Context) error {
+		locked, err := r.store.TryLock(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !locked {
+			// Another relay is claiming the events.
+			return nil
+		}
+
+		events, err = r.store.ListPending(ctx, r.cfg.BatchSize)
+		if err != nil || len(events) == 0 {
+			return err
+		}
+
+		ids := make([]int64, 0, len(events))
+
+		for _, e := range events {
+			ids = append(ids, e.ID)
+		}
+
+		return r.store.Claim(ctx, ids, r.cfg.ClaimTTL)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// publish publishes the event within the publishing context and records the outcome.
+func (r *Relay) publish(ctx, pctx context.Context, e *storage.OutboxEvent) error {
+	perr := r.publisher.Publish(pctx, e)
+	if perr == nil {
+		r.metrics.observePublished(e.Type, r.clock.Now().Sub(e.CreatedAt))
+
+		e.PublishedAt.Valid = true
+
+		return r.store.MarkPublished(ctx, e.ID)
+	}
+
+	dead := e.Attempts+1 >= r.cfg.MaxAttempts
+
+	r.metrics.observeFailed(e.Type, dead)
+
+	if dead {
+		return r.store.MarkFailed(ctx, e.ID, perr.Error())
+	}
+
+	return r.store.MarkRetry(ctx, e.ID, perr.Error(), r.backoff(e.Attempts))
+}
+
+// backoff returns the time to wait before the next attempt.
+func (r *Relay) backoff(attempts int) time.Duration {
+	d := r.cfg.RetryBackoff
+
+	for i := 0; i < attempts && d < r.cfg.RetryMaxBackoff; i++ {
+		d *= 2
+	}
+
+	if d > r.cfg.RetryMaxBackoff {
+		d = r.cfg.RetryMaxBackoff
+	}
+
+	return d
+}
@@ -0,0 +1,209 @@
+package outbox_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/platform/outbox"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/google/uuid"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type store struct {
+	locked bool
+	events []*storage.OutboxEvent
+
+	claimed  []int64
+	released []int64
+
+	published []int64
+	retried   map[int64]time.Duration
+	failed    []int64
+}
+
+func (s *store) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (s *store) TryLock(_ context.Context) (bool, error) {
+	return s.locked, nil
+}
+
+func (s *store) ListPending(_ context.Context, _ uint64) ([]*storage.OutboxEvent, error) {
+	return s.events, nil
+}
+
+func (s *store) Claim(_ context.Context, ids []int64, _ time.Duration) error {
+	s.claimed = ids
+
+	return nil
+}
+
+func (s *store) Release(_ context.Context, ids []int64) error {
+	s.released = ids
+
+	return nil
+}
+
+func (s *store) MarkPublished(_ context.Context, id int64) error {
+	s.published = append(s.published, id)
+
+	return nil
+}
+
+func (s *store) MarkRetry(_ context.Context, id int64, _ string, backoff time.Duration) error {
+	if s.retried == nil {
+		s.retried = make(map[int64]time.Duration)
+	}
+
+	s.retried[id] = backoff
+
+	return nil
+}
+
+func (s *store) MarkFailed(_ context.Context, id int64, _ string) error {
+	s.failed = append(s.failed, id)
+
+	return nil
+}
+
+type publisher struct {
+	fail      map[int64]bool
+	published []int64
+}
+
+func (p *publisher) Publish(_ context.Context, e *storage.OutboxEvent) error {
+	if p.fail[e.ID] {
+		return assert.AnError
+	}
+
+	p.published = append(p.published, e.ID)
+
+	return nil
+}
+
+func TestRelay_Drain(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 12, 16, 10, 0, 0, 0, time.UTC)
+
+	cfg := outbox.Config{
+		BatchSize:       100,
+		ClaimTTL:        time.Minute,
+		MaxAttempts:     3,
+		RetryBackoff:    time.Second,
+		RetryMaxBackoff: time.Minute,
+	}
+
+	alice, bob := uuid.New(), uuid.New()
+
+	t.Run("success, publishes in order", func(t *testing.T) {
+		t.Parallel()
+
+		st := &store{
+			locked: true,
+			events: []*storage.OutboxEvent{
+				{ID: 1, UserID: alice, Type: storage.EventUserAdded},
+				{ID: 2, UserID: bob, Type: storage.EventUserAdded},
+				{ID: 3, UserID: alice, Type: storage.EventUserUpdated},
+			},
+		}
+		pub := &publisher{}
+
+		r := outbox.NewRelay(st, pub, clock.Fix(now), cfg)
+
+		require.NoError(t, r.Drain(context.Background()))
+
+		assert.Equal(t, []int64{1, 2, 3}, pub.published)
+		assert.Equal(t, []int64{1, 2, 3}, st.published)
+
+		// Events are claimed for the time of publishing.
+		assert.Equal(t, []int64{1, 2, 3}, st.claimed)
+		assert.Equal(t, []int64{1, 2, 3}, st.released)
+	})
+
+	t.Run("failure blocks the following events of the user", func(t *testing.T) {
+		t.Parallel()
+
+		st := &store{
+			locked: true,
+			events: []*storage.OutboxEvent{
+				{ID: 1, UserID: alice, Type: storage.EventUserAdded, Attempts: 1},
+				{ID: 2, UserID: bob, Type: storage.EventUserAdded},
+				{ID: 3, UserID: alice, Type: storage.EventUserUpdated},
+			},
+		}
+		pub := &publisher{fail: map[int64]bool{1: true}}
+
+		r := outbox.NewRelay(st, pub, clock.Fix(now), cfg)
+
+		require.NoError(t, r.Drain(context.Background()))
+
+		assert.Equal(t, []int64{2}, pub.published)
+		assert.Equal(t, map[int64]time.Duration{1: 2 * time.Second}, st.retried)
+		assert.Equal(t, []int64{1, 2, 3}, st.released)
+	})
+
+	t.Run("publishing stops once the claim expires", func(t *testing.T) {
+		t.Parallel()
+
+		st := &store{
+			locked: true,
+			events: []*storage.OutboxEvent{
+				{ID: 1, UserID: alice, Type: storage.EventUserAdded},
+			},
+		}
+		pub := &publisher{}
+
+		expired := cfg
+		expired.ClaimTTL = 0
+
+		r := outbox.NewRelay(st, pub, clock.Fix(now), expired)
+
+		require.NoError(t, r.Drain(context.Background()))
+
+		assert.Empty(t, pub.published)
+		assert.Equal(t, []int64{1}, st.released)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		t.Parallel()
+
+		st := &store{
+			locked: true,
+			events: []*storage.OutboxEvent{
+				{ID: 1, UserID: alice, Type: storage.EventUserAdded, Attempts: 2},
+			},
+		}
+		pub := &publisher{fail: map[int64]bool{1: true}}
+
+		r := outbox.NewRelay(st, pub, clock.Fix(now), cfg)
+
+		require.NoError(t, r.Drain(context.Background()))
+
+		assert.Equal(t, []int64{1}, st.failed)
+		assert.Empty(t, st.retried)
+	})
+
+	t.Run("skip when locked by another relay", func(t *testing.T) {
+		t.Parallel()
+
+		st := &store{
+			events: []*storage.OutboxEvent{
+				{ID: 1, UserID: alice, Type: storage.EventUserAdded},
+			},
+		}
+		pub := &publisher{}
+
+		r := outbox.NewRelay(st, pub, clock.Fix(now), cfg)
+
+		require.NoError(t, r.Drain(context.Background()))
+
+		assert.Empty(t, pub.published)
+		assert.Empty(t, st.claimed)
+	})
+}
@@ -0,0 +1,2 @@
+// Package pagetoken encodes the positions in the lists into opaque signed page tokens.
+package pagetoken
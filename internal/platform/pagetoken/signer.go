@@ -0,0 +1,110 @@
+package pagetoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+)
+
+// payload is the content of the page token.
+type payload struct {
+	Cursor model.Cursor `json:"c"`
+	Query  []byte       `json:"q"` // Hash of the request parameters the token is valid for
+}
+
+// Signer encodes the cursors into page tokens signed with HMAC-SHA256, so clients can not forge them.
+//
+// The token is bound to the request parameters, decoding it with other parameters fails. This enforces the rule that
+// all the parameters but the page size must match the call that provided the page token.
+type Signer struct {
+	key []byte
+}
+
+// NewSigner creates a new Signer with the secret key.
+func NewSigner(key []byte) *Signer {
+	return &Signer{key: key}
+}
+
+// Encode encodes the cursor, bound to the request parameters, into a page token.
+//
+// It returns an empty token when the cursor is nil, there are no more pages.
+func (s *Signer) Encode(cursor model.Cursor, params ...string) string {
+	if cursor == nil {
+		return ""
+	}
+
+	raw, err := json.Marshal(payload{Cursor: cursor, Query: queryHash(params)})
+	if err != nil {
+		// Marshaling strings and bytes does not fail.
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw) + "." + base64.RawURLEncoding.EncodeToString(s.sign(raw))
+}
+
+// Decode decodes the cursor of the page token issued for the request parameters.
+//
+// It returns a nil cursor when the token is empty, the first page.
+func (s *Signer) Decode(token string, params ...string) (model.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	p64, sig64, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed", usecase.ErrInvalidPageToken)
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(p64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", usecase.ErrInvalidPageToken, err)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(sig64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", usecase.ErrInvalidPageToken, err)
+	}
+
+	if !hmac.Equal(sig, s.sign(raw)) {
+		return nil, fmt.Errorf("%w: signature mismatch", usecase.ErrInvalidPageToken)
+	}
+
+	var p payload
+
+	if err = json.Unmarshal(raw, &p); err != nil {
+		return nil, fmt.Errorf("%w: %w", usecase.ErrInvalidPageToken, err)
+	}
+
+	if !hmac.Equal(p.Query, queryHash(params)) {
+		return nil, fmt.Errorf("%w: request parameters do not match the call that provided the page token",
+			usecase.ErrInvalidPageToken)
+	}
+
+	return p.Cursor, nil
+}
+
+func (s *Signer) sign(raw []byte) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	_, _ = mac.Write(raw) //nolint:errcheck // Hash writes never fail.
+
+	return mac.Sum(nil)
+}
+
+// queryHash hashes the request parameters, each one prefixed by its length so the boundaries are unambiguous.
+func queryHash(params []string) []byte {
+	h := sha256.New()
+
+	for _, p := range params {
+		_ = binary.Write(h, binary.BigEndian, uint64(len(p))) //nolint:errcheck // Hash writes never fail.
+		_, _ = h.Write([]byte(p))                             //nolint:errcheck // Hash writes never fail.
+	}
+
+	return h.Sum(nil)[:16]
+}
@@ -0,0 +1,73 @@
+package pagetoken_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/pagetoken"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner(t *testing.T) {
+	t.Parallel()
+
+	s := pagetoken.NewSigner([]byte("secret"))
+	cursor := model.Cursor{"2024-01-01T10:00:00Z", "26ef0140-c436-4838-a271-32652c72f6f2"}
+
+	token := s.Encode(cursor, "ListUsersByCountry", "UK")
+	require.NotEmpty(t, token)
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := s.Decode(token, "ListUsersByCountry", "UK")
+		require.NoError(t, err)
+		require.Equal(t, cursor, got)
+	})
+
+	t.Run("first and last page", func(t *testing.T) {
+		t.Parallel()
+
+		require.Empty(t, s.Encode(nil, "ListUsersByCountry", "UK"))
+
+		got, err := s.Decode("", "ListUsersByCountry", "UK")
+		require.NoError(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("other parameters", func(t *testing.T) {
+		t.Parallel()
+
+		for _, params := range [][]string{
+			{"ListUsersByCountry", "MR"},
+			{"ListUsers", "UK"},
+			{"ListUsersByCountry", "U", "K"},
+		} {
+			_, err := s.Decode(token, params...)
+			require.ErrorIs(t, err, usecase.ErrInvalidPageToken)
+			require.ErrorContains(t, err, "request parameters do not match")
+		}
+	})
+
+	t.Run("forged", func(t *testing.T) {
+		t.Parallel()
+
+		payload, sig, _ := strings.Cut(token, ".")
+
+		for _, forged := range []string{
+			"2",
+			payload + ".",
+			payload + "." + sig[1:],
+			strings.ToUpper(payload) + "." + sig,
+		} {
+			_, err := s.Decode(forged, "ListUsersByCountry", "UK")
+			require.ErrorIs(t, err, usecase.ErrInvalidPageToken, forged)
+		}
+
+		_, err := pagetoken.NewSigner([]byte("other")).Decode(token, "ListUsersByCountry", "UK")
+		require.ErrorIs(t, err, usecase.ErrInvalidPageToken)
+		require.ErrorContains(t, err, "signature mismatch")
+	})
+}
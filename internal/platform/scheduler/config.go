@@ -0,0 +1,11 @@
+package scheduler
+
+import "time"
+
+// Config represents the scheduler configuration.
+type Config struct {
+	// Interval is the time between two consecutive runs of the jobs.
+	Interval time.Duration `default:"1m"`
+	// BatchSize is the maximum number of items a job handles at once.
+	BatchSize uint64 `split_words:"true" default:"100"`
+}
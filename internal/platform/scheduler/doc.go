@@ -0,0 +1,2 @@
+// Package scheduler runs the periodic jobs of the service, e.g. lifting expired suspensions, purging deleted users.
+package scheduler
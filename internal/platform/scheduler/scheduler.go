@@ -0,0 +1,83 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nhatthm/go-clock"
+)
+
+// Job handles up to limit items due at the given time, returning the number of items handled.
+type Job func(ctx context.Context, at time.Time, limit uint64) (int, error)
+
+type namedJob struct {
+	name string
+	run  Job
+}
+
+// Scheduler runs the jobs every interval.
+//
+// A job handling a full batch runs again right away, until it catches up. Jobs must be safe to run on several
+// instances of the service at once.
+type Scheduler struct {
+	clock clock.Clock
+	cfg   Config
+	jobs  []namedJob
+
+	// OnError is called when a job fails.
+	OnError func(ctx context.Context, err error)
+}
+
+// New creates a new Scheduler.
+func New(clk clock.Clock, cfg Config) *Scheduler {
+	return &Scheduler{
+		clock: clk,
+		cfg:   cfg,
+	}
+}
+
+// Add adds the job, the name identifies it in the errors.
+func (s *Scheduler) Add(name string, job Job) {
+	s.jobs = append(s.jobs, namedJob{name: name, run: job})
+}
+
+// Run runs the jobs every interval until the context is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.RunOnce(ctx); err != nil && s.OnError != nil && ctx.Err() == nil {
+				s.OnError(ctx, err)
+			}
+		}
+	}
+}
+
+// RunOnce runs the jobs once, a failing job does not prevent the others from running.
+func (s *Scheduler) RunOnce(ctx context.Context) error {
+	var errs []error
+
+	for _, j := range s.jobs {
+		for {
+			n, err := j.run(ctx, s.clock.Now().UTC(), s.cfg.BatchSize)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", j.name, err))
+
+				break
+			}
+
+			if uint64(n) < s.cfg.BatchSize || ctx.Err() != nil {
+				break
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
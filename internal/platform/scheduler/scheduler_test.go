@@ -0,0 +1,54 @@
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/faceit/internal/platform/scheduler"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_RunOnce(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 12, 23, 9, 0, 0, 0, time.UTC)
+
+	s := scheduler.New(clock.Fix(now), scheduler.Config{BatchSize: 2})
+
+	// The job catches up with the full batches.
+	pending := 5
+
+	var runs []time.Time
+
+	s.Add("catching up", func(_ context.Context, at time.Time, limit uint64) (int, error) {
+		runs = append(runs, at)
+
+		n := min(pending, int(limit))
+		pending -= n
+
+		return n, nil
+	})
+
+	s.Add("failing", func(context.Context, time.Time, uint64) (int, error) {
+		return 0, assert.AnError
+	})
+
+	var others int
+
+	s.Add("other", func(context.Context, time.Time, uint64) (int, error) {
+		others++
+
+		return 0, nil
+	})
+
+	err := s.RunOnce(context.Background())
+	require.ErrorIs(t, err, assert.AnError)
+	require.ErrorContains(t, err, "failing")
+
+	assert.Equal(t, []time.Time{now, now, now}, runs)
+	assert.Zero(t, pending)
+	assert.Equal(t, 1, others)
+}
@@ -2,12 +2,13 @@ package service
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
+	"net/http"
 
-	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	pbvalidate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	"github.com/bool64/ctxd"
 	"github.com/bufbuild/protovalidate-go"
+	"github.com/dohernandez/faceit/internal/platform/auth"
+	"github.com/dohernandez/faceit/internal/platform/pagetoken"
 	api "github.com/dohernandez/faceit/internal/platform/service/pb"
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -20,12 +21,36 @@ import (
 type FaceitServiceDeps interface {
 	Logger() ctxd.Logger
 	GRPCAddr() string
+	SSEConfig() SSEConfig
+	PageTokens() *pagetoken.Signer
+	SigningKeys() *auth.KeySet
+	Authenticator() *auth.Authenticator
 
 	AddUser() AddUser
 	UpdateUser() UpdateUser
 	DeleteUser() DeleteUser
+	UndeleteUser() UndeleteUser
+	GetUser() GetUser
 
 	ListUsersByCountry() ListUsersByCountry
+	ListUsers() ListUsers
+	WatchUsers() WatchUsers
+
+	Authenticate() Authenticate
+	RefreshSession() RefreshSession
+	RevokeSession() RevokeSession
+
+	VerifyEmail() VerifyEmail
+	ResendVerification() ResendVerification
+	RequestPasswordReset() RequestPasswordReset
+	ResetPassword() ResetPassword
+
+	ChangeUserStatus() ChangeUserStatus
+
+	ExportUserData() ExportUserData
+	EraseUser() EraseUser
+
+	ListUserAuditEvents() ListUserAuditEvents
 }
 
 // FaceitService is the gRPC service.
@@ -52,29 +77,53 @@ func (s *FaceitService) RegisterService(r grpc.ServiceRegistrar) {
 
 // RegisterServiceHandler registers the service implementation to mux.
 func (s *FaceitService) RegisterServiceHandler(mux *runtime.ServeMux) error {
+	// register server-sent events, streaming is not supported by the rest service
+	if err := mux.HandlePath(http.MethodGet, "/v1/users:watch", s.WatchUsersSSE); err != nil {
+		return err
+	}
+
+	// register the keys verifying the access tokens, at their conventional well-known location
+	if err := mux.HandlePath(http.MethodGet, "/.well-known/jwks.json", s.JWKS); err != nil {
+		return err
+	}
+
 	// register rest service
 	return api.RegisterFaceitServiceHandlerFromEndpoint(context.Background(), mux, s.deps.GRPCAddr(), []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())})
 }
 
-func isUserValid(msg proto.Message, val *protovalidate.Validator, forAdd bool) (map[string]string, bool) {
+// validate validates the message against its constraints, returning the message of the fields invalid.
+func validate(msg proto.Message, val *protovalidate.Validator) (map[string]string, bool) {
 	fields := make(map[string]string)
 
 	if err := val.Validate(msg); err != nil {
 		fields = mapValidatorError(err)
 	}
 
+	return fields, len(fields) == 0
+}
+
+// isUserValid validates the user, along the constraints of the message, the fields the service requires on add.
+func isUserValid(msg proto.Message, val *protovalidate.Validator, forAdd bool) (map[string]string, bool) {
+	fields, _ := validate(msg, val)
+
 	req, ok := msg.(*api.User)
 	if !ok {
 		return fields, len(fields) == 0
 	}
 
-	if req.PasswordHash != nil && !isValidSHA256Hash(req.GetPasswordHash()) {
-		fields["password_hash"] = "invalid hash"
+	// Passwords are hashed by the service, a hash computed by the client is not accepted.
+	if req.PasswordHash != nil { //nolint:staticcheck // Checked to reject the deprecated field.
+		fields["password_hash"] = "no longer accepted, send the password"
 	}
 
-	_, err := uuid.Parse(req.GetId())
-	if err != nil {
-		fields["password_hash"] = err.Error()
+	// The id of the users added is generated when not given.
+	switch {
+	case req.GetId() == "" && !forAdd:
+		fields["id"] = "must not be empty"
+	case req.GetId() != "":
+		if _, err := uuid.Parse(req.GetId()); err != nil {
+			fields["id"] = err.Error()
+		}
 	}
 
 	if !forAdd {
@@ -99,11 +148,15 @@ func isUserValid(msg proto.Message, val *protovalidate.Validator, forAdd bool) (
 		fields["country"] = require
 	}
 
+	if req.Password == nil && req.PasswordHash == nil { //nolint:staticcheck // Checked to reject the deprecated field.
+		fields["password"] = require
+	}
+
 	return fields, len(fields) == 0
 }
 
 func mapValidatorError(err error) map[string]string {
-	valErrs, ok := err.(interface{ ToProto() *validate.Violations })
+	valErrs, ok := err.(interface{ ToProto() *pbvalidate.Violations })
 	if !ok {
 		return nil
 	}
@@ -121,14 +174,3 @@ func mapValidatorError(err error) map[string]string {
 
 	return fieldMsg
 }
-
-func isValidSHA256Hash(hash string) bool {
-	// Decode the string from hexadecimal
-	decoded, err := hex.DecodeString(hash)
-	if err != nil {
-		return false // Not a valid hex string
-	}
-
-	// Check if the decoded byte slice matches the size of a SHA-256 hash
-	return len(decoded) == sha256.Size
-}
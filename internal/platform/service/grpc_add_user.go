@@ -6,6 +6,7 @@ import (
 
 	"github.com/bufbuild/protovalidate-go"
 	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
 	api "github.com/dohernandez/faceit/internal/platform/service/pb"
 	"github.com/dohernandez/go-grpc-service/database"
 	"github.com/dohernandez/servers"
@@ -13,18 +14,21 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// headerLocation is the header, and gRPC metadata, bearing the URI of the user added.
+const headerLocation = "location"
+
 // AddUser defines the use case to add a user.
 type AddUser interface {
-	AddUser(ctx context.Context, u *model.User) error
+	AddUser(ctx context.Context, u *model.User, password string) (*model.User, error)
 }
 
 // AddUser add new user.
 //
-// Receives a request with user data. Responses whether the user was added successfully or not.
-func (s *FaceitService) AddUser(ctx context.Context, req *api.User) (*emptypb.Empty, error) {
+// Receives a request with user data and an optional id, generated when not given. Responses with the user added, 201
+// Created on REST along with its URI in the Location header.
+func (s *FaceitService) AddUser(ctx context.Context, req *api.User) (*api.UserProfile, error) {
 	// Validate request.
 	val, err := protovalidate.New(
 		protovalidate.WithMessages(
@@ -42,27 +46,35 @@ func (s *FaceitService) AddUser(ctx context.Context, req *api.User) (*emptypb.Em
 
 	// Add user.
 	us := &model.User{
-		ID: uuid.MustParse(req.GetId()), // Safe to ignore panic as it was validated before.
 		UserState: model.UserState{
-			PasswordHash: req.GetPasswordHash(),
-			Email:        req.GetEmail(),
-			FirstName:    req.GetFirstName(),
-			LastName:     req.GetLastName(),
-			Nickname:     req.GetNickname(),
-			Country:      req.GetCountry(),
+			Email:     req.GetEmail(),
+			FirstName: req.GetFirstName(),
+			LastName:  req.GetLastName(),
+			Nickname:  req.GetNickname(),
+			Country:   req.GetCountry(),
 		},
 	}
 
-	err = s.deps.AddUser().AddUser(ctx, us)
+	if req.GetId() != "" {
+		us.ID = uuid.MustParse(req.GetId()) // Safe to ignore panic as it was validated before.
+	}
+
+	u, err := s.deps.AddUser().AddUser(ctx, us, req.GetPassword())
 	if err != nil {
 		if errors.Is(err, database.ErrAlreadyExists) {
 			return nil, servers.WrapError(codes.AlreadyExists, err, "user already exists")
 		}
 
+		if errors.Is(err, usecase.ErrPermissionDenied) {
+			return nil, servers.WrapError(codes.PermissionDenied, err, "permission denied")
+		}
+
 		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
 	}
 
-	_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "204")) //nolint:errcheck
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "201", headerLocation, "/v1/users/"+u.ID.String())) //nolint:errcheck
+
+	setETag(ctx, u.Version)
 
-	return &emptypb.Empty{}, nil
+	return userToProto(u, nil), nil
 }
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dohernandez/faceit/internal/platform/auth"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/servers"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// headerAuthorization is the header, and gRPC metadata, bearing the token of the requests.
+//
+// The REST gateway forwards the Authorization header as metadata, the REST requests are authorized alike.
+const headerAuthorization = "authorization"
+
+// AuthRule is the authorization rule of a gRPC method.
+type AuthRule struct {
+	Public bool     // Callable without a token
+	Scopes []string // Scopes the bearer must be granted
+}
+
+// authRules are the authorization rules of the methods of the service, by full method name.
+var authRules = map[string]AuthRule{
+	api.FaceitService_AddUser_FullMethodName:              {Scopes: []string{auth.ScopeUsersWrite}},
+	api.FaceitService_UpdateUser_FullMethodName:           {Scopes: []string{auth.ScopeUsersWrite}},
+	api.FaceitService_DeleteUser_FullMethodName:           {Scopes: []string{auth.ScopeUsersWrite}},
+	api.FaceitService_UndeleteUser_FullMethodName:         {Scopes: []string{auth.ScopeUsersWrite}},
+	api.FaceitService_GetUser_FullMethodName:              {Scopes: []string{auth.ScopeUsersRead}},
+	api.FaceitService_ListUsersByCountry_FullMethodName:   {Scopes: []string{auth.ScopeUsersRead}},
+	api.FaceitService_ListUsers_FullMethodName:            {Scopes: []string{auth.ScopeUsersRead}},
+	api.FaceitService_WatchUsers_FullMethodName:           {Scopes: []string{auth.ScopeUsersRead}},
+	api.FaceitService_Authenticate_FullMethodName:         {Public: true},
+	api.FaceitService_RefreshToken_FullMethodName:         {Public: true},
+	api.FaceitService_Revoke_FullMethodName:               {Public: true},
+	api.FaceitService_VerifyEmail_FullMethodName:          {Public: true},
+	api.FaceitService_ResendVerification_FullMethodName:   {Scopes: []string{auth.ScopeUsersWrite}},
+	api.FaceitService_RequestPasswordReset_FullMethodName: {Public: true},
+	api.FaceitService_ResetPassword_FullMethodName:        {Public: true},
+	api.FaceitService_SuspendUser_FullMethodName:          {Scopes: []string{auth.ScopeUsersWrite}},
+	api.FaceitService_BanUser_FullMethodName:              {Scopes: []string{auth.ScopeUsersWrite}},
+	api.FaceitService_ReinstateUser_FullMethodName:        {Scopes: []string{auth.ScopeUsersWrite}},
+	api.FaceitService_ExportUserData_FullMethodName:       {Scopes: []string{auth.ScopeUsersRead}},
+	api.FaceitService_EraseUser_FullMethodName:            {Scopes: []string{auth.ScopeUsersWrite}},
+	api.FaceitService_ListUserAuditEvents_FullMethodName:  {Scopes: []string{auth.ScopeUsersRead}},
+}
+
+// AuthInterceptor authorizes the gRPC calls by the rule of their method, the bearer of the token in the
+// authorization metadata must be granted the scopes of the rule.
+//
+// Methods without rule, e.g. the reflection service, only require an authenticated bearer.
+type AuthInterceptor struct {
+	authn *auth.Authenticator
+	rules map[string]AuthRule
+}
+
+// NewAuthInterceptor creates a new AuthInterceptor with the rules of the service.
+func NewAuthInterceptor(authn *auth.Authenticator) *AuthInterceptor {
+	return &AuthInterceptor{
+		authn: authn,
+		rules: authRules,
+	}
+}
+
+// UnaryServerInterceptor authorizes the unary calls.
+func (i *AuthInterceptor) UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := i.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor authorizes the streaming calls.
+func (i *AuthInterceptor) StreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := i.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+}
+
+func (i *AuthInterceptor) authorize(ctx context.Context, method string) (context.Context, error) {
+	rule := i.rules[method]
+	if rule.Public {
+		return ctx, nil
+	}
+
+	var authorization string
+
+	if values := metadata.ValueFromIncomingContext(ctx, headerAuthorization); len(values) > 0 {
+		authorization = values[0]
+	}
+
+	ctx, err := i.authn.Authorize(ctx, authorization, rule.Scopes...)
+	if err != nil {
+		return nil, authError(err)
+	}
+
+	return ctx, nil
+}
+
+// authError converts the authorization error to its gRPC status.
+func authError(err error) error {
+	switch {
+	case errors.Is(err, auth.ErrMissingToken):
+		return servers.WrapError(codes.Unauthenticated, err, "missing bearer token")
+	case errors.Is(err, auth.ErrInvalidToken):
+		return servers.WrapError(codes.Unauthenticated, err, "invalid bearer token")
+	case errors.Is(err, auth.ErrInsufficientScope):
+		return servers.WrapError(codes.PermissionDenied, err, "insufficient scope")
+	default:
+		return servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+}
+
+// authorizedStream is a server stream carrying the context of the authorized call.
+type authorizedStream struct {
+	grpc.ServerStream
+
+	ctx context.Context //nolint:containedctx // Overrides the context of the stream.
+}
+
+// Context returns the context of the authorized call.
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,114 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dohernandez/faceit/internal/platform/auth"
+	"github.com/dohernandez/faceit/internal/platform/service"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type serverStream struct {
+	grpc.ServerStream
+
+	ctx context.Context //nolint:containedctx
+}
+
+func (s serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	t.Parallel()
+
+	i := service.NewAuthInterceptor(newAuthenticator())
+
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	unary := func(ctx context.Context, method string) (auth.Principal, error) {
+		var p auth.Principal
+
+		_, err := i.UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, _ any) (any, error) {
+			p, _ = auth.PrincipalFromContext(ctx)
+
+			return nil, nil //nolint:nilnil
+		})
+
+		return p, err
+	}
+
+	t.Run("granted", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := unary(withToken("writer-key"), api.FaceitService_DeleteUser_FullMethodName)
+		require.NoError(t, err)
+		require.Equal(t, "writer", p.Subject)
+	})
+
+	t.Run("public", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := unary(context.Background(), api.FaceitService_Authenticate_FullMethodName)
+		require.NoError(t, err)
+	})
+
+	t.Run("without rule, authenticated", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := unary(withToken("reader-key"), "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo")
+		require.NoError(t, err)
+
+		_, err = unary(context.Background(), "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo")
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := unary(context.Background(), api.FaceitService_GetUser_FullMethodName)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := unary(withToken("unknown-key"), api.FaceitService_GetUser_FullMethodName)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("insufficient scope", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := unary(withToken("reader-key"), api.FaceitService_AddUser_FullMethodName)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		t.Parallel()
+
+		info := &grpc.StreamServerInfo{FullMethod: api.FaceitService_WatchUsers_FullMethodName, IsServerStream: true}
+
+		var p auth.Principal
+
+		err := i.StreamServerInterceptor(nil, serverStream{ctx: withToken("reader-key")}, info, func(_ any, ss grpc.ServerStream) error {
+			p, _ = auth.PrincipalFromContext(ss.Context())
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "reader", p.Subject)
+
+		err = i.StreamServerInterceptor(nil, serverStream{ctx: withToken("writer-key")}, info, func(_ any, _ grpc.ServerStream) error {
+			return nil
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
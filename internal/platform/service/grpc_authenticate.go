@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/servers"
+	"google.golang.org/grpc/codes"
+)
+
+// tokenTypeBearer is the type of the access tokens, sent in the Authorization header as bearer tokens.
+const tokenTypeBearer = "Bearer"
+
+// Authenticate defines the use case to authenticate a user.
+type Authenticate interface {
+	Authenticate(ctx context.Context, email, password string) (model.Session, error)
+}
+
+// Authenticate authenticates a user by email and password.
+//
+// Receives a request with the credentials of the user. Responses with a session, a short-lived access token and a
+// refresh token.
+func (s *FaceitService) Authenticate(ctx context.Context, req *api.AuthenticateRequest) (*api.Session, error) {
+	ctx = ctxd.AddFields(ctx, "service", "FaceitService")
+
+	// Validate request.
+	val, err := protovalidate.New(
+		protovalidate.WithMessages(
+			&api.AuthenticateRequest{},
+		),
+	)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "create proto validator")
+	}
+
+	fieldMsgErrs, ok := isUserValid(req, val, false)
+	if !ok {
+		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
+	}
+
+	sess, err := s.deps.Authenticate().Authenticate(ctx, req.GetEmail(), req.GetPassword())
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidCredentials) {
+			return nil, servers.WrapError(codes.Unauthenticated, err, "invalid credentials")
+		}
+
+		if errors.Is(err, usecase.ErrAccountLocked) {
+			return nil, servers.WrapError(codes.ResourceExhausted, err, "too many failed logins, try again later")
+		}
+
+		if errors.Is(err, usecase.ErrAccountRestricted) {
+			return nil, servers.WrapError(codes.PermissionDenied, err, "account suspended or banned")
+		}
+
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+
+	return sessionToProto(sess), nil
+}
+
+// sessionToProto converts the session to its proto message, lifetimes in seconds as of now.
+func sessionToProto(sess model.Session) *api.Session {
+	return &api.Session{
+		AccessToken:      sess.AccessToken,
+		TokenType:        tokenTypeBearer,
+		ExpiresIn:        int32(time.Until(sess.AccessTokenExpiresAt).Round(time.Second).Seconds()),
+		RefreshToken:     sess.RefreshToken,
+		RefreshExpiresIn: int32(time.Until(sess.RefreshTokenExpiresAt).Round(time.Second).Seconds()),
+	}
+}
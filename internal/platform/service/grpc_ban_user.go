@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+)
+
+// BanUser bans the user, until the expire time when given.
+//
+// Receives a request with user data id, the reason and an optional expire time. Responses the banned user.
+func (s *FaceitService) BanUser(ctx context.Context, req *api.RestrictUserRequest) (*api.UserProfile, error) {
+	return s.restrictUser(ctx, req, model.StatusBanned)
+}
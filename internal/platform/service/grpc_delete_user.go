@@ -7,6 +7,7 @@ import (
 	"github.com/bool64/ctxd"
 	"github.com/bufbuild/protovalidate-go"
 	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
 	api "github.com/dohernandez/faceit/internal/platform/service/pb"
 	"github.com/dohernandez/go-grpc-service/database"
 	"github.com/dohernandez/servers"
@@ -19,19 +20,20 @@ import (
 
 // DeleteUser defines the use case to delete a user.
 type DeleteUser interface {
-	DeleteUser(ctx context.Context, id model.UserID) error
+	DeleteUser(ctx context.Context, id model.UserID, version int64) error
 }
 
 // DeleteUser delete the user.
 //
-// Receives a request with user data. Responses whether the user was deleted successfully or not.
-func (s *FaceitService) DeleteUser(ctx context.Context, req *api.UserID) (*emptypb.Empty, error) {
+// Receives a request with user data id and an optional etag, from the If-Match header on REST. Responses whether the
+// user was deleted successfully or not, ABORTED when the user changed since the etag.
+func (s *FaceitService) DeleteUser(ctx context.Context, req *api.DeleteUserRequest) (*emptypb.Empty, error) {
 	ctx = ctxd.AddFields(ctx, "service", "FaceitService")
 
 	// Validate request.
 	val, err := protovalidate.New(
 		protovalidate.WithMessages(
-			&api.UserID{},
+			&api.DeleteUserRequest{},
 		),
 	)
 	if err != nil {
@@ -43,13 +45,26 @@ func (s *FaceitService) DeleteUser(ctx context.Context, req *api.UserID) (*empty
 		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
 	}
 
+	version, err := expectedVersion(ctx, req.GetEtag())
+	if err != nil {
+		return nil, servers.WrapError(codes.InvalidArgument, err, "validation error", map[string]string{"etag": err.Error()})
+	}
+
 	id := uuid.MustParse(req.GetId()) // Safe to ignore panic as it was validated before.
 
-	if err = s.deps.DeleteUser().DeleteUser(ctx, id); err != nil {
+	if err = s.deps.DeleteUser().DeleteUser(ctx, id, version); err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			return nil, servers.WrapError(codes.NotFound, err, "user not found")
 		}
 
+		if errors.Is(err, usecase.ErrVersionMismatch) {
+			return nil, servers.WrapError(codes.Aborted, err, "user changed, etag mismatch")
+		}
+
+		if errors.Is(err, usecase.ErrPermissionDenied) {
+			return nil, servers.WrapError(codes.PermissionDenied, err, "permission denied")
+		}
+
 		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
 	}
 
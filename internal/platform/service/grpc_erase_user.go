@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/dohernandez/servers"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// EraseUser defines the use case to erase the personal data of a user.
+type EraseUser interface {
+	EraseUser(ctx context.Context, id model.UserID) (*model.ErasureCertificate, error)
+}
+
+// EraseUser erases the personal data of the user, irreversibly.
+//
+// Receives a request with user data id. Responses the certificate of the erasure.
+func (s *FaceitService) EraseUser(ctx context.Context, req *api.UserID) (*api.ErasureCertificate, error) {
+	ctx = ctxd.AddFields(ctx, "service", "FaceitService")
+
+	// Validate request.
+	val, err := protovalidate.New(
+		protovalidate.WithMessages(
+			&api.UserID{},
+		),
+	)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "create proto validator")
+	}
+
+	fieldMsgErrs, ok := isUserValid(req, val, false)
+	if !ok {
+		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
+	}
+
+	id := uuid.MustParse(req.GetId()) // Safe to ignore panic as it was validated before.
+
+	c, err := s.deps.EraseUser().EraseUser(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, servers.WrapError(codes.NotFound, err, "user not found")
+		}
+
+		if errors.Is(err, usecase.ErrPermissionDenied) {
+			return nil, servers.WrapError(codes.PermissionDenied, err, "permission denied")
+		}
+
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+
+	return &api.ErasureCertificate{
+		Id:        c.ID.String(),
+		UserId:    c.UserID.String(),
+		ErasedBy:  c.ErasedBy,
+		EraseTime: timestamppb.New(c.ErasedAt),
+		Records:   c.Records,
+	}, nil
+}
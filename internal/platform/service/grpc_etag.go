@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// headerETag is the header, and gRPC metadata, bearing the etag of the user read.
+	headerETag = "etag"
+	// headerIfMatch is the header, and gRPC metadata, bearing the etag the mutations are conditioned on.
+	headerIfMatch = "if-match"
+)
+
+// errInvalidETag occurs when the etag given is not one of the etags returned by the service.
+var errInvalidETag = errors.New("invalid etag")
+
+// etagOf returns the etag of the version of the user, empty when the version is unknown.
+func etagOf(version int64) string {
+	if version == 0 {
+		return ""
+	}
+
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// setETag sends the etag of the version of the user in the response header.
+func setETag(ctx context.Context, version int64) {
+	if etag := etagOf(version); etag != "" {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(headerETag, etag)) //nolint:errcheck
+	}
+}
+
+// expectedVersion returns the version of the user a mutation is conditioned on, from the etag of the request, or the
+// If-Match header when not given, 0 when neither is given.
+func expectedVersion(ctx context.Context, etag string) (int64, error) {
+	if etag == "" {
+		if values := metadata.ValueFromIncomingContext(ctx, headerIfMatch); len(values) > 0 {
+			etag = values[0]
+		}
+	}
+
+	etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+
+	if etag == "" || etag == "*" {
+		return 0, nil
+	}
+
+	if unquoted, err := strconv.Unquote(etag); err == nil {
+		etag = unquoted
+	}
+
+	version, err := strconv.ParseInt(etag, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, errInvalidETag
+	}
+
+	return version, nil
+}
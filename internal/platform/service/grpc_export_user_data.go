@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/dohernandez/servers"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ExportUserData defines the use case to export the personal data of a user.
+type ExportUserData interface {
+	ExportUserData(ctx context.Context, id model.UserID) (*model.PersonalData, error)
+}
+
+// ExportUserData exports the personal data of the user.
+//
+// Receives a request with user data id. Responses a JSON archive of every record stored about the user.
+func (s *FaceitService) ExportUserData(ctx context.Context, req *api.UserID) (*httpbody.HttpBody, error) {
+	ctx = ctxd.AddFields(ctx, "service", "FaceitService")
+
+	// Validate request.
+	val, err := protovalidate.New(
+		protovalidate.WithMessages(
+			&api.UserID{},
+		),
+	)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "create proto validator")
+	}
+
+	fieldMsgErrs, ok := isUserValid(req, val, false)
+	if !ok {
+		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
+	}
+
+	id := uuid.MustParse(req.GetId()) // Safe to ignore panic as it was validated before.
+
+	d, err := s.deps.ExportUserData().ExportUserData(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, servers.WrapError(codes.NotFound, err, "user not found")
+		}
+
+		if errors.Is(err, usecase.ErrPermissionDenied) {
+			return nil, servers.WrapError(codes.PermissionDenied, err, "permission denied")
+		}
+
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+
+	archive, err := personalDataToArchive(d)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "archive personal data")
+	}
+
+	data, err := json.Marshal(archive)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "marshal personal data")
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "application/json",
+		Data:        data,
+	}, nil
+}
+
+// personalDataArchive is the JSON archive of the personal data of a user.
+type personalDataArchive struct {
+	User               archivedUser           `json:"user"`
+	Roles              []archivedRole         `json:"roles"`
+	Sessions           []archivedSession      `json:"sessions"`
+	EmailVerifications []archivedToken        `json:"email_verifications"`
+	PasswordResets     []archivedToken        `json:"password_resets"`
+	LoginAttempts      *archivedLoginAttempts `json:"login_attempts,omitempty"`
+	Events             []archivedEvent        `json:"events"`
+	AuditEvents        []archivedAuditEvent   `json:"audit_events"`
+	IdempotencyKeys    []archivedIdempotency  `json:"idempotency_keys"`
+}
+
+type archivedUser struct {
+	ID              string     `json:"id"`
+	Email           string     `json:"email"`
+	FirstName       string     `json:"first_name"`
+	LastName        string     `json:"last_name"`
+	Nickname        string     `json:"nickname"`
+	Country         string     `json:"country"`
+	Status          string     `json:"status"`
+	StatusReason    string     `json:"status_reason,omitempty"`
+	StatusChangedBy string     `json:"status_changed_by,omitempty"`
+	StatusChangedAt *time.Time `json:"status_changed_at,omitempty"`
+	StatusExpiresAt *time.Time `json:"status_expires_at,omitempty"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
+	ErasedAt        *time.Time `json:"erased_at,omitempty"`
+}
+
+type archivedRole struct {
+	Role       string    `json:"role"`
+	AssignedAt time.Time `json:"assigned_at"`
+}
+
+type archivedSession struct {
+	FamilyID  string     `json:"family_id"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+type archivedToken struct {
+	Email     string     `json:"email,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+}
+
+type archivedLoginAttempts struct {
+	Failures    int        `json:"failures"`
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+type archivedEvent struct {
+	ID          string          `json:"id"`
+	Type        string          `json:"type"`
+	Payload     json.RawMessage `json:"payload"`
+	CreatedAt   time.Time       `json:"created_at"`
+	PublishedAt *time.Time      `json:"published_at,omitempty"`
+}
+
+type archivedAuditEvent struct {
+	ID        int64                        `json:"id"`
+	Action    string                       `json:"action"`
+	Actor     string                       `json:"actor"`
+	RequestID string                       `json:"request_id"`
+	SourceIP  string                       `json:"source_ip,omitempty"`
+	Changes   map[string]model.AuditChange `json:"changes"`
+	CreatedAt time.Time                    `json:"created_at"`
+}
+
+type archivedIdempotency struct {
+	Key       string          `json:"key"`
+	Method    string          `json:"method"`
+	Response  json.RawMessage `json:"response"`
+	CreatedAt time.Time       `json:"created_at"`
+	ExpiresAt time.Time       `json:"expires_at"`
+}
+
+// personalDataToArchive maps the personal data to its JSON archive.
+func personalDataToArchive(d *model.PersonalData) (personalDataArchive, error) {
+	a := personalDataArchive{
+		User: archivedUser{
+			ID:              d.User.ID.String(),
+			Email:           d.User.Email,
+			FirstName:       d.User.FirstName,
+			LastName:        d.User.LastName,
+			Nickname:        d.User.Nickname,
+			Country:         d.User.Country,
+			Status:          string(d.User.Status),
+			StatusReason:    d.User.StatusReason,
+			StatusChangedBy: d.User.StatusChangedBy,
+			StatusChangedAt: d.User.StatusChangedAt,
+			StatusExpiresAt: d.User.StatusExpiresAt,
+			EmailVerifiedAt: d.User.EmailVerifiedAt,
+			CreatedAt:       d.User.CreatedAt,
+			UpdatedAt:       d.User.UpdatedAt,
+			DeletedAt:       d.User.DeletedAt,
+			ErasedAt:        d.User.ErasedAt,
+		},
+		Roles:              make([]archivedRole, 0, len(d.Roles)),
+		Sessions:           make([]archivedSession, 0, len(d.Sessions)),
+		EmailVerifications: tokensToArchive(d.Verifications),
+		PasswordResets:     tokensToArchive(d.PasswordResets),
+		Events:             make([]archivedEvent, 0, len(d.Events)),
+		AuditEvents:        make([]archivedAuditEvent, 0, len(d.AuditEntries)),
+		IdempotencyKeys:    make([]archivedIdempotency, 0, len(d.IdempotencyKeys)),
+	}
+
+	for _, r := range d.Roles {
+		a.Roles = append(a.Roles, archivedRole{Role: string(r.Role), AssignedAt: r.AssignedAt})
+	}
+
+	for _, s := range d.Sessions {
+		a.Sessions = append(a.Sessions, archivedSession{
+			FamilyID:  s.FamilyID.String(),
+			CreatedAt: s.CreatedAt,
+			ExpiresAt: s.ExpiresAt,
+			RevokedAt: s.RevokedAt,
+		})
+	}
+
+	if l := d.LoginAttempts; l != nil {
+		a.LoginAttempts = &archivedLoginAttempts{
+			Failures:    l.Failures,
+			LockedUntil: l.LockedUntil,
+			UpdatedAt:   l.UpdatedAt,
+		}
+	}
+
+	for _, e := range d.Events {
+		a.Events = append(a.Events, archivedEvent{
+			ID:          e.ID.String(),
+			Type:        e.Type,
+			Payload:     e.Payload,
+			CreatedAt:   e.CreatedAt,
+			PublishedAt: e.PublishedAt,
+		})
+	}
+
+	for _, e := range d.AuditEntries {
+		a.AuditEvents = append(a.AuditEvents, archivedAuditEvent{
+			ID:        e.ID,
+			Action:    string(e.Action),
+			Actor:     e.Actor,
+			RequestID: e.RequestID,
+			SourceIP:  e.SourceIP,
+			Changes:   e.Changes,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+
+	for _, k := range d.IdempotencyKeys {
+		// Responses are stored as protobuf messages wrapped in an Any, archived as their JSON mapping.
+		var msg anypb.Any
+
+		if err := proto.Unmarshal(k.Response, &msg); err != nil {
+			return personalDataArchive{}, fmt.Errorf("unmarshal response of idempotency key %s: %w", k.Key, err)
+		}
+
+		response, err := protojson.Marshal(&msg)
+		if err != nil {
+			return personalDataArchive{}, fmt.Errorf("marshal response of idempotency key %s: %w", k.Key, err)
+		}
+
+		a.IdempotencyKeys = append(a.IdempotencyKeys, archivedIdempotency{
+			Key:       k.Key,
+			Method:    k.Method,
+			Response:  response,
+			CreatedAt: k.CreatedAt,
+			ExpiresAt: k.ExpiresAt,
+		})
+	}
+
+	return a, nil
+}
+
+func tokensToArchive(tokens []model.TokenRecord) []archivedToken {
+	list := make([]archivedToken, 0, len(tokens))
+
+	for _, t := range tokens {
+		list = append(list, archivedToken{
+			Email:     t.Email,
+			CreatedAt: t.CreatedAt,
+			ExpiresAt: t.ExpiresAt,
+			UsedAt:    t.UsedAt,
+		})
+	}
+
+	return list
+}
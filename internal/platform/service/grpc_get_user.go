@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/dohernandez/servers"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// GetUser defines the use case to get a user.
+type GetUser interface {
+	GetUser(ctx context.Context, id model.UserID) (*model.User, error)
+}
+
+// GetUser get the user.
+//
+// Receives a request with user data id and an optional read mask. Responses with the user, without credentials.
+func (s *FaceitService) GetUser(ctx context.Context, req *api.GetUserRequest) (*api.UserProfile, error) {
+	ctx = ctxd.AddFields(ctx, "service", "FaceitService")
+
+	// Validate request.
+	val, err := protovalidate.New(
+		protovalidate.WithMessages(
+			&api.GetUserRequest{},
+		),
+	)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "create proto validator")
+	}
+
+	fieldMsgErrs, ok := isUserValid(req, val, false)
+
+	fieldMsgErrs, maskOK := isReadMaskValid(fieldMsgErrs, req.GetReadMask())
+	if !ok || !maskOK {
+		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
+	}
+
+	id := uuid.MustParse(req.GetId()) // Safe to ignore panic as it was validated before.
+
+	u, err := s.deps.GetUser().GetUser(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, servers.WrapError(codes.NotFound, err, "user not found")
+		}
+
+		if errors.Is(err, usecase.ErrPermissionDenied) {
+			return nil, servers.WrapError(codes.PermissionDenied, err, "permission denied")
+		}
+
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+
+	setETag(ctx, u.Version)
+
+	return userToProto(u, req.GetReadMask()), nil
+}
@@ -0,0 +1,96 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/platform/service"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+type getUser func(ctx context.Context, id model.UserID) (*model.User, error)
+
+func (g getUser) GetUser(ctx context.Context, id model.UserID) (*model.User, error) {
+	return g(ctx, id)
+}
+
+func (d deps) GetUser() service.GetUser {
+	return d.getUser
+}
+
+func TestFaceitService_GetUser(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+	srv := service.NewFaceitService(deps{getUser: func(_ context.Context, _ model.UserID) (*model.User, error) {
+		return &model.User{
+			ID: id,
+			UserState: model.UserState{
+				PasswordHash: "f6b7e19e0d867de6c0391879050e8297165728d89d7c4e9e8839972b356c4d9d",
+				Email:        "alice@bob.com",
+				FirstName:    "Alice",
+				LastName:     "Bob",
+				Country:      "UK",
+			},
+			UserStatusChange: model.UserStatusChange{
+				Status:       model.StatusSuspended,
+				StatusReason: "Spam",
+			},
+		}, nil
+	}})
+
+	t.Run("without credentials", func(t *testing.T) {
+		t.Parallel()
+
+		u, err := srv.GetUser(context.Background(), &api.GetUserRequest{Id: id.String()})
+		require.NoError(t, err)
+
+		require.True(t, proto.Equal(&api.UserProfile{
+			Id:        id.String(),
+			FirstName: proto.String("Alice"),
+			LastName:  proto.String("Bob"),
+			Nickname:  proto.String(""),
+			Email:     proto.String("alice@bob.com"),
+			Country:   proto.String("UK"),
+
+			EmailVerified: proto.Bool(false),
+			Status:        proto.String("suspended"),
+			StatusReason:  proto.String("Spam"),
+		}, u), u.String())
+	})
+
+	t.Run("read mask", func(t *testing.T) {
+		t.Parallel()
+
+		u, err := srv.GetUser(context.Background(), &api.GetUserRequest{
+			Id:       id.String(),
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"id", "first_name", "country"}},
+		})
+		require.NoError(t, err)
+
+		require.True(t, proto.Equal(&api.UserProfile{
+			Id:        id.String(),
+			FirstName: proto.String("Alice"),
+			Country:   proto.String("UK"),
+		}, u), u.String())
+	})
+
+	t.Run("invalid read mask", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := srv.GetUser(context.Background(), &api.GetUserRequest{
+			Id:       id.String(),
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"first_name", "password_hash"}},
+		})
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/dohernandez/servers"
+	"github.com/google/uuid"
+	"github.com/nhatthm/go-clock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// headerIdempotencyKey is the header, and gRPC metadata, bearing the key the client identifies a mutation with.
+	headerIdempotencyKey = "idempotency-key"
+	// headerIdempotentReplayed is the header, and gRPC metadata, flagging the responses replayed.
+	headerIdempotentReplayed = "idempotent-replayed"
+
+	// maxIdempotencyKeyLength is the maximum number of characters of the idempotency keys.
+	maxIdempotencyKeyLength = 255
+)
+
+// idempotentMethods are the methods of the service retried safely with an idempotency key, by full method name.
+var idempotentMethods = map[string]bool{
+	api.FaceitService_AddUser_FullMethodName:    true,
+	api.FaceitService_UpdateUser_FullMethodName: true,
+	api.FaceitService_DeleteUser_FullMethodName: true,
+}
+
+// errNotProtoMessage occurs when the request or the response of a method is not a protobuf message.
+var errNotProtoMessage = errors.New("not a proto message")
+
+// IdempotencyConfig represents the configuration of the idempotency keys.
+type IdempotencyConfig struct {
+	// TTL is the time the outcome of a mutation is replayed for, to the retries with its idempotency key.
+	TTL time.Duration `default:"24h"`
+	// LockTimeout is the time a mutation in progress holds its key, a retry takes the key over once it lapses. It must
+	// outlast the mutations, the keys of the mutations abandoned, e.g. on a crash, are held until then.
+	LockTimeout time.Duration `split_words:"true" default:"1m"`
+}
+
+// IdempotencyStore defines functionality to store the idempotency keys and the outcome of their mutations.
+type IdempotencyStore interface {
+	ReserveIdempotencyKey(ctx context.Context, k *storage.IdempotencyKey) (bool, error)
+	FindIdempotencyKey(ctx context.Context, subject, key string) (*storage.IdempotencyKey, error)
+	CompleteIdempotencyKey(ctx context.Context, subject, key string, userID model.UserID, response, header []byte) error
+	ReleaseIdempotencyKey(ctx context.Context, subject, key string) error
+}
+
+// IdempotencyInterceptor replays the outcome of the mutations to their retries, the calls bearing the same
+// idempotency-key metadata, the Idempotency-Key header on REST.
+//
+// Keys are scoped by the actor, and bound to the request they were first given with: the method, the message and the
+// If-Match metadata. A key given with another request is rejected with FAILED_PRECONDITION, and while the mutation
+// is in progress with UNAVAILABLE. Only the mutations succeeding are replayed, the key of a failed mutation is
+// released so the mutation can be retried with it. The key of a mutation abandoned in progress, failing to be
+// released or completed, is taken over by a retry once its lock times out.
+type IdempotencyInterceptor struct {
+	store   IdempotencyStore
+	clock   clock.Clock
+	cfg     IdempotencyConfig
+	methods map[string]bool
+	logger  ctxd.Logger
+}
+
+// NewIdempotencyInterceptor creates a new IdempotencyInterceptor with the idempotent methods of the service.
+func NewIdempotencyInterceptor(store IdempotencyStore, clk clock.Clock, cfg IdempotencyConfig, logger ctxd.Logger) *IdempotencyInterceptor {
+	return &IdempotencyInterceptor{
+		store:   store,
+		clock:   clk,
+		cfg:     cfg,
+		methods: idempotentMethods,
+		logger:  logger,
+	}
+}
+
+// UnaryServerInterceptor replays the outcome of the unary calls retried with an idempotency key.
+//
+// Must run after the authorization, the keys are scoped by the actor of the call.
+func (i *IdempotencyInterceptor) UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !i.methods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	var key string
+
+	if values := metadata.ValueFromIncomingContext(ctx, headerIdempotencyKey); len(values) > 0 {
+		key = values[0]
+	}
+
+	actor, ok := usecase.ActorFromContext(ctx)
+	if key == "" || !ok {
+		return handler(ctx, req)
+	}
+
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, servers.Error(codes.InvalidArgument, "validation error", map[string]string{
+			"idempotency_key": fmt.Sprintf("must have at most %d characters", maxIdempotencyKeyLength),
+		})
+	}
+
+	ctx = ctxd.AddFields(ctx, "idempotency_key", key)
+
+	fp, err := fingerprint(ctx, info.FullMethod, req)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+
+	now := i.clock.Now().UTC()
+
+	k := &storage.IdempotencyKey{
+		Subject:     actor.Subject,
+		Key:         key,
+		Method:      info.FullMethod,
+		Fingerprint: fp,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(i.cfg.TTL),
+		LockedUntil: now.Add(i.cfg.LockTimeout),
+	}
+
+	reserved, err := i.store.ReserveIdempotencyKey(ctx, k)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+
+	if !reserved {
+		return i.replay(ctx, k)
+	}
+
+	header := &headerRecorder{ServerTransportStream: grpc.ServerTransportStreamFromContext(ctx), method: info.FullMethod}
+
+	resp, err := handler(grpc.NewContextWithServerTransportStream(ctx, header), req)
+	if err != nil {
+		if rErr := i.store.ReleaseIdempotencyKey(ctx, k.Subject, k.Key); rErr != nil {
+			i.logger.Error(ctx, "release idempotency key", "error", rErr)
+		}
+
+		return nil, err
+	}
+
+	// The mutation is done, failing to store its outcome leaves the key in progress until its lock times out, the
+	// mutation is performed again by a retry taking it over.
+	if err := i.complete(ctx, k, req, resp, header.md); err != nil {
+		i.logger.Error(ctx, "complete idempotency key", "error", err)
+	}
+
+	return resp, nil
+}
+
+// complete stores the outcome of the mutation of the key, along the user it acted on, so the response is exported
+// and erased with the personal data of the user.
+func (i *IdempotencyInterceptor) complete(ctx context.Context, k *storage.IdempotencyKey, req, resp any, md metadata.MD) error {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return fmt.Errorf("%w: %T", errNotProtoMessage, resp)
+	}
+
+	a, err := anypb.New(msg)
+	if err != nil {
+		return fmt.Errorf("wrap response: %w", err)
+	}
+
+	response, err := proto.Marshal(a)
+	if err != nil {
+		return fmt.Errorf("marshal response: %w", err)
+	}
+
+	header, err := json.Marshal(md)
+	if err != nil {
+		return fmt.Errorf("marshal header: %w", err)
+	}
+
+	return i.store.CompleteIdempotencyKey(ctx, k.Subject, k.Key, mutatedUserID(req, resp), response, header)
+}
+
+// mutatedUserID returns the id of the user the mutation acted on, the id of the response, the user added or updated,
+// or of the request, uuid.Nil when unknown.
+func mutatedUserID(req, resp any) model.UserID {
+	for _, msg := range []any{resp, req} {
+		if m, ok := msg.(interface{ GetId() string }); ok {
+			if id, err := uuid.Parse(m.GetId()); err == nil {
+				return id
+			}
+		}
+	}
+
+	return uuid.Nil
+}
+
+// replay returns the outcome of the mutation of the stored key, sending its header metadata along.
+func (i *IdempotencyInterceptor) replay(ctx context.Context, k *storage.IdempotencyKey) (any, error) {
+	stored, err := i.store.FindIdempotencyKey(ctx, k.Subject, k.Key)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, servers.WrapError(codes.Unavailable, err, "request with the idempotency key in progress")
+		}
+
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+
+	if stored.Method != k.Method || stored.Fingerprint != k.Fingerprint {
+		return nil, servers.Error(codes.FailedPrecondition, "idempotency key already used with another request")
+	}
+
+	if len(stored.Response) == 0 {
+		return nil, servers.Error(codes.Unavailable, "request with the idempotency key in progress")
+	}
+
+	var a anypb.Any
+
+	if err := proto.Unmarshal(stored.Response, &a); err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+
+	resp, err := a.UnmarshalNew()
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+
+	md := metadata.MD{}
+
+	if len(stored.Header) > 0 {
+		if err := json.Unmarshal(stored.Header, &md); err != nil {
+			return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+		}
+	}
+
+	md.Set(headerIdempotentReplayed, "true")
+
+	_ = grpc.SetHeader(ctx, md) //nolint:errcheck
+
+	return resp, nil
+}
+
+// fingerprint returns the hex encoded SHA-256 of the request of the method, along with the If-Match metadata the
+// mutation is conditioned on.
+func fingerprint(ctx context.Context, method string, req any) (string, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return "", fmt.Errorf("%w: %T", errNotProtoMessage, req)
+	}
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", fmt.Errorf("marshal request: %w", err)
+	}
+
+	h := sha256.New()
+
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+
+	for _, v := range metadata.ValueFromIncomingContext(ctx, headerIfMatch) {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+
+	h.Write(b)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// headerRecorder records the header metadata set by the handler of a call, sending it along when the call has a
+// transport stream.
+type headerRecorder struct {
+	grpc.ServerTransportStream
+
+	method string
+	md     metadata.MD
+}
+
+// Method returns the full method name of the call.
+func (r *headerRecorder) Method() string {
+	return r.method
+}
+
+// SetHeader records the header metadata, and sets it to the stream.
+func (r *headerRecorder) SetHeader(md metadata.MD) error {
+	r.md = metadata.Join(r.md, md)
+
+	if r.ServerTransportStream == nil {
+		return nil
+	}
+
+	return r.ServerTransportStream.SetHeader(md)
+}
+
+// SendHeader records the header metadata, and sends it to the stream.
+func (r *headerRecorder) SendHeader(md metadata.MD) error {
+	r.md = metadata.Join(r.md, md)
+
+	if r.ServerTransportStream == nil {
+		return nil
+	}
+
+	return r.ServerTransportStream.SendHeader(md)
+}
+
+// SetTrailer sets the trailer metadata to the stream.
+func (r *headerRecorder) SetTrailer(md metadata.MD) error {
+	if r.ServerTransportStream == nil {
+		return nil
+	}
+
+	return r.ServerTransportStream.SetTrailer(md)
+}
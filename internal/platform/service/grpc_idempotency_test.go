@@ -0,0 +1,379 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/service"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// idempotencyStore is an in-memory service.IdempotencyStore.
+type idempotencyStore struct {
+	mu   sync.Mutex
+	keys map[string]storage.IdempotencyKey
+}
+
+func (s *idempotencyStore) ReserveIdempotencyKey(_ context.Context, k *storage.IdempotencyKey) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.keys[k.Subject+"/"+k.Key]
+	if ok && stored.ExpiresAt.After(k.CreatedAt) && (len(stored.Response) > 0 || stored.LockedUntil.After(k.CreatedAt)) {
+		return false, nil
+	}
+
+	s.keys[k.Subject+"/"+k.Key] = *k
+
+	return true, nil
+}
+
+func (s *idempotencyStore) FindIdempotencyKey(_ context.Context, subject, key string) (*storage.IdempotencyKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k, ok := s.keys[subject+"/"+key]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+
+	return &k, nil
+}
+
+func (s *idempotencyStore) CompleteIdempotencyKey(_ context.Context, subject, key string, userID model.UserID, response, header []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k := s.keys[subject+"/"+key]
+	k.UserID = uuid.NullUUID{UUID: userID, Valid: userID != uuid.Nil}
+	k.Response = response
+	k.Header = header
+	s.keys[subject+"/"+key] = k
+
+	return nil
+}
+
+func (s *idempotencyStore) ReleaseIdempotencyKey(_ context.Context, subject, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.keys, subject+"/"+key)
+
+	return nil
+}
+
+// transportStream is a grpc.ServerTransportStream recording the header metadata set.
+type transportStream struct {
+	grpc.ServerTransportStream
+
+	header metadata.MD
+}
+
+func (s *transportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+
+	return nil
+}
+
+func TestIdempotencyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 12, 29, 9, 0, 0, 0, time.UTC)
+
+	cfg := service.IdempotencyConfig{TTL: time.Hour, LockTimeout: time.Minute}
+
+	newInterceptor := func(at time.Time) (*service.IdempotencyInterceptor, *idempotencyStore) {
+		store := &idempotencyStore{keys: map[string]storage.IdempotencyKey{}}
+
+		return service.NewIdempotencyInterceptor(store, clock.Fix(at), cfg, &ctxd.LoggerMock{}), store
+	}
+
+	withKey := func(key string, kv ...string) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(append([]string{"idempotency-key", key}, kv...)...))
+
+		return usecase.ContextWithActor(ctx, model.Actor{Subject: "backoffice"})
+	}
+
+	// call calls the interceptor, the handler counting its calls, returning the response and the header metadata set.
+	call := func(ctx context.Context, i *service.IdempotencyInterceptor, req proto.Message, calls *int, err error) (any, metadata.MD, error) {
+		stream := &transportStream{}
+
+		resp, rErr := i.UnaryServerInterceptor(
+			grpc.NewContextWithServerTransportStream(ctx, stream),
+			req,
+			&grpc.UnaryServerInfo{FullMethod: api.FaceitService_AddUser_FullMethodName},
+			func(ctx context.Context, _ any) (any, error) {
+				*calls++
+
+				if err != nil {
+					return nil, err
+				}
+
+				_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "204")) //nolint:errcheck
+
+				return &emptypb.Empty{}, nil
+			},
+		)
+
+		return resp, stream.header, rErr
+	}
+
+	alice := &api.User{FirstName: proto.String("Alice"), Email: proto.String("alice@bob.com")}
+
+	t.Run("replayed", func(t *testing.T) {
+		t.Parallel()
+
+		i, _ := newInterceptor(now)
+
+		var calls int
+
+		resp, header, err := call(withKey("add-alice"), i, alice, &calls, nil)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(&emptypb.Empty{}, resp.(proto.Message)))
+		require.Equal(t, []string{"204"}, header.Get("x-http-code"))
+		require.Empty(t, header.Get("idempotent-replayed"))
+
+		resp, header, err = call(withKey("add-alice"), i, alice, &calls, nil)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(&emptypb.Empty{}, resp.(proto.Message)))
+		require.Equal(t, []string{"204"}, header.Get("x-http-code"))
+		require.Equal(t, []string{"true"}, header.Get("idempotent-replayed"))
+
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("bound to the user mutated", func(t *testing.T) {
+		t.Parallel()
+
+		i, store := newInterceptor(now)
+
+		var calls int
+
+		// The response is erased along with the personal data of the user it holds.
+		id := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+		_, _, err := call(withKey("update-alice"), i, &api.User{Id: id.String(), FirstName: proto.String("Alice")}, &calls, nil)
+		require.NoError(t, err)
+
+		k, err := store.FindIdempotencyKey(context.Background(), "backoffice", "update-alice")
+		require.NoError(t, err)
+		require.Equal(t, uuid.NullUUID{UUID: id, Valid: true}, k.UserID)
+
+		_, _, err = call(withKey("add-alice"), i, alice, &calls, nil)
+		require.NoError(t, err)
+
+		k, err = store.FindIdempotencyKey(context.Background(), "backoffice", "add-alice")
+		require.NoError(t, err)
+		require.False(t, k.UserID.Valid)
+	})
+
+	t.Run("another request", func(t *testing.T) {
+		t.Parallel()
+
+		i, _ := newInterceptor(now)
+
+		var calls int
+
+		_, _, err := call(withKey("add-alice"), i, alice, &calls, nil)
+		require.NoError(t, err)
+
+		_, _, err = call(withKey("add-alice"), i, &api.User{FirstName: proto.String("Carol")}, &calls, nil)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		// The If-Match metadata the mutation is conditioned on is part of the request.
+		_, _, err = call(withKey("add-alice", "if-match", `"3"`), i, alice, &calls, nil)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("in progress", func(t *testing.T) {
+		t.Parallel()
+
+		i, _ := newInterceptor(now)
+
+		var (
+			retries  int
+			retryErr error
+		)
+
+		_, err := i.UnaryServerInterceptor(
+			withKey("add-alice"),
+			alice,
+			&grpc.UnaryServerInfo{FullMethod: api.FaceitService_AddUser_FullMethodName},
+			func(_ context.Context, _ any) (any, error) {
+				// Retried before the mutation is done.
+				_, _, retryErr = call(withKey("add-alice"), i, alice, &retries, nil)
+
+				return &emptypb.Empty{}, nil
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, codes.Unavailable, status.Code(retryErr))
+
+		require.Equal(t, 0, retries)
+	})
+
+	t.Run("failed, released", func(t *testing.T) {
+		t.Parallel()
+
+		i, _ := newInterceptor(now)
+
+		var calls int
+
+		_, _, err := call(withKey("add-alice"), i, alice, &calls, status.Error(codes.Unavailable, "database down"))
+		require.Equal(t, codes.Unavailable, status.Code(err))
+
+		_, header, err := call(withKey("add-alice"), i, alice, &calls, nil)
+		require.NoError(t, err)
+		require.Empty(t, header.Get("idempotent-replayed"))
+
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("abandoned, taken over once the lock times out", func(t *testing.T) {
+		t.Parallel()
+
+		store := &idempotencyStore{keys: map[string]storage.IdempotencyKey{}}
+
+		// The key of the failed mutation is not released, left in progress as on a crash.
+		i := service.NewIdempotencyInterceptor(unreleasingIdempotencyStore{store}, clock.Fix(now), cfg, &ctxd.LoggerMock{})
+
+		var calls int
+
+		_, _, err := call(withKey("add-alice"), i, alice, &calls, status.Error(codes.Unavailable, "database down"))
+		require.Equal(t, codes.Unavailable, status.Code(err))
+
+		_, _, err = call(withKey("add-alice"), i, alice, &calls, nil)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Equal(t, 1, calls)
+
+		later := service.NewIdempotencyInterceptor(store, clock.Fix(now.Add(time.Minute)), cfg, &ctxd.LoggerMock{})
+
+		_, header, err := call(withKey("add-alice"), later, alice, &calls, nil)
+		require.NoError(t, err)
+		require.Empty(t, header.Get("idempotent-replayed"))
+		require.Equal(t, 2, calls)
+
+		// Done, the outcome is replayed from then on.
+		_, header, err = call(withKey("add-alice"), later, alice, &calls, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"true"}, header.Get("idempotent-replayed"))
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		t.Parallel()
+
+		i, store := newInterceptor(now)
+
+		var calls int
+
+		_, _, err := call(withKey("add-alice"), i, alice, &calls, nil)
+		require.NoError(t, err)
+
+		later := service.NewIdempotencyInterceptor(store, clock.Fix(now.Add(time.Hour)), cfg, &ctxd.LoggerMock{})
+
+		_, header, err := call(withKey("add-alice"), later, alice, &calls, nil)
+		require.NoError(t, err)
+		require.Empty(t, header.Get("idempotent-replayed"))
+
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("scoped by actor", func(t *testing.T) {
+		t.Parallel()
+
+		i, _ := newInterceptor(now)
+
+		var calls int
+
+		_, _, err := call(withKey("add-alice"), i, alice, &calls, nil)
+		require.NoError(t, err)
+
+		ctx := usecase.ContextWithActor(withKey("add-alice"), model.Actor{Subject: "support"})
+
+		_, _, err = call(ctx, i, &api.User{FirstName: proto.String("Carol")}, &calls, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("without key", func(t *testing.T) {
+		t.Parallel()
+
+		i, _ := newInterceptor(now)
+
+		var calls int
+
+		ctx := usecase.ContextWithActor(context.Background(), model.Actor{Subject: "backoffice"})
+
+		for range 2 {
+			_, _, err := call(ctx, i, alice, &calls, nil)
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("key too long", func(t *testing.T) {
+		t.Parallel()
+
+		i, _ := newInterceptor(now)
+
+		var calls int
+
+		_, _, err := call(withKey(string(make([]byte, 256))), i, alice, &calls, nil)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		require.Equal(t, 0, calls)
+	})
+
+	t.Run("store failed", func(t *testing.T) {
+		t.Parallel()
+
+		i := service.NewIdempotencyInterceptor(failingIdempotencyStore{}, clock.Fix(now), cfg, &ctxd.LoggerMock{})
+
+		var calls int
+
+		_, _, err := call(withKey("add-alice"), i, alice, &calls, nil)
+		require.Equal(t, codes.Internal, status.Code(err))
+
+		require.Equal(t, 0, calls)
+	})
+}
+
+// failingIdempotencyStore is a service.IdempotencyStore failing to reserve the keys.
+type failingIdempotencyStore struct {
+	service.IdempotencyStore
+}
+
+func (failingIdempotencyStore) ReserveIdempotencyKey(context.Context, *storage.IdempotencyKey) (bool, error) {
+	return false, errors.New("database down")
+}
+
+// unreleasingIdempotencyStore is a service.IdempotencyStore failing to release the keys.
+type unreleasingIdempotencyStore struct {
+	service.IdempotencyStore
+}
+
+func (unreleasingIdempotencyStore) ReleaseIdempotencyKey(context.Context, string, string) error {
+	return errors.New("database down")
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/servers"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListUserAuditEvents defines the use case to list the audit log of the user mutations.
+type ListUserAuditEvents interface {
+	ListUserAuditEvents(ctx context.Context, f model.AuditFilter, limit uint64, after model.Cursor) (model.AuditPage, error)
+}
+
+// ListUserAuditEvents list the audit log of the user mutations.
+//
+// Receives a request with an optional user id, actor and time range. Responses with a list of audit events, the
+// latest first.
+func (s *FaceitService) ListUserAuditEvents(ctx context.Context, req *api.ListUserAuditEventsRequest) (*api.UserAuditEventList, error) {
+	ctx = ctxd.AddFields(ctx, "service", "FaceitService")
+
+	// Validate request.
+	val, err := protovalidate.New(
+		protovalidate.WithMessages(
+			&api.ListUserAuditEventsRequest{},
+		),
+	)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "create proto validator", nil)
+	}
+
+	fieldMsgErrs, ok := isUserValid(req, val, false)
+	if !ok {
+		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
+	}
+
+	f, fieldMsgErrs := auditFilterFromProto(req)
+	if len(fieldMsgErrs) > 0 {
+		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
+	}
+
+	params := []string{req.GetUserId(), req.GetActor(), f.Since.Format(time.RFC3339Nano), f.Until.Format(time.RFC3339Nano)}
+
+	// Decode page token, bound to the request parameters.
+	after, err := s.deps.PageTokens().Decode(req.GetPageToken(), append([]string{"ListUserAuditEvents"}, params...)...)
+	if err != nil {
+		return nil, servers.WrapError(codes.InvalidArgument, err, "invalid page token")
+	}
+
+	limit := req.GetPageSize()
+
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	// List audit events.
+	page, err := s.deps.ListUserAuditEvents().ListUserAuditEvents(ctx, f, limit, after)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidPageToken):
+			return nil, servers.WrapError(codes.InvalidArgument, err, "invalid page token")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			return nil, servers.WrapError(codes.PermissionDenied, err, "permission denied")
+		default:
+			return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+		}
+	}
+
+	events := make([]*api.UserAuditEvent, 0, len(page.Entries))
+
+	for _, e := range page.Entries {
+		events = append(events, auditEntryToProto(e))
+	}
+
+	return &api.UserAuditEventList{
+		AuditEvents:   events,
+		NextPageToken: s.deps.PageTokens().Encode(page.Next, append([]string{"ListUserAuditEvents"}, params...)...),
+	}, nil
+}
+
+// auditFilterFromProto maps the request to the filter of the audit entries, with the errors of the invalid fields.
+func auditFilterFromProto(req *api.ListUserAuditEventsRequest) (model.AuditFilter, map[string]string) {
+	f := model.AuditFilter{Actor: req.GetActor()}
+	fieldMsgErrs := make(map[string]string)
+
+	if req.GetUserId() != "" {
+		f.UserID = uuid.MustParse(req.GetUserId()) // Safe to ignore panic as it was validated before.
+	}
+
+	if req.StartTime != nil {
+		if err := req.GetStartTime().CheckValid(); err != nil {
+			fieldMsgErrs["start_time"] = err.Error()
+		}
+
+		f.Since = req.GetStartTime().AsTime()
+	}
+
+	if req.EndTime != nil {
+		if err := req.GetEndTime().CheckValid(); err != nil {
+			fieldMsgErrs["end_time"] = err.Error()
+		}
+
+		f.Until = req.GetEndTime().AsTime()
+	}
+
+	if !f.Since.IsZero() && !f.Until.IsZero() && !f.Until.After(f.Since) {
+		fieldMsgErrs["end_time"] = "must be after start_time"
+	}
+
+	return f, fieldMsgErrs
+}
+
+func auditEntryToProto(e *model.AuditEntry) *api.UserAuditEvent {
+	changes := make(map[string]*api.AuditChange, len(e.Changes))
+
+	for attr, c := range e.Changes {
+		changes[attr] = &api.AuditChange{Before: c.Before, After: c.After}
+	}
+
+	return &api.UserAuditEvent{
+		Id:         e.ID,
+		UserId:     e.UserID.String(),
+		Action:     string(e.Action),
+		Actor:      e.Actor,
+		RequestId:  e.RequestID,
+		SourceIp:   e.SourceIP,
+		Changes:    changes,
+		CreateTime: timestamppb.New(e.CreatedAt),
+	}
+}
@@ -2,12 +2,12 @@ package service
 
 import (
 	"context"
-	"fmt"
-	"strconv"
+	"errors"
 
 	"github.com/bool64/ctxd"
 	"github.com/bufbuild/protovalidate-go"
 	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
 	api "github.com/dohernandez/faceit/internal/platform/service/pb"
 	"github.com/dohernandez/servers"
 	"google.golang.org/grpc/codes"
@@ -19,7 +19,7 @@ const defaultLimit = 100
 
 // ListUsersByCountry defines the use case to list users by country.
 type ListUsersByCountry interface {
-	ListUsersByCountry(ctx context.Context, country string, limit, offset uint64) ([]*model.User, error)
+	ListUsersByCountry(ctx context.Context, country string, limit uint64, after model.Cursor) (model.UserPage, error)
 }
 
 // ListUsersByCountry list users by country.
@@ -39,19 +39,16 @@ func (s *FaceitService) ListUsersByCountry(ctx context.Context, req *api.UsersBy
 	}
 
 	fieldMsgErrs, ok := isUserValid(req, val, false)
-	if !ok {
+
+	fieldMsgErrs, maskOK := isReadMaskValid(fieldMsgErrs, req.GetReadMask())
+	if !ok || !maskOK {
 		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
 	}
 
-	// Parse page token
-	var offset uint64
-
-	if pageToken := req.GetPageToken(); pageToken != "" {
-		// Convert the page token to an uint64.
-		_, err = fmt.Sscanf(pageToken, "%d", &offset)
-		if err != nil {
-			return nil, servers.WrapError(codes.InvalidArgument, err, "parse page token")
-		}
+	// Decode page token, bound to the request parameters.
+	after, err := s.deps.PageTokens().Decode(req.GetPageToken(), "ListUsersByCountry", req.GetCountry())
+	if err != nil {
+		return nil, servers.WrapError(codes.InvalidArgument, err, "invalid page token")
 	}
 
 	limit := req.GetPageSize()
@@ -61,35 +58,21 @@ func (s *FaceitService) ListUsersByCountry(ctx context.Context, req *api.UsersBy
 	}
 
 	// List users by country.
-	users, err := s.deps.ListUsersByCountry().ListUsersByCountry(ctx, req.GetCountry(), limit, offset)
+	page, err := s.deps.ListUsersByCountry().ListUsersByCountry(ctx, req.GetCountry(), limit, after)
 	if err != nil {
-		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
-	}
-
-	// Prepare next page token
-	nextPageToken := ""
-
-	if len(users) == int(limit) { //nolint:gosec // It is safe to ignore as it is not a security issue
-		nextPageToken = strconv.FormatUint(offset+limit, 10)
-	}
+		if errors.Is(err, usecase.ErrInvalidPageToken) {
+			return nil, servers.WrapError(codes.InvalidArgument, err, "invalid page token")
+		}
 
-	// Map users to response users.
-	list := make([]*api.User, 0, len(users))
+		if errors.Is(err, usecase.ErrPermissionDenied) {
+			return nil, servers.WrapError(codes.PermissionDenied, err, "permission denied")
+		}
 
-	for _, u := range users {
-		list = append(list, &api.User{
-			Id:           u.ID.String(),
-			PasswordHash: &u.PasswordHash,
-			Email:        &u.Email,
-			FirstName:    &u.FirstName,
-			LastName:     &u.LastName,
-			Nickname:     &u.Nickname,
-			Country:      &u.Country,
-		})
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
 	}
 
 	return &api.UserList{
-		Users:         list,
-		NextPageToken: nextPageToken,
+		Users:         usersToProto(page.Users, req.GetReadMask()),
+		NextPageToken: s.deps.PageTokens().Encode(page.Next, "ListUsersByCountry", req.GetCountry()),
 	}, nil
 }
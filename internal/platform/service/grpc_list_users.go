@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/servers"
+	"google.golang.org/grpc/codes"
+)
+
+// ListUsers defines the use case to list users.
+type ListUsers interface {
+	ListUsers(ctx context.Context, filter, orderBy string, limit uint64, after model.Cursor) (model.UserPage, error)
+}
+
+// ListUsers list the users matching the filter.
+//
+// Receives a request with an AIP-160 filter and an AIP-132 order by. Responses with a list of users.
+func (s *FaceitService) ListUsers(ctx context.Context, req *api.ListUsersRequest) (*api.UserList, error) {
+	ctx = ctxd.AddFields(ctx, "service", "FaceitService")
+
+	// Validate request.
+	val, err := protovalidate.New(
+		protovalidate.WithMessages(
+			&api.ListUsersRequest{},
+		),
+	)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "create proto validator", nil)
+	}
+
+	fieldMsgErrs, ok := validate(req, val)
+
+	fieldMsgErrs, maskOK := isReadMaskValid(fieldMsgErrs, req.GetReadMask())
+	if !ok || !maskOK {
+		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
+	}
+
+	// Decode page token, bound to the request parameters.
+	after, err := s.deps.PageTokens().Decode(req.GetPageToken(), "ListUsers", req.GetFilter(), req.GetOrderBy())
+	if err != nil {
+		return nil, servers.WrapError(codes.InvalidArgument, err, "invalid page token")
+	}
+
+	limit := req.GetPageSize()
+
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	// List users.
+	page, err := s.deps.ListUsers().ListUsers(ctx, req.GetFilter(), req.GetOrderBy(), limit, after)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidFilter):
+			return nil, servers.WrapError(codes.InvalidArgument, err, "invalid filter")
+		case errors.Is(err, usecase.ErrInvalidOrderBy):
+			return nil, servers.WrapError(codes.InvalidArgument, err, "invalid order by")
+		case errors.Is(err, usecase.ErrInvalidPageToken):
+			return nil, servers.WrapError(codes.InvalidArgument, err, "invalid page token")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			return nil, servers.WrapError(codes.PermissionDenied, err, "permission denied")
+		default:
+			return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+		}
+	}
+
+	return &api.UserList{
+		Users:         usersToProto(page.Users, req.GetReadMask()),
+		NextPageToken: s.deps.PageTokens().Encode(page.Next, "ListUsers", req.GetFilter(), req.GetOrderBy()),
+	}, nil
+}
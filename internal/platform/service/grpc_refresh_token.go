@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/servers"
+	"google.golang.org/grpc/codes"
+)
+
+// RefreshSession defines the use case to exchange a refresh token for a new session.
+type RefreshSession interface {
+	RefreshSession(ctx context.Context, refreshToken string) (model.Session, error)
+}
+
+// RefreshToken exchanges a refresh token for a new session.
+//
+// Receives a request with the refresh token. Responses with a new session, the refresh token is not valid anymore.
+func (s *FaceitService) RefreshToken(ctx context.Context, req *api.RefreshTokenRequest) (*api.Session, error) {
+	ctx = ctxd.AddFields(ctx, "service", "FaceitService")
+
+	// Validate request.
+	val, err := protovalidate.New(
+		protovalidate.WithMessages(
+			&api.RefreshTokenRequest{},
+		),
+	)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "create proto validator")
+	}
+
+	fieldMsgErrs, ok := isUserValid(req, val, false)
+	if !ok {
+		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
+	}
+
+	sess, err := s.deps.RefreshSession().RefreshSession(ctx, req.GetRefreshToken())
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidRefreshToken) {
+			return nil, servers.WrapError(codes.Unauthenticated, err, "invalid refresh token")
+		}
+
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+
+	return sessionToProto(sess), nil
+}
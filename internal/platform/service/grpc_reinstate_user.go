@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bool64/ctxd"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/servers"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// ReinstateUser reinstates the suspended or banned user.
+//
+// Receives a request with user data id and an optional reason. Responses the active user.
+func (s *FaceitService) ReinstateUser(ctx context.Context, req *api.ReinstateUserRequest) (*api.UserProfile, error) {
+	ctx = ctxd.AddFields(ctx, "service", "FaceitService")
+
+	// Validate request.
+	val, err := protovalidate.New(
+		protovalidate.WithMessages(
+			&api.ReinstateUserRequest{},
+		),
+	)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "create proto validator")
+	}
+
+	fieldMsgErrs, ok := isUserValid(req, val, false)
+	if !ok {
+		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
+	}
+
+	change := model.UserStatusChange{
+		Status:       model.StatusActive,
+		StatusReason: req.GetReason(),
+	}
+
+	return s.changeUserStatus(ctx, uuid.MustParse(req.GetId()), change) // Safe to ignore panic as it was validated before.
+}
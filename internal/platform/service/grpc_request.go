@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/textproto"
+	"strings"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// headerRequestID is the header, and gRPC metadata, bearing the id of the requests.
+//
+// The id given by the client is kept, otherwise one is generated. It is sent back in the response header, and
+// recorded in the audit entries of the mutations performed on the request.
+const headerRequestID = "x-request-id"
+
+// headerForwardedFor is the gRPC metadata bearing the addresses a request was forwarded for, set by the gateway.
+const headerForwardedFor = "x-forwarded-for"
+
+// RequestConfig represents the configuration of the information of the requests.
+type RequestConfig struct {
+	// TrustedProxies is the list of networks, in CIDR notation, of the proxies trusted to forward the address of the
+	// clients. The gateway calls the gRPC server on the loopback.
+	TrustedProxies []string `split_words:"true" default:"127.0.0.0/8,::1/128"`
+}
+
+// RequestInterceptor carries the information of the request, its id and the address of the client, in the context
+// of the gRPC calls.
+type RequestInterceptor struct {
+	trustedProxies []netip.Prefix
+}
+
+// NewRequestInterceptor creates a new RequestInterceptor.
+func NewRequestInterceptor(cfg RequestConfig) (*RequestInterceptor, error) {
+	proxies := make([]netip.Prefix, 0, len(cfg.TrustedProxies))
+
+	for _, p := range cfg.TrustedProxies {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(p))
+		if err != nil {
+			return nil, fmt.Errorf("parse trusted proxy %q: %w", p, err)
+		}
+
+		proxies = append(proxies, prefix)
+	}
+
+	return &RequestInterceptor{trustedProxies: proxies}, nil
+}
+
+// UnaryServerInterceptor carries the information of the request of the unary calls.
+func (i *RequestInterceptor) UnaryServerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, r := i.request(ctx)
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(headerRequestID, r.ID)); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor carries the information of the request of the streaming calls.
+func (i *RequestInterceptor) StreamServerInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, r := i.request(ss.Context())
+
+	if err := ss.SetHeader(metadata.Pairs(headerRequestID, r.ID)); err != nil {
+		return err
+	}
+
+	return handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+}
+
+func (i *RequestInterceptor) request(ctx context.Context) (context.Context, model.RequestInfo) {
+	r := model.RequestInfo{
+		SourceIP: i.clientIP(ctx),
+	}
+
+	if values := metadata.ValueFromIncomingContext(ctx, headerRequestID); len(values) > 0 && values[0] != "" {
+		r.ID = values[0]
+	} else {
+		r.ID = uuid.NewString()
+	}
+
+	return usecase.ContextWithRequest(ctx, r), r
+}
+
+// clientIP returns the address of the client, empty when unknown.
+//
+// The x-forwarded-for metadata is only trusted from the trusted proxies, the gateway among them: the client is the
+// last address forwarded that is not a trusted proxy. The entries before it are set by the client and can not be
+// trusted. Otherwise the client is the peer of the call.
+func (i *RequestInterceptor) clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	if !i.trusted(host) {
+		return host
+	}
+
+	var forwarded []string
+
+	for _, v := range metadata.ValueFromIncomingContext(ctx, headerForwardedFor) {
+		forwarded = append(forwarded, strings.Split(v, ",")...)
+	}
+
+	for k := len(forwarded) - 1; k >= 0; k-- {
+		ip := strings.TrimSpace(forwarded[k])
+
+		if _, err := netip.ParseAddr(ip); err != nil {
+			break
+		}
+
+		host = ip
+
+		if !i.trusted(ip) {
+			break
+		}
+	}
+
+	return host
+}
+
+// trusted reports whether the address is of a trusted proxy.
+func (i *RequestInterceptor) trusted(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+
+	for _, p := range i.trustedProxies {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IncomingHeaderMatcher forwards the request id, If-Match and Idempotency-Key headers of the REST requests as gRPC
+// metadata, along with the headers forwarded by default.
+func IncomingHeaderMatcher(key string) (string, bool) {
+	switch textproto.CanonicalMIMEHeaderKey(key) {
+	case textproto.CanonicalMIMEHeaderKey(headerRequestID):
+		return headerRequestID, true
+	case textproto.CanonicalMIMEHeaderKey(headerIfMatch):
+		return headerIfMatch, true
+	case textproto.CanonicalMIMEHeaderKey(headerIdempotencyKey):
+		return headerIdempotencyKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// OutgoingHeaderMatcher sends the request id, etag, location and idempotent replayed metadata back as headers of the
+// REST responses, the other metadata is sent prefixed as by default.
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case headerRequestID:
+		return textproto.CanonicalMIMEHeaderKey(headerRequestID), true
+	case headerETag:
+		return textproto.CanonicalMIMEHeaderKey(headerETag), true
+	case headerLocation:
+		return textproto.CanonicalMIMEHeaderKey(headerLocation), true
+	case headerIdempotentReplayed:
+		return textproto.CanonicalMIMEHeaderKey(headerIdempotentReplayed), true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/servers"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RequestPasswordReset defines the use case to request the reset of the password of a user.
+type RequestPasswordReset interface {
+	RequestPasswordReset(ctx context.Context, email, ip string) error
+}
+
+// RequestPasswordReset emails a password reset token to the user with the given email.
+//
+// Receives a request with the email of the user. Responses successfully whether a user has the email or not, so the
+// emails of the users can not be enumerated.
+func (s *FaceitService) RequestPasswordReset(ctx context.Context, req *api.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	ctx = ctxd.AddFields(ctx, "service", "FaceitService")
+
+	// Validate request.
+	val, err := protovalidate.New(
+		protovalidate.WithMessages(
+			&api.RequestPasswordResetRequest{},
+		),
+	)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "create proto validator")
+	}
+
+	fieldMsgErrs, ok := validate(req, val)
+	if !ok {
+		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
+	}
+
+	// The address of the client, carried by the request interceptor.
+	r, _ := usecase.RequestFromContext(ctx)
+
+	if err := s.deps.RequestPasswordReset().RequestPasswordReset(ctx, req.GetEmail(), r.SourceIP); err != nil {
+		if errors.Is(err, usecase.ErrTooManyResetRequests) {
+			return nil, servers.WrapError(codes.ResourceExhausted, err, "too many password resets requested, try again later")
+		}
+
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "204")) //nolint:errcheck
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,71 @@
+package service_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/service"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestRequestInterceptor_sourceIP(t *testing.T) {
+	t.Parallel()
+
+	i, err := service.NewRequestInterceptor(service.RequestConfig{TrustedProxies: []string{"127.0.0.0/8", "10.0.0.0/8"}})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name      string
+		peer      string
+		forwarded []string
+		sourceIP  string
+	}{
+		{name: "peer", peer: "203.0.113.7:52000", sourceIP: "203.0.113.7"},
+		{name: "forwarded by an untrusted peer", peer: "203.0.113.7:52000", forwarded: []string{"198.51.100.1"}, sourceIP: "203.0.113.7"},
+		{name: "forwarded by the gateway", peer: "127.0.0.1:52000", forwarded: []string{"198.51.100.1, 203.0.113.7"}, sourceIP: "203.0.113.7"},
+		{name: "forwarded by trusted proxies", peer: "127.0.0.1:52000", forwarded: []string{"198.51.100.1, 203.0.113.7, 10.0.0.5"}, sourceIP: "203.0.113.7"},
+		{name: "forwarded garbage", peer: "127.0.0.1:52000", forwarded: []string{"unknown"}, sourceIP: "127.0.0.1"},
+		{name: "unknown peer", forwarded: []string{"198.51.100.1"}, sourceIP: ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{"x-forwarded-for": tc.forwarded})
+			ctx = grpc.NewContextWithServerTransportStream(ctx, &transportStream{})
+
+			if tc.peer != "" {
+				addr, err := net.ResolveTCPAddr("tcp", tc.peer)
+				require.NoError(t, err)
+
+				ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+			}
+
+			var sourceIP string
+
+			_, err := i.UnaryServerInterceptor(ctx, &emptypb.Empty{}, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+				r, ok := usecase.RequestFromContext(ctx)
+				require.True(t, ok)
+
+				sourceIP = r.SourceIP
+
+				return &emptypb.Empty{}, nil
+			})
+			require.NoError(t, err)
+
+			require.Equal(t, tc.sourceIP, sourceIP)
+		})
+	}
+}
+
+func TestNewRequestInterceptor_invalidProxy(t *testing.T) {
+	t.Parallel()
+
+	_, err := service.NewRequestInterceptor(service.RequestConfig{TrustedProxies: []string{"10.0.0.1"}})
+	require.Error(t, err)
+}
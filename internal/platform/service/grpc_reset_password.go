@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/servers"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ResetPassword defines the use case to reset the password of a user.
+type ResetPassword interface {
+	ResetPassword(ctx context.Context, token, password string) error
+}
+
+// ResetPassword sets a new password to a user.
+//
+// Receives a request with the password reset token emailed to the user and the new password. Responses whether the
+// password was reset successfully or not.
+func (s *FaceitService) ResetPassword(ctx context.Context, req *api.ResetPasswordRequest) (*emptypb.Empty, error) {
+	ctx = ctxd.AddFields(ctx, "service", "FaceitService")
+
+	// Validate request.
+	val, err := protovalidate.New(
+		protovalidate.WithMessages(
+			&api.ResetPasswordRequest{},
+		),
+	)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "create proto validator")
+	}
+
+	fieldMsgErrs, ok := isUserValid(req, val, false)
+	if !ok {
+		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
+	}
+
+	if err := s.deps.ResetPassword().ResetPassword(ctx, req.GetToken(), req.GetPassword()); err != nil {
+		if errors.Is(err, usecase.ErrInvalidResetToken) {
+			return nil, servers.WrapError(codes.InvalidArgument, err, "invalid password reset token")
+		}
+
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "204")) //nolint:errcheck
+
+	return &emptypb.Empty{}, nil
+}
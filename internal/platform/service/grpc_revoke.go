@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bool64/ctxd"
+	"github.com/bufbuild/protovalidate-go"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/servers"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RevokeSession defines the use case to revoke a session.
+type RevokeSession interface {
+	RevokeSession(ctx context.Context, refreshToken string) error
+}
+
+// Revoke revokes a session.
+//
+// Receives a request with a refresh token of the session. Responses whether the session was revoked successfully or
+// not.
+func (s *FaceitService) Revoke(ctx context.Context, req *api.RevokeRequest) (*emptypb.Empty, error) {
+	ctx = ctxd.AddFields(ctx, "service", "FaceitService")
+
+	// Validate request.
+	val, err := protovalidate.New(
+		protovalidate.WithMessages(
+			&api.RevokeRequest{},
+		),
+	)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "create proto validator")
+	}
+
+	fieldMsgErrs, ok := isUserValid(req, val, false)
+	if !ok {
+		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
+	}
+
+	if err := s.deps.RevokeSession().RevokeSession(ctx, req.GetRefreshToken()); err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "204")) //nolint:errcheck
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/dohernandez/servers"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// ChangeUserStatus defines the use case to change the status of a user.
+type ChangeUserStatus interface {
+	ChangeUserStatus(ctx context.Context, id model.UserID, change model.UserStatusChange) (*model.User, error)
+}
+
+// SuspendUser suspends the user, until the expire time when given.
+//
+// Receives a request with user data id, the reason and an optional expire time. Responses the suspended user.
+func (s *FaceitService) SuspendUser(ctx context.Context, req *api.RestrictUserRequest) (*api.UserProfile, error) {
+	return s.restrictUser(ctx, req, model.StatusSuspended)
+}
+
+// restrictUser validates the request and changes the status of the user to the restricted status.
+func (s *FaceitService) restrictUser(ctx context.Context, req *api.RestrictUserRequest, status model.UserStatus) (*api.UserProfile, error) {
+	ctx = ctxd.AddFields(ctx, "service", "FaceitService")
+
+	// Validate request.
+	val, err := protovalidate.New(
+		protovalidate.WithMessages(
+			&api.RestrictUserRequest{},
+		),
+	)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "create proto validator")
+	}
+
+	fieldMsgErrs, ok := isUserValid(req, val, false)
+	if !ok {
+		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
+	}
+
+	change := model.UserStatusChange{
+		Status:       status,
+		StatusReason: req.GetReason(),
+	}
+
+	if req.GetExpireTime() != nil {
+		expiresAt := req.GetExpireTime().AsTime()
+		change.StatusExpiresAt = &expiresAt
+	}
+
+	return s.changeUserStatus(ctx, uuid.MustParse(req.GetId()), change) // Safe to ignore panic as it was validated before.
+}
+
+// changeUserStatus changes the status of the user, mapping the use case errors to the response errors.
+func (s *FaceitService) changeUserStatus(ctx context.Context, id model.UserID, change model.UserStatusChange) (*api.UserProfile, error) {
+	u, err := s.deps.ChangeUserStatus().ChangeUserStatus(ctx, id, change)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, servers.WrapError(codes.NotFound, err, "user not found")
+		}
+
+		if errors.Is(err, usecase.ErrPermissionDenied) {
+			return nil, servers.WrapError(codes.PermissionDenied, err, "permission denied")
+		}
+
+		if errors.Is(err, usecase.ErrInvalidStatusTransition) {
+			return nil, servers.WrapError(codes.FailedPrecondition, err, "invalid status transition")
+		}
+
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+
+	return userToProto(u, nil), nil
+}
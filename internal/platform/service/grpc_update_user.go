@@ -3,29 +3,30 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/bool64/ctxd"
 	"github.com/bufbuild/protovalidate-go"
 	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
 	api "github.com/dohernandez/faceit/internal/platform/service/pb"
 	"github.com/dohernandez/go-grpc-service/database"
 	"github.com/dohernandez/servers"
 	"github.com/google/uuid"
-	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // UpdateUser defines the use case to update a user.
 type UpdateUser interface {
-	UpdateUser(ctx context.Context, id model.UserID, info model.UserState) error
+	UpdateUser(ctx context.Context, id model.UserID, info model.UserState, fields model.UserFields, password string, version int64) (*model.User, error)
 }
 
 // UpdateUser update the user.
 //
-// Receives a request with user data. Responses whether the user was updated successfully or not.
-func (s *FaceitService) UpdateUser(ctx context.Context, req *api.User) (*emptypb.Empty, error) {
+// Receives a request with user data, an optional update mask and an optional etag, from the If-Match header on REST.
+// Responses with the updated user, ABORTED when the user changed since the etag.
+func (s *FaceitService) UpdateUser(ctx context.Context, req *api.User) (*api.UserProfile, error) {
 	ctx = ctxd.AddFields(ctx, "service", "FaceitService")
 
 	// Validate request.
@@ -39,36 +40,112 @@ func (s *FaceitService) UpdateUser(ctx context.Context, req *api.User) (*emptypb
 	}
 
 	fieldMsgErrs, ok := isUserValid(req, val, false)
-	if !ok {
+
+	fields, fieldMsgErrs, maskOK := updateFields(fieldMsgErrs, req)
+	if !ok || !maskOK {
 		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
 	}
 
-	id := uuid.MustParse(req.GetId()) // Safe to ignore panic as it was validated before.
-
-	us := model.UserState{
-		PasswordHash: req.GetPasswordHash(),
-		Email:        req.GetEmail(),
-		FirstName:    req.GetFirstName(),
-		LastName:     req.GetLastName(),
-		Nickname:     req.GetNickname(),
-		Country:      req.GetCountry(),
+	version, err := expectedVersion(ctx, req.GetEtag())
+	if err != nil {
+		return nil, servers.WrapError(codes.InvalidArgument, err, "validation error", map[string]string{"etag": err.Error()})
 	}
 
-	if us == (model.UserState{}) {
-		_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "204")) //nolint:errcheck
+	id := uuid.MustParse(req.GetId()) // Safe to ignore panic as it was validated before.
 
-		return &emptypb.Empty{}, nil
+	us := model.UserState{
+		Email:     req.GetEmail(),
+		FirstName: req.GetFirstName(),
+		LastName:  req.GetLastName(),
+		Nickname:  req.GetNickname(),
+		Country:   req.GetCountry(),
 	}
 
-	if err = s.deps.UpdateUser().UpdateUser(ctx, id, us); err != nil {
+	u, err := s.deps.UpdateUser().UpdateUser(ctx, id, us, fields, req.GetPassword(), version)
+	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			return nil, servers.WrapError(codes.NotFound, err, "user not found")
 		}
 
+		if errors.Is(err, usecase.ErrVersionMismatch) {
+			return nil, servers.WrapError(codes.Aborted, err, "user changed, etag mismatch")
+		}
+
+		if errors.Is(err, usecase.ErrPermissionDenied) {
+			return nil, servers.WrapError(codes.PermissionDenied, err, "permission denied")
+		}
+
 		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
 	}
 
-	_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "204")) //nolint:errcheck
+	setETag(ctx, u.Version)
+
+	return userToProto(u, nil), nil
+}
+
+// updateFields returns the fields of the user the request updates, those of the update mask, or the fields set in the
+// request without mask.
+//
+// The validation errors of the update mask, and of the fields it clears that can not be empty, are added to the field
+// errors when invalid.
+func updateFields(fieldMsgErrs map[string]string, req *api.User) (model.UserFields, map[string]string, bool) {
+	set := map[model.UserField]bool{
+		model.UserFieldEmail:     req.Email != nil,
+		model.UserFieldFirstName: req.FirstName != nil,
+		model.UserFieldLastName:  req.LastName != nil,
+		model.UserFieldNickname:  req.Nickname != nil,
+		model.UserFieldCountry:   req.Country != nil,
+		model.UserFieldPassword:  req.Password != nil,
+	}
+
+	if fieldMsgErrs == nil {
+		fieldMsgErrs = make(map[string]string)
+	}
+
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		var fields model.UserFields
+
+		// Keeps the order of the fields stable.
+		for _, f := range []model.UserField{
+			model.UserFieldEmail, model.UserFieldFirstName, model.UserFieldLastName,
+			model.UserFieldNickname, model.UserFieldCountry, model.UserFieldPassword,
+		} {
+			if set[f] {
+				fields = append(fields, f)
+			}
+		}
+
+		return fields, fieldMsgErrs, true
+	}
+
+	var (
+		fields  model.UserFields
+		unknown []string
+	)
+
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		f := model.UserField(path)
+
+		isSet, ok := set[f]
+		if !ok {
+			unknown = append(unknown, path)
+
+			continue
+		}
+
+		// Only the nickname is optional, the other fields can not be cleared.
+		if !isSet && f != model.UserFieldNickname {
+			fieldMsgErrs[path] = "must not be empty"
+		}
+
+		if !fields.Has(f) {
+			fields = append(fields, f)
+		}
+	}
+
+	if len(unknown) > 0 {
+		fieldMsgErrs["update_mask"] = fmt.Sprintf("unknown fields %s", strings.Join(unknown, ", "))
+	}
 
-	return &emptypb.Empty{}, nil
+	return fields, fieldMsgErrs, len(fieldMsgErrs) == 0
 }
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/servers"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// VerifyEmail defines the use case to verify the email of a user.
+type VerifyEmail interface {
+	VerifyEmail(ctx context.Context, token string) error
+}
+
+// VerifyEmail verifies the email of a user.
+//
+// Receives a request with the verification token emailed to the user. Responses whether the email was verified
+// successfully or not.
+func (s *FaceitService) VerifyEmail(ctx context.Context, req *api.VerifyEmailRequest) (*emptypb.Empty, error) {
+	ctx = ctxd.AddFields(ctx, "service", "FaceitService")
+
+	// Validate request.
+	val, err := protovalidate.New(
+		protovalidate.WithMessages(
+			&api.VerifyEmailRequest{},
+		),
+	)
+	if err != nil {
+		return nil, servers.WrapError(codes.Internal, err, "create proto validator")
+	}
+
+	fieldMsgErrs, ok := isUserValid(req, val, false)
+	if !ok {
+		return nil, servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
+	}
+
+	if err := s.deps.VerifyEmail().VerifyEmail(ctx, req.GetToken()); err != nil {
+		if errors.Is(err, usecase.ErrInvalidVerificationToken) {
+			return nil, servers.WrapError(codes.InvalidArgument, err, "invalid verification token")
+		}
+
+		return nil, servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "204")) //nolint:errcheck
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"github.com/dohernandez/servers"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WatchUsers defines the use case to watch the user changes.
+type WatchUsers interface {
+	WatchUsers(ctx context.Context, country, resumeToken string, fn func(e model.UserEvent) error) error
+}
+
+// WatchUsers streams the user changes.
+//
+// Receives a request with an optional country and resume token. Responses with a stream of user events.
+func (s *FaceitService) WatchUsers(req *api.WatchUsersRequest, stream grpc.ServerStreamingServer[api.UserEvent]) error {
+	ctx := ctxd.AddFields(stream.Context(), "service", "FaceitService")
+
+	// Validate request.
+	val, err := protovalidate.New(
+		protovalidate.WithMessages(
+			&api.WatchUsersRequest{},
+		),
+	)
+	if err != nil {
+		return servers.WrapError(codes.Internal, err, "create proto validator")
+	}
+
+	fieldMsgErrs, ok := validate(req, val)
+	if !ok {
+		return servers.Error(codes.InvalidArgument, "validation error", fieldMsgErrs)
+	}
+
+	err = s.deps.WatchUsers().WatchUsers(ctx, req.GetCountry(), req.GetResumeToken(), func(e model.UserEvent) error {
+		return stream.Send(userEventToProto(e))
+	})
+
+	switch {
+	case err == nil, ctx.Err() != nil:
+		// The client went away.
+		return nil
+	case errors.Is(err, usecase.ErrInvalidResumeToken):
+		return servers.WrapError(codes.InvalidArgument, err, "invalid resume token")
+	case errors.Is(err, usecase.ErrSlowSubscriber):
+		return servers.WrapError(codes.ResourceExhausted, err, "too slow consuming the events, resume watching")
+	case errors.Is(err, usecase.ErrPermissionDenied):
+		return servers.WrapError(codes.PermissionDenied, err, "permission denied")
+	default:
+		return servers.WrapError(codes.Internal, err, "ups, something went wrong!")
+	}
+}
+
+// userEventToProto maps the user event to the response message.
+func userEventToProto(e model.UserEvent) *api.UserEvent {
+	return &api.UserEvent{
+		Id:   e.ID.String(),
+		Type: string(e.Type),
+		Time: timestamppb.New(e.Time),
+		User: &api.User{
+			Id:        e.Data.ID,
+			FirstName: optional(e.Data.FirstName),
+			LastName:  optional(e.Data.LastName),
+			Nickname:  optional(e.Data.Nickname),
+			Email:     optional(e.Data.Email),
+			Country:   optional(e.Data.Country),
+		},
+		ResumeToken: e.ResumeToken,
+	}
+}
+
+// optional returns a pointer to the value, nil when the value is empty.
+func optional(v string) *string {
+	if v == "" {
+		return nil
+	}
+
+	return &v
+}
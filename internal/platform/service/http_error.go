@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/dohernandez/servers"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type errorResponse struct {
+	Code    int                    `json:"code"`
+	Message string                 `json:"message,omitempty"`
+	Error   string                 `json:"error,omitempty"`
+	Details []errorResponseDetails `json:"details,omitempty"`
+}
+
+type errorResponseDetails struct {
+	Field       string `json:"field,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// HTTPErrorHandler writes the errors of the REST requests as the servers package does, but for the mutations
+// conditioned on an etag that changed, answered with 412 Precondition Failed instead of 409 Conflict.
+//
+// ABORTED is only returned by the service on etag mismatch, so it is mapped as a whole.
+func HTTPErrorHandler(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		st, _ = status.FromError(servers.WrapError(codes.Internal, err, "ups, something went wrong!"))
+	}
+
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+	if st.Code() == codes.Aborted {
+		httpStatus = http.StatusPreconditionFailed
+	}
+
+	var (
+		details []errorResponseDetails
+		errID   string
+	)
+
+	for _, d := range st.Details() {
+		errInfo, ok := d.(*errdetails.ErrorInfo)
+		if !ok {
+			continue
+		}
+
+		for f, m := range errInfo.GetMetadata() {
+			if f == "error_id" {
+				errID = m
+
+				continue
+			}
+
+			details = append(details, errorResponseDetails{
+				Field:       f,
+				Description: m,
+			})
+		}
+	}
+
+	w.Header().Del("Grpc-Metadata-Content-Type")
+	w.WriteHeader(httpStatus)
+
+	_ = json.NewEncoder(w).Encode(errorResponse{ //nolint:errcheck
+		Code:    httpStatus,
+		Message: st.Message(),
+		Error:   errID,
+		Details: details,
+	})
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// JWKS serves the JSON Web Key Set with the public keys verifying the access tokens.
+//
+// Keys are rotated by configuration, the set holds the signing key and the previous keys still verifying tokens.
+// Clients may cache it for a while, a token signed by an unknown key means the set must be fetched again.
+func (s *FaceitService) JWKS(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.WriteHeader(http.StatusOK)
+
+	_ = json.NewEncoder(w).Encode(s.deps.SigningKeys().JWKS()) //nolint:errcheck
+}
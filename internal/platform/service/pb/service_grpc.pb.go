@@ -8,6 +8,7 @@ package api
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,10 +21,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FaceitService_AddUser_FullMethodName            = "/api.faceit.FaceitService/AddUser"
-	FaceitService_UpdateUser_FullMethodName         = "/api.faceit.FaceitService/UpdateUser"
-	FaceitService_DeleteUser_FullMethodName         = "/api.faceit.FaceitService/DeleteUser"
-	FaceitService_ListUsersByCountry_FullMethodName = "/api.faceit.FaceitService/ListUsersByCountry"
+	FaceitService_AddUser_FullMethodName              = "/api.faceit.FaceitService/AddUser"
+	FaceitService_UpdateUser_FullMethodName           = "/api.faceit.FaceitService/UpdateUser"
+	FaceitService_GetUser_FullMethodName              = "/api.faceit.FaceitService/GetUser"
+	FaceitService_DeleteUser_FullMethodName           = "/api.faceit.FaceitService/DeleteUser"
+	FaceitService_UndeleteUser_FullMethodName         = "/api.faceit.FaceitService/UndeleteUser"
+	FaceitService_ExportUserData_FullMethodName       = "/api.faceit.FaceitService/ExportUserData"
+	FaceitService_EraseUser_FullMethodName            = "/api.faceit.FaceitService/EraseUser"
+	FaceitService_ListUsersByCountry_FullMethodName   = "/api.faceit.FaceitService/ListUsersByCountry"
+	FaceitService_ListUsers_FullMethodName            = "/api.faceit.FaceitService/ListUsers"
+	FaceitService_ListUserAuditEvents_FullMethodName  = "/api.faceit.FaceitService/ListUserAuditEvents"
+	FaceitService_WatchUsers_FullMethodName           = "/api.faceit.FaceitService/WatchUsers"
+	FaceitService_Authenticate_FullMethodName         = "/api.faceit.FaceitService/Authenticate"
+	FaceitService_RefreshToken_FullMethodName         = "/api.faceit.FaceitService/RefreshToken"
+	FaceitService_Revoke_FullMethodName               = "/api.faceit.FaceitService/Revoke"
+	FaceitService_VerifyEmail_FullMethodName          = "/api.faceit.FaceitService/VerifyEmail"
+	FaceitService_ResendVerification_FullMethodName   = "/api.faceit.FaceitService/ResendVerification"
+	FaceitService_RequestPasswordReset_FullMethodName = "/api.faceit.FaceitService/RequestPasswordReset"
+	FaceitService_ResetPassword_FullMethodName        = "/api.faceit.FaceitService/ResetPassword"
+	FaceitService_SuspendUser_FullMethodName          = "/api.faceit.FaceitService/SuspendUser"
+	FaceitService_BanUser_FullMethodName              = "/api.faceit.FaceitService/BanUser"
+	FaceitService_ReinstateUser_FullMethodName        = "/api.faceit.FaceitService/ReinstateUser"
 )
 
 // FaceitServiceClient is the client API for FaceitService service.
@@ -34,20 +52,109 @@ const (
 type FaceitServiceClient interface {
 	// AddUser add new user.
 	//
-	// Receives a request with user data. Responses whether the user was added successfully or not.
-	AddUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Receives a request with user data and an optional id, a time-ordered UUIDv7 is generated when not given. Responses
+	// with the user added, along with its location on REST. Retried safely with the idempotency-key metadata, the
+	// Idempotency-Key header on REST.
+	AddUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*UserProfile, error)
 	// Update the user.
 	//
-	// Receives a request with user data. Responses whether the user was updated successfully or not.
-	UpdateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Receives a request with user data, an optional update mask and an optional etag, from the If-Match header on REST.
+	// Responses with the updated user, ABORTED when the user changed since the etag. Retried safely with the
+	// idempotency-key metadata, the Idempotency-Key header on REST.
+	UpdateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*UserProfile, error)
+	// Get the user.
+	//
+	// Receives a request with user data id and an optional read mask. Responses the user, without credentials.
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserProfile, error)
 	// Delete the user.
 	//
-	// Receives a request with user data id. Responses whether the user was deleted successfully or not.
-	DeleteUser(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Receives a request with user data id and an optional etag, from the If-Match header on REST. Responses whether the
+	// user was deleted successfully or not, ABORTED when the user changed since the etag. The user can be restored with
+	// UndeleteUser within the grace period. Retried safely with the idempotency-key metadata, the Idempotency-Key header
+	// on REST.
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteUser restores the deleted user.
+	//
+	// Receives a request with user data id. Responses the restored user, as long as it was deleted within the grace
+	// period and is not purged yet.
+	UndeleteUser(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*UserProfile, error)
+	// ExportUserData exports the personal data of the user.
+	//
+	// Receives a request with user data id. Responses a JSON archive of every record stored about the user, deleted or
+	// not: its attributes, roles, tokens metadata, failed logins and events. Secrets are never exported.
+	ExportUserData(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// EraseUser erases the personal data of the user, irreversibly.
+	//
+	// Receives a request with user data id. Responses the certificate of the erasure. The user is deleted, if it was
+	// not, and kept under its id with its personal data anonymized, it can not be restored.
+	EraseUser(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ErasureCertificate, error)
 	// ListUsersByCountry list users by country.
 	//
-	// Receives a request with country data. Responses a list of users.
+	// Receives a request with country data. Responses a list of users, sorted by creation time.
 	ListUsersByCountry(ctx context.Context, in *UsersByCountry, opts ...grpc.CallOption) (*UserList, error)
+	// ListUsers list the users matching the filter.
+	//
+	// Receives a request with an AIP-160 filter and an AIP-132 order by. Responses a list of users.
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*UserList, error)
+	// ListUserAuditEvents list the audit log of the user mutations.
+	//
+	// Receives a request with an optional user id, actor and time range. Responses a list of audit events, the latest
+	// first.
+	ListUserAuditEvents(ctx context.Context, in *ListUserAuditEventsRequest, opts ...grpc.CallOption) (*UserAuditEventList, error)
+	// WatchUsers streams the user changes.
+	//
+	// Receives a request with an optional country and resume token. Responses a stream of user events, starting after
+	// the event of the resume token when given, otherwise with the changes happening from now on.
+	WatchUsers(ctx context.Context, in *WatchUsersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UserEvent], error)
+	// Authenticate authenticates a user by email and password.
+	//
+	// Receives a request with the credentials of the user. Responses a session, a short-lived access token and a refresh
+	// token to obtain a new session. Logins of an email are locked out for a while after repeated failures.
+	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*Session, error)
+	// RefreshToken exchanges a refresh token for a new session.
+	//
+	// Receives a request with the refresh token. Responses a new session, the refresh token is not valid anymore.
+	// Reusing a refresh token already exchanged revokes the session.
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*Session, error)
+	// Revoke revokes a session.
+	//
+	// Receives a request with a refresh token of the session. Responses whether the session was revoked successfully or
+	// not, revoking an invalid refresh token succeeds.
+	Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// VerifyEmail verifies the email of a user.
+	//
+	// Receives a request with the verification token emailed to the user. Responses whether the email was verified
+	// successfully or not.
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ResendVerification emails a new verification token to the user.
+	//
+	// Receives a request with user data id. Responses whether the verification was sent successfully or not, the
+	// tokens sent before are no longer valid.
+	ResendVerification(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RequestPasswordReset emails a password reset token to the user with the given email.
+	//
+	// Receives a request with the email of the user. Always responses successfully whether a user has the email or not,
+	// unless too many resets were requested for the email or from the client address.
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ResetPassword sets a new password to a user.
+	//
+	// Receives a request with the password reset token emailed to the user and the new password. Responses whether the
+	// password was reset successfully or not, the sessions of the user are revoked on success.
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SuspendUser suspends the user, until the expire time when given.
+	//
+	// Receives a request with user data id, the reason and an optional expire time. Responses the suspended user, the
+	// sessions of the user are revoked and the user can no longer authenticate.
+	SuspendUser(ctx context.Context, in *RestrictUserRequest, opts ...grpc.CallOption) (*UserProfile, error)
+	// BanUser bans the user, until the expire time when given.
+	//
+	// Receives a request with user data id, the reason and an optional expire time. Responses the banned user, the
+	// sessions of the user are revoked and the user can no longer authenticate.
+	BanUser(ctx context.Context, in *RestrictUserRequest, opts ...grpc.CallOption) (*UserProfile, error)
+	// ReinstateUser reinstates the suspended or banned user.
+	//
+	// Receives a request with user data id and an optional reason. Responses the active user.
+	ReinstateUser(ctx context.Context, in *ReinstateUserRequest, opts ...grpc.CallOption) (*UserProfile, error)
 }
 
 type faceitServiceClient struct {
@@ -58,9 +165,9 @@ func NewFaceitServiceClient(cc grpc.ClientConnInterface) FaceitServiceClient {
 	return &faceitServiceClient{cc}
 }
 
-func (c *faceitServiceClient) AddUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *faceitServiceClient) AddUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*UserProfile, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(UserProfile)
 	err := c.cc.Invoke(ctx, FaceitService_AddUser_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -68,9 +175,9 @@ func (c *faceitServiceClient) AddUser(ctx context.Context, in *User, opts ...grp
 	return out, nil
 }
 
-func (c *faceitServiceClient) UpdateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *faceitServiceClient) UpdateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*UserProfile, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(UserProfile)
 	err := c.cc.Invoke(ctx, FaceitService_UpdateUser_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -78,7 +185,17 @@ func (c *faceitServiceClient) UpdateUser(ctx context.Context, in *User, opts ...
 	return out, nil
 }
 
-func (c *faceitServiceClient) DeleteUser(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *faceitServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserProfile)
+	err := c.cc.Invoke(ctx, FaceitService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faceitServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, FaceitService_DeleteUser_FullMethodName, in, out, cOpts...)
@@ -88,6 +205,36 @@ func (c *faceitServiceClient) DeleteUser(ctx context.Context, in *UserID, opts .
 	return out, nil
 }
 
+func (c *faceitServiceClient) UndeleteUser(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*UserProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserProfile)
+	err := c.cc.Invoke(ctx, FaceitService_UndeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faceitServiceClient) ExportUserData(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, FaceitService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faceitServiceClient) EraseUser(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ErasureCertificate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ErasureCertificate)
+	err := c.cc.Invoke(ctx, FaceitService_EraseUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *faceitServiceClient) ListUsersByCountry(ctx context.Context, in *UsersByCountry, opts ...grpc.CallOption) (*UserList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UserList)
@@ -98,6 +245,145 @@ func (c *faceitServiceClient) ListUsersByCountry(ctx context.Context, in *UsersB
 	return out, nil
 }
 
+func (c *faceitServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*UserList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserList)
+	err := c.cc.Invoke(ctx, FaceitService_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faceitServiceClient) ListUserAuditEvents(ctx context.Context, in *ListUserAuditEventsRequest, opts ...grpc.CallOption) (*UserAuditEventList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserAuditEventList)
+	err := c.cc.Invoke(ctx, FaceitService_ListUserAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faceitServiceClient) WatchUsers(ctx context.Context, in *WatchUsersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UserEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FaceitService_ServiceDesc.Streams[0], FaceitService_WatchUsers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchUsersRequest, UserEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FaceitService_WatchUsersClient = grpc.ServerStreamingClient[UserEvent]
+
+func (c *faceitServiceClient) Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*Session, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Session)
+	err := c.cc.Invoke(ctx, FaceitService_Authenticate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faceitServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*Session, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Session)
+	err := c.cc.Invoke(ctx, FaceitService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faceitServiceClient) Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FaceitService_Revoke_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faceitServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FaceitService_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faceitServiceClient) ResendVerification(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FaceitService_ResendVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faceitServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FaceitService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faceitServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FaceitService_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faceitServiceClient) SuspendUser(ctx context.Context, in *RestrictUserRequest, opts ...grpc.CallOption) (*UserProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserProfile)
+	err := c.cc.Invoke(ctx, FaceitService_SuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faceitServiceClient) BanUser(ctx context.Context, in *RestrictUserRequest, opts ...grpc.CallOption) (*UserProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserProfile)
+	err := c.cc.Invoke(ctx, FaceitService_BanUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faceitServiceClient) ReinstateUser(ctx context.Context, in *ReinstateUserRequest, opts ...grpc.CallOption) (*UserProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserProfile)
+	err := c.cc.Invoke(ctx, FaceitService_ReinstateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FaceitServiceServer is the server API for FaceitService service.
 // All implementations must embed UnimplementedFaceitServiceServer
 // for forward compatibility.
@@ -106,20 +392,109 @@ func (c *faceitServiceClient) ListUsersByCountry(ctx context.Context, in *UsersB
 type FaceitServiceServer interface {
 	// AddUser add new user.
 	//
-	// Receives a request with user data. Responses whether the user was added successfully or not.
-	AddUser(context.Context, *User) (*emptypb.Empty, error)
+	// Receives a request with user data and an optional id, a time-ordered UUIDv7 is generated when not given. Responses
+	// with the user added, along with its location on REST. Retried safely with the idempotency-key metadata, the
+	// Idempotency-Key header on REST.
+	AddUser(context.Context, *User) (*UserProfile, error)
 	// Update the user.
 	//
-	// Receives a request with user data. Responses whether the user was updated successfully or not.
-	UpdateUser(context.Context, *User) (*emptypb.Empty, error)
+	// Receives a request with user data, an optional update mask and an optional etag, from the If-Match header on REST.
+	// Responses with the updated user, ABORTED when the user changed since the etag. Retried safely with the
+	// idempotency-key metadata, the Idempotency-Key header on REST.
+	UpdateUser(context.Context, *User) (*UserProfile, error)
+	// Get the user.
+	//
+	// Receives a request with user data id and an optional read mask. Responses the user, without credentials.
+	GetUser(context.Context, *GetUserRequest) (*UserProfile, error)
 	// Delete the user.
 	//
-	// Receives a request with user data id. Responses whether the user was deleted successfully or not.
-	DeleteUser(context.Context, *UserID) (*emptypb.Empty, error)
+	// Receives a request with user data id and an optional etag, from the If-Match header on REST. Responses whether the
+	// user was deleted successfully or not, ABORTED when the user changed since the etag. The user can be restored with
+	// UndeleteUser within the grace period. Retried safely with the idempotency-key metadata, the Idempotency-Key header
+	// on REST.
+	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
+	// UndeleteUser restores the deleted user.
+	//
+	// Receives a request with user data id. Responses the restored user, as long as it was deleted within the grace
+	// period and is not purged yet.
+	UndeleteUser(context.Context, *UserID) (*UserProfile, error)
+	// ExportUserData exports the personal data of the user.
+	//
+	// Receives a request with user data id. Responses a JSON archive of every record stored about the user, deleted or
+	// not: its attributes, roles, tokens metadata, failed logins and events. Secrets are never exported.
+	ExportUserData(context.Context, *UserID) (*httpbody.HttpBody, error)
+	// EraseUser erases the personal data of the user, irreversibly.
+	//
+	// Receives a request with user data id. Responses the certificate of the erasure. The user is deleted, if it was
+	// not, and kept under its id with its personal data anonymized, it can not be restored.
+	EraseUser(context.Context, *UserID) (*ErasureCertificate, error)
 	// ListUsersByCountry list users by country.
 	//
-	// Receives a request with country data. Responses a list of users.
+	// Receives a request with country data. Responses a list of users, sorted by creation time.
 	ListUsersByCountry(context.Context, *UsersByCountry) (*UserList, error)
+	// ListUsers list the users matching the filter.
+	//
+	// Receives a request with an AIP-160 filter and an AIP-132 order by. Responses a list of users.
+	ListUsers(context.Context, *ListUsersRequest) (*UserList, error)
+	// ListUserAuditEvents list the audit log of the user mutations.
+	//
+	// Receives a request with an optional user id, actor and time range. Responses a list of audit events, the latest
+	// first.
+	ListUserAuditEvents(context.Context, *ListUserAuditEventsRequest) (*UserAuditEventList, error)
+	// WatchUsers streams the user changes.
+	//
+	// Receives a request with an optional country and resume token. Responses a stream of user events, starting after
+	// the event of the resume token when given, otherwise with the changes happening from now on.
+	WatchUsers(*WatchUsersRequest, grpc.ServerStreamingServer[UserEvent]) error
+	// Authenticate authenticates a user by email and password.
+	//
+	// Receives a request with the credentials of the user. Responses a session, a short-lived access token and a refresh
+	// token to obtain a new session. Logins of an email are locked out for a while after repeated failures.
+	Authenticate(context.Context, *AuthenticateRequest) (*Session, error)
+	// RefreshToken exchanges a refresh token for a new session.
+	//
+	// Receives a request with the refresh token. Responses a new session, the refresh token is not valid anymore.
+	// Reusing a refresh token already exchanged revokes the session.
+	RefreshToken(context.Context, *RefreshTokenRequest) (*Session, error)
+	// Revoke revokes a session.
+	//
+	// Receives a request with a refresh token of the session. Responses whether the session was revoked successfully or
+	// not, revoking an invalid refresh token succeeds.
+	Revoke(context.Context, *RevokeRequest) (*emptypb.Empty, error)
+	// VerifyEmail verifies the email of a user.
+	//
+	// Receives a request with the verification token emailed to the user. Responses whether the email was verified
+	// successfully or not.
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*emptypb.Empty, error)
+	// ResendVerification emails a new verification token to the user.
+	//
+	// Receives a request with user data id. Responses whether the verification was sent successfully or not, the
+	// tokens sent before are no longer valid.
+	ResendVerification(context.Context, *UserID) (*emptypb.Empty, error)
+	// RequestPasswordReset emails a password reset token to the user with the given email.
+	//
+	// Receives a request with the email of the user. Always responses successfully whether a user has the email or not,
+	// unless too many resets were requested for the email or from the client address.
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error)
+	// ResetPassword sets a new password to a user.
+	//
+	// Receives a request with the password reset token emailed to the user and the new password. Responses whether the
+	// password was reset successfully or not, the sessions of the user are revoked on success.
+	ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error)
+	// SuspendUser suspends the user, until the expire time when given.
+	//
+	// Receives a request with user data id, the reason and an optional expire time. Responses the suspended user, the
+	// sessions of the user are revoked and the user can no longer authenticate.
+	SuspendUser(context.Context, *RestrictUserRequest) (*UserProfile, error)
+	// BanUser bans the user, until the expire time when given.
+	//
+	// Receives a request with user data id, the reason and an optional expire time. Responses the banned user, the
+	// sessions of the user are revoked and the user can no longer authenticate.
+	BanUser(context.Context, *RestrictUserRequest) (*UserProfile, error)
+	// ReinstateUser reinstates the suspended or banned user.
+	//
+	// Receives a request with user data id and an optional reason. Responses the active user.
+	ReinstateUser(context.Context, *ReinstateUserRequest) (*UserProfile, error)
 	mustEmbedUnimplementedFaceitServiceServer()
 }
 
@@ -130,18 +505,69 @@ type FaceitServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedFaceitServiceServer struct{}
 
-func (UnimplementedFaceitServiceServer) AddUser(context.Context, *User) (*emptypb.Empty, error) {
+func (UnimplementedFaceitServiceServer) AddUser(context.Context, *User) (*UserProfile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddUser not implemented")
 }
-func (UnimplementedFaceitServiceServer) UpdateUser(context.Context, *User) (*emptypb.Empty, error) {
+func (UnimplementedFaceitServiceServer) UpdateUser(context.Context, *User) (*UserProfile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
 }
-func (UnimplementedFaceitServiceServer) DeleteUser(context.Context, *UserID) (*emptypb.Empty, error) {
+func (UnimplementedFaceitServiceServer) GetUser(context.Context, *GetUserRequest) (*UserProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedFaceitServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedFaceitServiceServer) UndeleteUser(context.Context, *UserID) (*UserProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteUser not implemented")
+}
+func (UnimplementedFaceitServiceServer) ExportUserData(context.Context, *UserID) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedFaceitServiceServer) EraseUser(context.Context, *UserID) (*ErasureCertificate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseUser not implemented")
+}
 func (UnimplementedFaceitServiceServer) ListUsersByCountry(context.Context, *UsersByCountry) (*UserList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUsersByCountry not implemented")
 }
+func (UnimplementedFaceitServiceServer) ListUsers(context.Context, *ListUsersRequest) (*UserList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedFaceitServiceServer) ListUserAuditEvents(context.Context, *ListUserAuditEventsRequest) (*UserAuditEventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserAuditEvents not implemented")
+}
+func (UnimplementedFaceitServiceServer) WatchUsers(*WatchUsersRequest, grpc.ServerStreamingServer[UserEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchUsers not implemented")
+}
+func (UnimplementedFaceitServiceServer) Authenticate(context.Context, *AuthenticateRequest) (*Session, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
+}
+func (UnimplementedFaceitServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*Session, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedFaceitServiceServer) Revoke(context.Context, *RevokeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
+}
+func (UnimplementedFaceitServiceServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedFaceitServiceServer) ResendVerification(context.Context, *UserID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendVerification not implemented")
+}
+func (UnimplementedFaceitServiceServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedFaceitServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedFaceitServiceServer) SuspendUser(context.Context, *RestrictUserRequest) (*UserProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedFaceitServiceServer) BanUser(context.Context, *RestrictUserRequest) (*UserProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanUser not implemented")
+}
+func (UnimplementedFaceitServiceServer) ReinstateUser(context.Context, *ReinstateUserRequest) (*UserProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReinstateUser not implemented")
+}
 func (UnimplementedFaceitServiceServer) mustEmbedUnimplementedFaceitServiceServer() {}
 func (UnimplementedFaceitServiceServer) testEmbeddedByValue()                       {}
 
@@ -199,8 +625,26 @@ func _FaceitService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FaceitService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FaceitService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserID)
+	in := new(DeleteUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -212,7 +656,61 @@ func _FaceitService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: FaceitService_DeleteUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FaceitServiceServer).DeleteUser(ctx, req.(*UserID))
+		return srv.(FaceitServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaceitService_UndeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).UndeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_UndeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).UndeleteUser(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaceitService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).ExportUserData(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaceitService_EraseUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).EraseUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_EraseUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).EraseUser(ctx, req.(*UserID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -235,6 +733,233 @@ func _FaceitService_ListUsersByCountry_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FaceitService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaceitService_ListUserAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).ListUserAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_ListUserAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).ListUserAuditEvents(ctx, req.(*ListUserAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaceitService_WatchUsers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchUsersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FaceitServiceServer).WatchUsers(m, &grpc.GenericServerStream[WatchUsersRequest, UserEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FaceitService_WatchUsersServer = grpc.ServerStreamingServer[UserEvent]
+
+func _FaceitService_Authenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).Authenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_Authenticate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).Authenticate(ctx, req.(*AuthenticateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaceitService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaceitService_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_Revoke_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).Revoke(ctx, req.(*RevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaceitService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaceitService_ResendVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).ResendVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_ResendVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).ResendVerification(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaceitService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaceitService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaceitService_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestrictUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_SuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).SuspendUser(ctx, req.(*RestrictUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaceitService_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestrictUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_BanUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).BanUser(ctx, req.(*RestrictUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaceitService_ReinstateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReinstateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaceitServiceServer).ReinstateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaceitService_ReinstateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaceitServiceServer).ReinstateUser(ctx, req.(*ReinstateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FaceitService_ServiceDesc is the grpc.ServiceDesc for FaceitService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -250,15 +975,85 @@ var FaceitService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUser",
 			Handler:    _FaceitService_UpdateUser_Handler,
 		},
+		{
+			MethodName: "GetUser",
+			Handler:    _FaceitService_GetUser_Handler,
+		},
 		{
 			MethodName: "DeleteUser",
 			Handler:    _FaceitService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "UndeleteUser",
+			Handler:    _FaceitService_UndeleteUser_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _FaceitService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "EraseUser",
+			Handler:    _FaceitService_EraseUser_Handler,
+		},
 		{
 			MethodName: "ListUsersByCountry",
 			Handler:    _FaceitService_ListUsersByCountry_Handler,
 		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _FaceitService_ListUsers_Handler,
+		},
+		{
+			MethodName: "ListUserAuditEvents",
+			Handler:    _FaceitService_ListUserAuditEvents_Handler,
+		},
+		{
+			MethodName: "Authenticate",
+			Handler:    _FaceitService_Authenticate_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _FaceitService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Revoke",
+			Handler:    _FaceitService_Revoke_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _FaceitService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "ResendVerification",
+			Handler:    _FaceitService_ResendVerification_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _FaceitService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _FaceitService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _FaceitService_SuspendUser_Handler,
+		},
+		{
+			MethodName: "BanUser",
+			Handler:    _FaceitService_BanUser_Handler,
+		},
+		{
+			MethodName: "ReinstateUser",
+			Handler:    _FaceitService_ReinstateUser_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchUsers",
+			Handler:       _FaceitService_WatchUsers_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }
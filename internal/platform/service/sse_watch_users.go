@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/auth"
+	"github.com/dohernandez/faceit/internal/platform/notifier"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// headerLastEventID is the header an EventSource sends when reconnecting, with the id of the last event received.
+	headerLastEventID = "Last-Event-ID"
+	// queryAccessToken is the query parameter bearing the token of the clients unable to send the Authorization header.
+	queryAccessToken = "access_token"
+)
+
+// SSEConfig represents the Server-Sent Events configuration.
+type SSEConfig struct {
+	// Heartbeat is the time between two heartbeat comments sent to keep the connection alive.
+	Heartbeat time.Duration `split_words:"true" default:"15s"`
+	// BufferSize is the number of events buffered per connection, a connection falling further behind is closed.
+	BufferSize int `split_words:"true" default:"64"`
+	// WriteTimeout is the maximum time to write an event to the connection.
+	WriteTimeout time.Duration `split_words:"true" default:"10s"`
+}
+
+// sseError is the data of the error event sent before closing the stream.
+type sseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// WatchUsersSSE streams the user changes as Server-Sent Events.
+//
+// Receives a request with an optional country query parameter. Every event carries its resume token as id, so an
+// EventSource reconnecting sends it back in the Last-Event-ID header and continues after that event. The event data
+// is the CloudEvents JSON event.
+func (s *FaceitService) WatchUsersSSE(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ctx := ctxd.AddFields(r.Context(), "service", "FaceitService")
+
+	// The stream is not served by the gRPC server, it is authorized as the WatchUsers calls.
+	authorization := r.Header.Get(headerAuthorization)
+
+	// An EventSource can not send headers, the token is then accepted in the query, RFC 6750 section 2.3.
+	if token := r.URL.Query().Get(queryAccessToken); authorization == "" && token != "" {
+		authorization = tokenTypeBearer + " " + token
+	}
+
+	ctx, err := s.deps.Authenticator().Authorize(ctx, authorization, auth.ScopeUsersRead)
+	if err != nil {
+		st, _ := status.FromError(authError(err))
+		code := runtime.HTTPStatusFromCode(st.Code())
+
+		if code == http.StatusUnauthorized {
+			w.Header().Set("WWW-Authenticate", tokenTypeBearer)
+		}
+
+		writeJSON(w, code, map[string]any{"code": code, "message": st.Message()})
+
+		return
+	}
+
+	country := r.URL.Query().Get("country")
+	if country != "" && len(country) != 2 {
+		writeJSON(w, http.StatusBadRequest, map[string]any{
+			"code":    http.StatusBadRequest,
+			"message": "validation error",
+			"details": []map[string]string{{"field": "country", "description": "must have 2 characters"}},
+		})
+
+		return
+	}
+
+	cfg := s.deps.SSEConfig()
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	defer rc.SetWriteDeadline(time.Time{}) //nolint:errcheck
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan model.UserEvent, cfg.BufferSize)
+	done := make(chan error, 1)
+
+	go func() {
+		done <- s.deps.WatchUsers().WatchUsers(ctx, country, r.Header.Get(headerLastEventID), func(e model.UserEvent) error {
+			select {
+			case events <- e:
+				return nil
+			default:
+				return usecase.ErrSlowSubscriber
+			}
+		})
+	}()
+
+	heartbeat := time.NewTicker(cfg.Heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		var err error
+
+		select {
+		case <-ctx.Done():
+			return
+		case err = <-done:
+			if ctx.Err() != nil {
+				return
+			}
+
+			// Events buffered before the stream ended are still sent.
+			for len(events) > 0 {
+				if err := writeEvent(rc, w, cfg, <-events); err != nil {
+					return
+				}
+			}
+
+			if err != nil {
+				s.writeError(ctx, rc, w, cfg, err)
+			}
+
+			return
+		case e := <-events:
+			err = writeEvent(rc, w, cfg, e)
+		case <-heartbeat.C:
+			err = write(rc, w, cfg, ": heartbeat\n\n")
+		}
+
+		if err != nil {
+			s.deps.Logger().Debug(ctx, "watch users stream closed", "error", err)
+
+			return
+		}
+	}
+}
+
+// writeJSON writes the response, before the stream starts.
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	_ = json.NewEncoder(w).Encode(v) //nolint:errcheck
+}
+
+// writeEvent writes the event, the id is the resume token of the event.
+func writeEvent(rc *http.ResponseController, w http.ResponseWriter, cfg SSEConfig, e model.UserEvent) error {
+	data, err := notifier.MarshalStructured(e)
+	if err != nil {
+		return err
+	}
+
+	return write(rc, w, cfg, fmt.Sprintf("id: %s\nevent: %s\ndata: %s\n\n", e.ResumeToken, e.Type, data))
+}
+
+// writeError writes the error event ending the stream.
+func (s *FaceitService) writeError(ctx context.Context, rc *http.ResponseController, w http.ResponseWriter, cfg SSEConfig, err error) {
+	e := sseError{Code: http.StatusInternalServerError, Message: "ups, something went wrong!"}
+
+	switch {
+	case errors.Is(err, usecase.ErrInvalidResumeToken):
+		e = sseError{Code: http.StatusBadRequest, Message: "invalid resume token"}
+	case errors.Is(err, usecase.ErrSlowSubscriber):
+		e = sseError{Code: http.StatusTooManyRequests, Message: "too slow consuming the events, resume watching"}
+	case errors.Is(err, usecase.ErrPermissionDenied):
+		e = sseError{Code: http.StatusForbidden, Message: "permission denied"}
+	default:
+		s.deps.Logger().Error(ctx, "watch users", "error", err)
+	}
+
+	data, _ := json.Marshal(e) //nolint:errcheck // Encoding a struct of strings and ints never fails.
+
+	_ = write(rc, w, cfg, fmt.Sprintf("event: error\ndata: %s\n\n", data)) //nolint:errcheck
+}
+
+// write writes the message and flushes it to the client within the write timeout.
+func write(rc *http.ResponseController, w http.ResponseWriter, cfg SSEConfig, msg string) error {
+	if err := rc.SetWriteDeadline(time.Now().Add(cfg.WriteTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	if _, err := w.Write([]byte(msg)); err != nil {
+		return err
+	}
+
+	return rc.Flush()
+}
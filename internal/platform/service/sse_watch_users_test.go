@@ -0,0 +1,275 @@
+package service_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/auth"
+	"github.com/dohernandez/faceit/internal/platform/service"
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type watchUsers func(ctx context.Context, country, resumeToken string, fn func(e model.UserEvent) error) error
+
+func (w watchUsers) WatchUsers(ctx context.Context, country, resumeToken string, fn func(e model.UserEvent) error) error {
+	return w(ctx, country, resumeToken, fn)
+}
+
+type deps struct {
+	service.FaceitServiceDeps
+
+	watchUsers watchUsers
+	getUser    getUser
+}
+
+func (d deps) Logger() ctxd.Logger {
+	return ctxd.NoOpLogger{}
+}
+
+func (d deps) GRPCAddr() string {
+	return "localhost:0"
+}
+
+func (d deps) SSEConfig() service.SSEConfig {
+	return service.SSEConfig{Heartbeat: 20 * time.Millisecond, BufferSize: 10, WriteTimeout: time.Second}
+}
+
+func (d deps) WatchUsers() service.WatchUsers {
+	return d.watchUsers
+}
+
+func (d deps) Authenticator() *auth.Authenticator {
+	return newAuthenticator()
+}
+
+// newAuthenticator creates an authenticator granting the scopes of the key named after them.
+func newAuthenticator() *auth.Authenticator {
+	ks, err := auth.NewKeySet(bytes.Repeat([]byte{1}, 32))
+	if err != nil {
+		panic(err)
+	}
+
+	return auth.NewAuthenticator(ks, nil, clock.New(), auth.Config{
+		Issuer: "faceit",
+		APIKeys: auth.APIKeys{
+			{Name: "reader", KeySHA256: auth.HashAPIKey("reader-key"), Scopes: []string{auth.ScopeUsersRead}},
+			{Name: "writer", KeySHA256: auth.HashAPIKey("writer-key"), Scopes: []string{auth.ScopeUsersWrite}},
+		},
+	})
+}
+
+func newServer(t *testing.T, w watchUsers) *httptest.Server {
+	t.Helper()
+
+	mux := runtime.NewServeMux()
+
+	require.NoError(t, service.NewFaceitService(deps{watchUsers: w}).RegisterServiceHandler(mux))
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// get requests the URL with the key of the reader.
+func get(t *testing.T, url string) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer reader-key")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		resp.Body.Close() //nolint:errcheck,gosec
+	})
+
+	return resp
+}
+
+// readUntil reads the stream until the line is received, returning the lines read.
+func readUntil(t *testing.T, r *bufio.Reader, line string) []string {
+	t.Helper()
+
+	var lines []string
+
+	for {
+		l, err := r.ReadString('\n')
+		require.NoError(t, err)
+
+		l = strings.TrimSuffix(l, "\n")
+		lines = append(lines, l)
+
+		if l == line {
+			return lines
+		}
+	}
+}
+
+func TestFaceitService_WatchUsersSSE(t *testing.T) {
+	t.Parallel()
+
+	event := model.UserEvent{
+		ID:          uuid.MustParse("9b8c4a34-42b0-4cb4-9d0f-5bd8e2a38bc1"),
+		Source:      "/faceit/users",
+		Type:        model.UserDeletedV1,
+		Subject:     uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2"),
+		Time:        time.Date(2024, 12, 16, 10, 0, 0, 0, time.UTC),
+		Data:        model.UserEventData{ID: "26ef0140-c436-4838-a271-32652c72f6f2"},
+		Country:     "UK",
+		ResumeToken: "token-2",
+	}
+
+	t.Run("success, resumes and sends heartbeats", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newServer(t, func(ctx context.Context, country, resumeToken string, fn func(e model.UserEvent) error) error {
+			assert.Equal(t, "UK", country)
+			assert.Equal(t, "token-1", resumeToken)
+
+			if err := fn(event); err != nil {
+				return err
+			}
+
+			<-ctx.Done()
+
+			return ctx.Err()
+		})
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/v1/users:watch?country=UK", nil)
+		require.NoError(t, err)
+
+		req.Header.Set("Last-Event-ID", "token-1")
+		req.Header.Set("Authorization", "Bearer reader-key")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+
+		defer resp.Body.Close() //nolint:errcheck
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		r := bufio.NewReader(resp.Body)
+
+		lines := readUntil(t, r, "")
+		assert.Equal(t, []string{
+			"id: token-2",
+			"event: com.faceit.user.deleted.v1",
+			`data: {"specversion":"1.0","id":"9b8c4a34-42b0-4cb4-9d0f-5bd8e2a38bc1","source":"/faceit/users","type":"com.faceit.user.deleted.v1","subject":"26ef0140-c436-4838-a271-32652c72f6f2","time":"2024-12-16T10:00:00Z","datacontenttype":"application/json","data":{"id":"26ef0140-c436-4838-a271-32652c72f6f2"}}`,
+			"",
+		}, lines)
+
+		readUntil(t, r, ": heartbeat")
+	})
+
+	t.Run("error event, slow subscriber", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newServer(t, func(_ context.Context, _, _ string, fn func(e model.UserEvent) error) error {
+			for {
+				if err := fn(event); err != nil {
+					return err
+				}
+			}
+		})
+
+		resp := get(t, srv.URL+"/v1/users:watch")
+
+		lines := readUntil(t, bufio.NewReader(resp.Body), "event: error")
+		assert.Contains(t, strings.Join(lines, "\n"), "id: token-2")
+	})
+
+	t.Run("error event, invalid resume token", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newServer(t, func(_ context.Context, _, _ string, _ func(e model.UserEvent) error) error {
+			return usecase.ErrInvalidResumeToken
+		})
+
+		resp := get(t, srv.URL+"/v1/users:watch")
+
+		lines := readUntil(t, bufio.NewReader(resp.Body), "")
+		assert.Equal(t, []string{"event: error", `data: {"code":400,"message":"invalid resume token"}`, ""}, lines)
+	})
+
+	t.Run("invalid country", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newServer(t, nil)
+
+		resp := get(t, srv.URL+"/v1/users:watch?country=UKK")
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newServer(t, nil)
+
+		resp, err := http.Get(srv.URL + "/v1/users:watch") //nolint:noctx
+		require.NoError(t, err)
+
+		defer resp.Body.Close() //nolint:errcheck
+
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		assert.Equal(t, "Bearer", resp.Header.Get("WWW-Authenticate"))
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"code":401,"message":"missing bearer token"}`, string(body))
+	})
+
+	t.Run("token in query", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newServer(t, func(ctx context.Context, _, _ string, _ func(e model.UserEvent) error) error {
+			<-ctx.Done()
+
+			return ctx.Err()
+		})
+
+		resp, err := http.Get(srv.URL + "/v1/users:watch?access_token=reader-key") //nolint:noctx
+		require.NoError(t, err)
+
+		defer resp.Body.Close() //nolint:errcheck
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("insufficient scope", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newServer(t, nil)
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/v1/users:watch", nil)
+		require.NoError(t, err)
+
+		req.Header.Set("Authorization", "Bearer writer-key")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+
+		defer resp.Body.Close() //nolint:errcheck
+
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dohernandez/faceit/internal/domain/model"
+	api "github.com/dohernandez/faceit/internal/platform/service/pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// userToProto maps the user to the response user, credentials are left out.
+func userToProto(u *model.User, mask *fieldmaskpb.FieldMask) *api.UserProfile {
+	p := &api.UserProfile{
+		Id:        u.ID.String(),
+		Email:     &u.Email,
+		FirstName: &u.FirstName,
+		LastName:  &u.LastName,
+		Nickname:  &u.Nickname,
+		Country:   &u.Country,
+
+		EmailVerified: proto.Bool(u.EmailVerified()),
+		Status:        proto.String(string(u.Status)),
+	}
+
+	if u.StatusReason != "" {
+		p.StatusReason = &u.StatusReason
+	}
+
+	if u.StatusExpiresAt != nil {
+		p.StatusExpireTime = timestamppb.New(*u.StatusExpiresAt)
+	}
+
+	p.Etag = etagOf(u.Version)
+
+	if !u.CreatedAt.IsZero() {
+		p.CreateTime = timestamppb.New(u.CreatedAt)
+	}
+
+	if !u.UpdatedAt.IsZero() {
+		p.UpdateTime = timestamppb.New(u.UpdatedAt)
+	}
+
+	applyReadMask(p, mask)
+
+	return p
+}
+
+// usersToProto maps the users to the response users, credentials are left out.
+func usersToProto(users []*model.User, mask *fieldmaskpb.FieldMask) []*api.UserProfile {
+	list := make([]*api.UserProfile, 0, len(users))
+
+	for _, u := range users {
+		list = append(list, userToProto(u, mask))
+	}
+
+	return list
+}
+
+// isReadMaskValid validates the paths of the read mask against the UserProfile message.
+//
+// The validation error of the read_mask field is added to the field errors when invalid.
+func isReadMaskValid(fields map[string]string, mask *fieldmaskpb.FieldMask) (map[string]string, bool) {
+	var unknown []string
+
+	for _, path := range mask.GetPaths() {
+		if _, err := fieldmaskpb.New(&api.UserProfile{}, path); err != nil {
+			unknown = append(unknown, path)
+		}
+	}
+
+	if len(unknown) == 0 {
+		return fields, true
+	}
+
+	if fields == nil {
+		fields = make(map[string]string)
+	}
+
+	fields["read_mask"] = fmt.Sprintf("unknown fields %s", strings.Join(unknown, ", "))
+
+	return fields, false
+}
+
+// maskTree is the tree of the field mask paths, a field without subfields is kept as a whole.
+type maskTree map[string]maskTree
+
+// applyReadMask clears the fields of the message not in the read mask, all the fields are kept when the mask is
+// empty.
+func applyReadMask(m *api.UserProfile, mask *fieldmaskpb.FieldMask) {
+	if len(mask.GetPaths()) == 0 {
+		return
+	}
+
+	tree := make(maskTree)
+
+	for _, path := range mask.GetPaths() {
+		node := tree
+
+		for _, name := range strings.Split(path, ".") {
+			if node[name] == nil {
+				node[name] = make(maskTree)
+			}
+
+			node = node[name]
+		}
+	}
+
+	prune(m.ProtoReflect(), tree)
+}
+
+func prune(m protoreflect.Message, tree maskTree) {
+	var clear []protoreflect.FieldDescriptor
+
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		sub, ok := tree[string(fd.Name())]
+
+		switch {
+		case !ok:
+			clear = append(clear, fd)
+		case len(sub) > 0 && fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+			prune(m.Mutable(fd).Message(), sub)
+		}
+
+		return true
+	})
+
+	for _, fd := range clear {
+		m.Clear(fd)
+	}
+}
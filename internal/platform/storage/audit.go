@@ -0,0 +1,173 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/google/uuid"
+)
+
+// AuditTable is the table name for audit events.
+const AuditTable = "audit_events"
+
+// AuditEvent represents a stored audit entry.
+type AuditEvent struct {
+	ID        int64             `db:"id,omitempty"`
+	UserID    model.UserID      `db:"user_id"`
+	Action    model.AuditAction `db:"action"`
+	Actor     string            `db:"actor"`
+	RequestID string            `db:"request_id"`
+	SourceIP  string            `db:"source_ip"`
+	Changes   []byte            `db:"changes"` // JSON encoded changes of the attributes, by attribute
+	CreatedAt time.Time         `db:"created_at,omitempty"`
+}
+
+// Audit represents an Audit repository, the entries are only appended, never updated but on erasure.
+type Audit struct {
+	storage *sqluct.Storage
+
+	// col names for audit events table search
+	colID        string
+	colUserID    string
+	colActor     string
+	colCreatedAt string
+}
+
+// NewAudit returns instance of Audit repository.
+func NewAudit(storage *sqluct.Storage) *Audit {
+	var e AuditEvent
+
+	return &Audit{
+		storage:      storage,
+		colID:        storage.Mapper.Col(&e, &e.ID),
+		colUserID:    storage.Mapper.Col(&e, &e.UserID),
+		colActor:     storage.Mapper.Col(&e, &e.Actor),
+		colCreatedAt: storage.Mapper.Col(&e, &e.CreatedAt),
+	}
+}
+
+// RecordAudit appends the audit entry, setting its id and time.
+func (s *Audit) RecordAudit(ctx context.Context, e *model.AuditEntry) error {
+	changes, err := json.Marshal(e.Changes)
+	if err != nil {
+		return err
+	}
+
+	row := AuditEvent{
+		UserID:    e.UserID,
+		Action:    e.Action,
+		Actor:     e.Actor,
+		RequestID: e.RequestID,
+		SourceIP:  e.SourceIP,
+		Changes:   changes,
+	}
+
+	q := s.storage.InsertStmt(AuditTable, row).
+		Suffix("RETURNING " + s.colID + ", " + s.colCreatedAt)
+
+	if err := s.storage.Select(ctx, q, &row); err != nil {
+		return err
+	}
+
+	e.ID = row.ID
+	e.CreatedAt = row.CreatedAt
+
+	return nil
+}
+
+// ListAuditEntries lists the page of audit entries matching the filter, the latest first, after the cursor, from the
+// latest entry when nil.
+//
+// Entries are sorted by id, the order they are recorded, so the cursor is the id of the last entry of the page.
+func (s *Audit) ListAuditEntries(ctx context.Context, f model.AuditFilter, limit uint64, after model.Cursor) (model.AuditPage, error) {
+	if limit == 0 {
+		return model.AuditPage{}, nil
+	}
+
+	q := s.storage.SelectStmt(AuditTable, AuditEvent{})
+
+	if f.UserID != uuid.Nil {
+		q = q.Where(squirrel.Eq{s.colUserID: f.UserID})
+	}
+
+	if f.Actor != "" {
+		q = q.Where(squirrel.Eq{s.colActor: f.Actor})
+	}
+
+	if !f.Since.IsZero() {
+		q = q.Where(squirrel.GtOrEq{s.colCreatedAt: f.Since})
+	}
+
+	if !f.Until.IsZero() {
+		q = q.Where(squirrel.Lt{s.colCreatedAt: f.Until})
+	}
+
+	if after != nil {
+		if len(after) != 1 {
+			return model.AuditPage{}, fmt.Errorf("%w: cursor of %d values", usecase.ErrInvalidPageToken, len(after))
+		}
+
+		id, err := strconv.ParseInt(after[0], 10, 64)
+		if err != nil {
+			return model.AuditPage{}, fmt.Errorf("%w: %w", usecase.ErrInvalidPageToken, err)
+		}
+
+		q = q.Where(squirrel.Lt{s.colID: id})
+	}
+
+	// One more entry tells whether there is a next page.
+	q = q.OrderBy(s.colID + " DESC").Limit(limit + 1)
+
+	var rows []AuditEvent
+
+	if err := s.storage.Select(ctx, q, &rows); err != nil {
+		return model.AuditPage{}, err
+	}
+
+	var page model.AuditPage
+
+	if uint64(len(rows)) > limit {
+		rows = rows[:limit]
+		page.Next = model.Cursor{strconv.FormatInt(rows[limit-1].ID, 10)}
+	}
+
+	page.Entries = make([]*model.AuditEntry, 0, len(rows))
+
+	for _, r := range rows {
+		e, err := r.entry()
+		if err != nil {
+			return model.AuditPage{}, err
+		}
+
+		page.Entries = append(page.Entries, e)
+	}
+
+	return page, nil
+}
+
+// entry decodes the stored audit entry.
+func (e *AuditEvent) entry() (*model.AuditEntry, error) {
+	var changes map[string]model.AuditChange
+
+	if err := json.Unmarshal(e.Changes, &changes); err != nil {
+		return nil, err
+	}
+
+	return &model.AuditEntry{
+		ID:        e.ID,
+		UserID:    e.UserID,
+		Action:    e.Action,
+		Actor:     e.Actor,
+		RequestID: e.RequestID,
+		SourceIP:  e.SourceIP,
+		Changes:   changes,
+		CreatedAt: e.CreatedAt,
+	}, nil
+}
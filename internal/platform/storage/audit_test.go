@@ -0,0 +1,133 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAudit_RecordAudit(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+	createdAt := time.Date(2024, 12, 26, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`INSERT INTO audit_events (user_id,action,actor,request_id,source_ip,changes) VALUES ($1,$2,$3,$4,$5,$6) RETURNING id, created_at`).
+		WithArgs(userID, model.AuditUserUpdated, "admin", "req-1", "10.0.0.1", []byte(`{"first_name":{"before":"Alice","after":"Alicia"}}`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(7, createdAt))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewAudit(st)
+
+	e := &model.AuditEntry{
+		UserID:    userID,
+		Action:    model.AuditUserUpdated,
+		Actor:     "admin",
+		RequestID: "req-1",
+		SourceIP:  "10.0.0.1",
+		Changes:   map[string]model.AuditChange{"first_name": {Before: "Alice", After: "Alicia"}},
+	}
+
+	err = repo.RecordAudit(context.Background(), e)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(7), e.ID)
+	require.Equal(t, createdAt, e.CreatedAt)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAudit_ListAuditEntries(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+	since := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	columns := []string{"id", "user_id", "action", "actor", "request_id", "source_ip", "changes", "created_at"}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(`SELECT id, user_id, action, actor, request_id, source_ip, changes, created_at FROM audit_events WHERE user_id = $1 AND actor = $2 AND created_at >= $3 AND created_at < $4 AND id < $5 ORDER BY id DESC LIMIT 3`).
+			WithArgs(userID, "admin", since, until, int64(10)).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(9, userID, model.AuditUserUpdated, "admin", "req-2", "", []byte(`{}`), since).
+				AddRow(8, userID, model.AuditUserAdded, "admin", "req-1", "", []byte(`{"email":{"before":"","after":"alice@bob.com"}}`), since).
+				AddRow(5, userID, model.AuditUserAdded, "admin", "req-0", "", []byte(`{}`), since))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewAudit(st)
+
+		page, err := repo.ListAuditEntries(context.Background(), model.AuditFilter{
+			UserID: userID,
+			Actor:  "admin",
+			Since:  since,
+			Until:  until,
+		}, 2, model.Cursor{"10"})
+		require.NoError(t, err)
+
+		require.Len(t, page.Entries, 2)
+		require.Equal(t, model.Cursor{"8"}, page.Next)
+		require.Equal(t, map[string]model.AuditChange{"email": {After: "alice@bob.com"}}, page.Entries[1].Changes)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(`SELECT id, user_id, action, actor, request_id, source_ip, changes, created_at FROM audit_events ORDER BY id DESC LIMIT 3`).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(1, userID, model.AuditUserAdded, "admin", "req-0", "", []byte(`{}`), since))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewAudit(st)
+
+		page, err := repo.ListAuditEntries(context.Background(), model.AuditFilter{}, 2, nil)
+		require.NoError(t, err)
+
+		require.Len(t, page.Entries, 1)
+		require.Nil(t, page.Next)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		t.Parallel()
+
+		db, _, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewAudit(st)
+
+		_, err = repo.ListAuditEntries(context.Background(), model.AuditFilter{}, 2, model.Cursor{"abc"})
+		require.ErrorIs(t, err, usecase.ErrInvalidPageToken)
+	})
+}
@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKeyTable is the table name for idempotency keys.
+const IdempotencyKeyTable = "idempotency_keys"
+
+// IdempotencyKey represents a key a client identified a mutation with, and the outcome of the mutation replayed on
+// its retries.
+//
+// Keys are scoped by the subject of the actor. The response is empty while the mutation is in progress, the mutation
+// holding the key until LockedUntil. The response holds the personal data of the user the mutation acted on, it is
+// exported and erased along with the user.
+type IdempotencyKey struct {
+	Subject     string        `db:"subject"`
+	Key         string        `db:"key"`
+	Method      string        `db:"method"`
+	Fingerprint string        `db:"fingerprint"`
+	Response    []byte        `db:"response"`
+	Header      []byte        `db:"header"`  // JSON encoded header metadata of the response, by key
+	UserID      uuid.NullUUID `db:"user_id"` // User the mutation acted on, set once completed
+	CreatedAt   time.Time     `db:"created_at"`
+	ExpiresAt   time.Time     `db:"expires_at"`
+	LockedUntil time.Time     `db:"locked_until"`
+}
+
+// Idempotency represents an Idempotency repository, storing the idempotency keys.
+type Idempotency struct {
+	storage *sqluct.Storage
+
+	// col names for idempotency keys table search
+	colSubject     string
+	colKey         string
+	colMethod      string
+	colFingerprint string
+	colResponse    string
+	colHeader      string
+	colUserID      string
+	colCreatedAt   string
+	colExpiresAt   string
+	colLockedUntil string
+}
+
+// NewIdempotency returns instance of Idempotency repository.
+func NewIdempotency(storage *sqluct.Storage) *Idempotency {
+	var k IdempotencyKey
+
+	return &Idempotency{
+		storage:        storage,
+		colSubject:     storage.Mapper.Col(&k, &k.Subject),
+		colKey:         storage.Mapper.Col(&k, &k.Key),
+		colMethod:      storage.Mapper.Col(&k, &k.Method),
+		colFingerprint: storage.Mapper.Col(&k, &k.Fingerprint),
+		colResponse:    storage.Mapper.Col(&k, &k.Response),
+		colHeader:      storage.Mapper.Col(&k, &k.Header),
+		colUserID:      storage.Mapper.Col(&k, &k.UserID),
+		colCreatedAt:   storage.Mapper.Col(&k, &k.CreatedAt),
+		colExpiresAt:   storage.Mapper.Col(&k, &k.ExpiresAt),
+		colLockedUntil: storage.Mapper.Col(&k, &k.LockedUntil),
+	}
+}
+
+// ReserveIdempotencyKey stores the key of a mutation in progress, replacing the key stored when expired, or when
+// still in progress once its lock lapsed, the mutation holding it abandoned.
+//
+// Returns false when the key is already stored and not expired, the stored key is found with FindIdempotencyKey.
+func (s *Idempotency) ReserveIdempotencyKey(ctx context.Context, k *IdempotencyKey) (bool, error) {
+	q := s.storage.QueryBuilder().Insert(IdempotencyKeyTable).
+		Columns(s.colSubject, s.colKey, s.colMethod, s.colFingerprint, s.colCreatedAt, s.colExpiresAt, s.colLockedUntil).
+		Values(k.Subject, k.Key, k.Method, k.Fingerprint, k.CreatedAt, k.ExpiresAt, k.LockedUntil).
+		Suffix("ON CONFLICT (" + s.colSubject + ", " + s.colKey + ") DO UPDATE SET " +
+			s.colMethod + " = EXCLUDED." + s.colMethod + ", " +
+			s.colFingerprint + " = EXCLUDED." + s.colFingerprint + ", " +
+			s.colResponse + " = NULL, " +
+			s.colHeader + " = NULL, " +
+			s.colUserID + " = NULL, " +
+			s.colCreatedAt + " = EXCLUDED." + s.colCreatedAt + ", " +
+			s.colExpiresAt + " = EXCLUDED." + s.colExpiresAt + ", " +
+			s.colLockedUntil + " = EXCLUDED." + s.colLockedUntil + " " +
+			"WHERE " + IdempotencyKeyTable + "." + s.colExpiresAt + " <= EXCLUDED." + s.colCreatedAt + " " +
+			"OR (" + IdempotencyKeyTable + "." + s.colResponse + " IS NULL " +
+			"AND " + IdempotencyKeyTable + "." + s.colLockedUntil + " <= EXCLUDED." + s.colCreatedAt + ")")
+
+	res, err := s.storage.Exec(ctx, q)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// FindIdempotencyKey finds the key of the subject.
+//
+// Returns database.ErrNotFound when the key is not stored, e.g. released in between.
+func (s *Idempotency) FindIdempotencyKey(ctx context.Context, subject, key string) (*IdempotencyKey, error) {
+	q := s.storage.SelectStmt(IdempotencyKeyTable, IdempotencyKey{}).
+		Where(squirrel.Eq{s.colSubject: subject, s.colKey: key})
+
+	var k IdempotencyKey
+
+	err := s.storage.Select(ctx, q, &k)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, database.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return &k, nil
+}
+
+// CompleteIdempotencyKey stores the outcome of the mutation of the key of the subject, along the user it acted on,
+// uuid.Nil when unknown.
+func (s *Idempotency) CompleteIdempotencyKey(ctx context.Context, subject, key string, userID model.UserID, response, header []byte) error {
+	q := s.storage.QueryBuilder().Update(IdempotencyKeyTable).
+		Set(s.colResponse, response).
+		Set(s.colHeader, header).
+		Set(s.colUserID, uuid.NullUUID{UUID: userID, Valid: userID != uuid.Nil}).
+		Where(squirrel.Eq{s.colSubject: subject, s.colKey: key})
+
+	_, err := s.storage.Exec(ctx, q)
+
+	return err
+}
+
+// ReleaseIdempotencyKey deletes the key of the subject of a mutation in progress, so the mutation can be retried
+// with it.
+func (s *Idempotency) ReleaseIdempotencyKey(ctx context.Context, subject, key string) error {
+	q := s.storage.DeleteStmt(IdempotencyKeyTable).
+		Where(squirrel.Eq{s.colSubject: subject, s.colKey: key, s.colResponse: nil})
+
+	_, err := s.storage.Exec(ctx, q)
+
+	return err
+}
+
+// PurgeExpiredIdempotencyKeys deletes up to limit keys expired at the given time, returning the number of keys
+// deleted.
+func (s *Idempotency) PurgeExpiredIdempotencyKeys(ctx context.Context, at time.Time, limit uint64) (int, error) {
+	expired, args, err := squirrel.Select(s.colSubject, s.colKey).
+		From(IdempotencyKeyTable).
+		Where(squirrel.LtOrEq{s.colExpiresAt: at}).
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := s.storage.DeleteStmt(IdempotencyKeyTable).
+		Where("("+s.colSubject+", "+s.colKey+") IN ("+expired+")", args...)
+
+	res, err := s.storage.Exec(ctx, q)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), nil
+}
@@ -0,0 +1,163 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotency_ReserveIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, 12, 29, 9, 0, 0, 0, time.UTC)
+
+	k := &storage.IdempotencyKey{
+		Subject:     "backoffice",
+		Key:         "add-alice",
+		Method:      "/api.faceit.FaceitService/AddUser",
+		Fingerprint: "5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8",
+		CreatedAt:   at,
+		ExpiresAt:   at.Add(24 * time.Hour),
+		LockedUntil: at.Add(time.Minute),
+	}
+
+	for _, tc := range []struct {
+		name         string
+		rowsAffected int64
+		reserved     bool
+	}{
+		{name: "reserved", rowsAffected: 1, reserved: true},
+		{name: "already stored", rowsAffected: 0, reserved: false},
+		{name: "taken over, expired or abandoned", rowsAffected: 1, reserved: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			mock.ExpectExec(`
+			INSERT INTO idempotency_keys (subject,key,method,fingerprint,created_at,expires_at,locked_until) VALUES ($1,$2,$3,$4,$5,$6,$7) ON CONFLICT (subject, key) DO UPDATE SET method = EXCLUDED.method, fingerprint = EXCLUDED.fingerprint, response = NULL, header = NULL, user_id = NULL, created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at, locked_until = EXCLUDED.locked_until WHERE idempotency_keys.expires_at <= EXCLUDED.created_at OR (idempotency_keys.response IS NULL AND idempotency_keys.locked_until <= EXCLUDED.created_at)
+		`).
+				WithArgs(k.Subject, k.Key, k.Method, k.Fingerprint, k.CreatedAt, k.ExpiresAt, k.LockedUntil).
+				WillReturnResult(sqlmock.NewResult(0, tc.rowsAffected))
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			repo := storage.NewIdempotency(st)
+
+			reserved, err := repo.ReserveIdempotencyKey(context.Background(), k)
+			require.NoError(t, err)
+			require.Equal(t, tc.reserved, reserved)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestIdempotency_FindIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectQuery(`
+			SELECT subject, key, method, fingerprint, response, header, user_id, created_at, expires_at, locked_until FROM idempotency_keys WHERE key = $1 AND subject = $2
+		`).
+		WithArgs("add-alice", "backoffice").
+		WillReturnRows(sqlmock.NewRows([]string{"subject"}))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewIdempotency(st)
+
+	k, err := repo.FindIdempotencyKey(context.Background(), "backoffice", "add-alice")
+	require.ErrorIs(t, err, database.ErrNotFound)
+	require.Nil(t, k)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIdempotency_CompleteIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+	// The user the mutation acted on is stored, its personal data in the response is erased along with it.
+	mock.ExpectExec(`
+			UPDATE idempotency_keys SET response = $1, header = $2, user_id = $3 WHERE key = $4 AND subject = $5
+		`).
+		WithArgs([]byte("response"), []byte("{}"), uuid.NullUUID{UUID: userID, Valid: true}, "add-alice", "backoffice").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewIdempotency(st)
+
+	require.NoError(t, repo.CompleteIdempotencyKey(context.Background(), "backoffice", "add-alice", userID, []byte("response"), []byte("{}")))
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIdempotency_ReleaseIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	// Only the keys in progress are released, the outcome of a mutation done is kept.
+	mock.ExpectExec(`
+			DELETE FROM idempotency_keys WHERE key = $1 AND response IS NULL AND subject = $2
+		`).
+		WithArgs("add-alice", "backoffice").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewIdempotency(st)
+
+	require.NoError(t, repo.ReleaseIdempotencyKey(context.Background(), "backoffice", "add-alice"))
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIdempotency_PurgeExpiredIdempotencyKeys(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	at := time.Date(2024, 12, 29, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(`
+			DELETE FROM idempotency_keys WHERE (subject, key) IN (SELECT subject, key FROM idempotency_keys WHERE expires_at <= $1 LIMIT 100)
+		`).
+		WithArgs(at).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewIdempotency(st)
+
+	n, err := repo.PurgeExpiredIdempotencyKeys(context.Background(), at, 100)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
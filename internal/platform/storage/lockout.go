@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/go-grpc-service/database"
+)
+
+// LoginAttemptTable is the table name for login attempts.
+const LoginAttemptTable = "login_attempts"
+
+// LoginAttempts represents the failed logins of an email since the last successful login or lockout.
+type LoginAttempts struct {
+	Email       string       `db:"email"`
+	Failures    int          `db:"failures"`
+	LockedUntil sql.NullTime `db:"locked_until"`
+	UpdatedAt   time.Time    `db:"updated_at"`
+}
+
+// Lockout represents a Lockout repository, storing the failed logins.
+type Lockout struct {
+	storage *sqluct.Storage
+
+	// col names for login attempts table search
+	colEmail       string
+	colFailures    string
+	colLockedUntil string
+	colUpdatedAt   string
+}
+
+// NewLockout returns instance of Lockout repository.
+func NewLockout(storage *sqluct.Storage) *Lockout {
+	var a LoginAttempts
+
+	return &Lockout{
+		storage:        storage,
+		colEmail:       storage.Mapper.Col(&a, &a.Email),
+		colFailures:    storage.Mapper.Col(&a, &a.Failures),
+		colLockedUntil: storage.Mapper.Col(&a, &a.LockedUntil),
+		colUpdatedAt:   storage.Mapper.Col(&a, &a.UpdatedAt),
+	}
+}
+
+// FindLoginAttempts finds the failed logins of the email.
+func (s *Lockout) FindLoginAttempts(ctx context.Context, email string) (*LoginAttempts, error) {
+	q := s.storage.SelectStmt(LoginAttemptTable, LoginAttempts{}).
+		Where(squirrel.Eq{s.colEmail: email})
+
+	var a LoginAttempts
+
+	err := s.storage.Select(ctx, q, &a)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, database.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// RecordLoginFailure records a failed login of the email, returning the number of failures recorded.
+func (s *Lockout) RecordLoginFailure(ctx context.Context, email string, at time.Time) (int, error) {
+	q := s.storage.QueryBuilder().Insert(LoginAttemptTable).
+		Columns(s.colEmail, s.colFailures, s.colUpdatedAt).
+		Values(email, 1, at).
+		Suffix("ON CONFLICT (" + s.colEmail + ") DO UPDATE SET " +
+			s.colFailures + " = " + LoginAttemptTable + "." + s.colFailures + " + 1, " +
+			s.colUpdatedAt + " = EXCLUDED." + s.colUpdatedAt).
+		Suffix("RETURNING " + s.colFailures)
+
+	var failures int
+
+	if err := s.storage.Select(ctx, q, &failures); err != nil {
+		return 0, err
+	}
+
+	return failures, nil
+}
+
+// LockLogins locks out the logins of the email until the given time, the failures start over.
+func (s *Lockout) LockLogins(ctx context.Context, email string, until, at time.Time) error {
+	q := s.storage.QueryBuilder().Update(LoginAttemptTable).
+		Set(s.colFailures, 0).
+		Set(s.colLockedUntil, until).
+		Set(s.colUpdatedAt, at).
+		Where(squirrel.Eq{s.colEmail: email})
+
+	_, err := s.storage.Exec(ctx, q)
+
+	return err
+}
+
+// ClearLoginAttempts clears the failed logins of the email.
+func (s *Lockout) ClearLoginAttempts(ctx context.Context, email string) error {
+	q := s.storage.DeleteStmt(LoginAttemptTable).
+		Where(squirrel.Eq{s.colEmail: email})
+
+	_, err := s.storage.Exec(ctx, q)
+
+	return err
+}
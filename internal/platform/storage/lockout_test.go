@@ -0,0 +1,90 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockout_FindLoginAttempts(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectQuery(`
+			SELECT email, failures, locked_until, updated_at FROM login_attempts WHERE email = $1
+		`).
+		WithArgs("alice@bob.com").
+		WillReturnRows(sqlmock.NewRows([]string{"email"}))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewLockout(st)
+
+	a, err := repo.FindLoginAttempts(context.Background(), "alice@bob.com")
+	require.ErrorIs(t, err, database.ErrNotFound)
+	require.Nil(t, a)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLockout_RecordLoginFailure(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	at := time.Date(2024, 12, 19, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`
+			INSERT INTO login_attempts (email,failures,updated_at) VALUES ($1,$2,$3) ON CONFLICT (email) DO UPDATE SET failures = login_attempts.failures + 1, updated_at = EXCLUDED.updated_at RETURNING failures
+		`).
+		WithArgs("alice@bob.com", 1, at).
+		WillReturnRows(sqlmock.NewRows([]string{"failures"}).AddRow(3))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewLockout(st)
+
+	failures, err := repo.RecordLoginFailure(context.Background(), "alice@bob.com", at)
+	require.NoError(t, err)
+	require.Equal(t, 3, failures)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLockout_LockLogins(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	at := time.Date(2024, 12, 19, 9, 0, 0, 0, time.UTC)
+	until := at.Add(15 * time.Minute)
+
+	mock.ExpectExec(`
+			UPDATE login_attempts SET failures = $1, locked_until = $2, updated_at = $3 WHERE email = $4
+		`).
+		WithArgs(0, until, at, "alice@bob.com").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewLockout(st)
+
+	err = repo.LockLogins(context.Background(), "alice@bob.com", until, at)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,373 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+)
+
+// OutboxTable is the table name for outbox.
+const OutboxTable = "outbox"
+
+// outboxLockID is the key of the advisory lock held while claiming the events, so only one relay drains them at a time.
+const outboxLockID = 7_340_318
+
+// Event types recorded in the outbox.
+const (
+	EventUserAdded   = "user.added"
+	EventUserUpdated = "user.updated"
+	EventUserDeleted = "user.deleted"
+
+	EventUserRestored = "user.restored"
+
+	EventUserStatusChanged = "user.status_changed"
+
+	EventUserPasswordReset = "user.password_reset"
+)
+
+// OutboxEvent represents an event recorded in the outbox.
+//
+// The payload is a JSON encoded model.User holding only the information relevant to the event. The country is the
+// country of the user when the event was recorded, so events can be filtered by country whatever their payload.
+type OutboxEvent struct {
+	ID      int64          `db:"id,omitempty"`
+	EventID uuid.UUID      `db:"event_id,omitempty"`
+	UserID  model.UserID   `db:"user_id"`
+	Type    string         `db:"event_type"`
+	Payload []byte         `db:"payload"`
+	Country sql.NullString `db:"country"`
+
+	Attempts  int            `db:"attempts"`
+	LastError sql.NullString `db:"last_error"`
+
+	CreatedAt     time.Time    `db:"created_at,omitempty"`
+	NextAttemptAt time.Time    `db:"next_attempt_at,omitempty"`
+	ClaimedUntil  sql.NullTime `db:"claimed_until"`
+	PublishedAt   sql.NullTime `db:"published_at"`
+	FailedAt      sql.NullTime `db:"failed_at"`
+}
+
+// OutboxPosition represents the position of an event in the order the events were published.
+type OutboxPosition struct {
+	PublishedAt time.Time
+	ID          int64
+}
+
+// Position returns the position of the published event.
+func (e *OutboxEvent) Position() OutboxPosition {
+	return OutboxPosition{
+		PublishedAt: e.PublishedAt.Time,
+		ID:          e.ID,
+	}
+}
+
+// User decodes the user carried by the event payload.
+func (e *OutboxEvent) User() (*model.User, error) {
+	var u model.User
+
+	if err := json.Unmarshal(e.Payload, &u); err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+// Outbox represents an Outbox repository.
+//
+// It implements the user notifiers by recording the events in the outbox table, so they are written in the same
+// transaction as the user mutation when the context carries one.
+type Outbox struct {
+	storage *sqluct.Storage
+
+	// col names for outbox table search
+	colID          string
+	colUserID      string
+	colType        string
+	colPayload     string
+	colCountry     string
+	colAttempts    string
+	colLastError   string
+	colNextAttempt string
+	colClaimed     string
+	colPublishedAt string
+	colFailedAt    string
+
+	// col names for users table search
+	colUsersID      string
+	colUsersCountry string
+}
+
+// NewOutbox returns instance of Outbox repository.
+func NewOutbox(storage *sqluct.Storage) *Outbox {
+	var (
+		e OutboxEvent
+		u model.User
+	)
+
+	return &Outbox{
+		storage:        storage,
+		colID:          storage.Mapper.Col(&e, &e.ID),
+		colUserID:      storage.Mapper.Col(&e, &e.UserID),
+		colType:        storage.Mapper.Col(&e, &e.Type),
+		colPayload:     storage.Mapper.Col(&e, &e.Payload),
+		colCountry:     storage.Mapper.Col(&e, &e.Country),
+		colAttempts:    storage.Mapper.Col(&e, &e.Attempts),
+		colLastError:   storage.Mapper.Col(&e, &e.LastError),
+		colNextAttempt: storage.Mapper.Col(&e, &e.NextAttemptAt),
+		colClaimed:     storage.Mapper.Col(&e, &e.ClaimedUntil),
+		colPublishedAt: storage.Mapper.Col(&e, &e.PublishedAt),
+		colFailedAt:    storage.Mapper.Col(&e, &e.FailedAt),
+
+		colUsersID:      storage.Mapper.Col(&u, &u.ID),
+		colUsersCountry: storage.Mapper.Col(&u, &u.Country),
+	}
+}
+
+// NotifyUserAdded records the user added event.
+//
+// Credentials are not part of the event.
+func (s *Outbox) NotifyUserAdded(ctx context.Context, u *model.User) error {
+	payload := *u
+	payload.PasswordHash = ""
+
+	return s.add(ctx, u.ID, EventUserAdded, &payload)
+}
+
+// NotifyUserUpdated records the user updated event.
+//
+// Credentials are not part of the event.
+func (s *Outbox) NotifyUserUpdated(ctx context.Context, id model.UserID, info model.UserState) error {
+	info.PasswordHash = ""
+
+	return s.add(ctx, id, EventUserUpdated, &model.User{ID: id, UserState: info})
+}
+
+// NotifyUserDeleted records the user deleted event.
+//
+// It must be called before deleting the user, so the event records the country of the user.
+func (s *Outbox) NotifyUserDeleted(ctx context.Context, id model.UserID) error {
+	return s.add(ctx, id, EventUserDeleted, &model.User{ID: id})
+}
+
+// NotifyUserRestored records the user restored event.
+func (s *Outbox) NotifyUserRestored(ctx context.Context, id model.UserID) error {
+	return s.add(ctx, id, EventUserRestored, &model.User{ID: id})
+}
+
+// NotifyUserStatusChanged records the user status changed event.
+func (s *Outbox) NotifyUserStatusChanged(ctx context.Context, id model.UserID, status model.UserStatus) error {
+	u := &model.User{ID: id}
+	u.Status = status
+
+	return s.add(ctx, id, EventUserStatusChanged, u)
+}
+
+// NotifyPasswordReset records the user password reset event.
+//
+// The event only identifies the user, credentials are not part of it.
+func (s *Outbox) NotifyPasswordReset(ctx context.Context, id model.UserID) error {
+	return s.add(ctx, id, EventUserPasswordReset, &model.User{ID: id})
+}
+
+func (s *Outbox) add(ctx context.Context, id model.UserID, eventType string, u *model.User) error {
+	payload, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+
+	// The country is read from the users table, within the transaction of the user mutation.
+	country := squirrel.Expr("(SELECT "+s.colUsersCountry+" FROM "+UserTable+" WHERE "+s.colUsersID+" = ?)", id)
+
+	q := s.storage.QueryBuilder().Insert(OutboxTable).
+		Columns(s.colUserID, s.colType, s.colPayload, s.colCountry).
+		Values(id, eventType, payload, country)
+
+	res, err := s.storage.Exec(ctx, q)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.New("no rows affected")
+	}
+
+	return nil
+}
+
+// InTx runs the function within a transaction.
+func (s *Outbox) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return s.storage.InTx(ctx, fn)
+}
+
+// TryLock tries to acquire the outbox lock for the running transaction.
+//
+// The lock is released when the transaction ends. Returns false when the lock is held by someone else.
+func (s *Outbox) TryLock(ctx context.Context) (bool, error) {
+	var locked bool
+
+	if err := s.storage.Select(ctx, sqluct.Stmt("SELECT pg_try_advisory_xact_lock($1)", outboxLockID), &locked); err != nil {
+		return false, err
+	}
+
+	return locked, nil
+}
+
+// ListPending lists the events pending to be relayed in the order they were recorded.
+//
+// The events of a user waiting for a retry are not listed, nor the events recorded after them. Nothing is listed while
+// events are claimed by a relay. Times are compared with the database clock, the one stamping the events.
+func (s *Outbox) ListPending(ctx context.Context, limit uint64) ([]*OutboxEvent, error) {
+	pending := squirrel.Eq{s.colPublishedAt: nil, s.colFailedAt: nil}
+
+	waiting := s.storage.QueryBuilder().Select(s.colUserID).From(OutboxTable).
+		Where(pending).
+		Where(s.colNextAttempt + " > clock_timestamp()")
+
+	claimed := s.storage.QueryBuilder().Select("1").From(OutboxTable).
+		Where(s.colClaimed + " > clock_timestamp()")
+
+	q := s.storage.SelectStmt(OutboxTable, OutboxEvent{}).
+		Where(pending).
+		Where(squirrel.Expr(s.colUserID+" NOT IN (?)", waiting)).
+		Where(squirrel.Expr("NOT EXISTS (?)", claimed)).
+		OrderBy(s.colID).
+		Limit(limit)
+
+	var events []*OutboxEvent
+
+	err := s.storage.Select(ctx, q, &events)
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// ListPublishedAfter lists the events published after the given position, in the order they were published.
+//
+// Events are published by a single relay at a time, one after the other, so the order is stable: an event is never
+// published before an event already listed.
+func (s *Outbox) ListPublishedAfter(ctx context.Context, after OutboxPosition, limit uint64) ([]*OutboxEvent, error) {
+	q := s.storage.SelectStmt(OutboxTable, OutboxEvent{}).
+		Where(squirrel.NotEq{s.colPublishedAt: nil}).
+		Where(squirrel.Expr("("+s.colPublishedAt+", "+s.colID+") > (?, ?)", after.PublishedAt, after.ID)).
+		OrderBy(s.colPublishedAt, s.colID).
+		Limit(limit)
+
+	var events []*OutboxEvent
+
+	err := s.storage.Select(ctx, q, &events)
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// LastPublished returns the position of the last event published, the zero position when none was published.
+func (s *Outbox) LastPublished(ctx context.Context) (OutboxPosition, error) {
+	q := s.storage.SelectStmt(OutboxTable, OutboxEvent{}).
+		Where(squirrel.NotEq{s.colPublishedAt: nil}).
+		OrderBy(s.colPublishedAt+" DESC", s.colID+" DESC").
+		Limit(1)
+
+	var events []*OutboxEvent
+
+	err := s.storage.Select(ctx, q, &events)
+	if err != nil {
+		return OutboxPosition{}, err
+	}
+
+	if len(events) == 0 {
+		return OutboxPosition{}, nil
+	}
+
+	return events[0].Position(), nil
+}
+
+// MarkPublished marks the event as published.
+//
+// The event is stamped with the clock of the database at the time it is marked, not the start of the transaction nor
+// the clock of the instance relaying it. Relays hold the outbox lock until they commit, so the events published later
+// are stamped later, whatever instance relays them.
+func (s *Outbox) MarkPublished(ctx context.Context, id int64) error {
+	q := s.storage.QueryBuilder().Update(OutboxTable).
+		Set(s.colPublishedAt, squirrel.Expr("clock_timestamp()")).
+		Set(s.colAttempts, squirrel.Expr(s.colAttempts+" + 1")).
+		Where(squirrel.Eq{s.colID: id})
+
+	return s.exec(ctx, q)
+}
+
+// MarkRetry records a failed attempt to relay the event, the event is retried once the backoff elapsed.
+func (s *Outbox) MarkRetry(ctx context.Context, id int64, reason string, backoff time.Duration) error {
+	q := s.storage.QueryBuilder().Update(OutboxTable).
+		Set(s.colAttempts, squirrel.Expr(s.colAttempts+" + 1")).
+		Set(s.colLastError, reason).
+		Set(s.colNextAttempt, squirrel.Expr("clock_timestamp() + make_interval(secs => ?)", backoff.Seconds())).
+		Where(squirrel.Eq{s.colID: id})
+
+	return s.exec(ctx, q)
+}
+
+// MarkFailed records a failed attempt to relay the event and gives up on it.
+func (s *Outbox) MarkFailed(ctx context.Context, id int64, reason string) error {
+	q := s.storage.QueryBuilder().Update(OutboxTable).
+		Set(s.colAttempts, squirrel.Expr(s.colAttempts+" + 1")).
+		Set(s.colLastError, reason).
+		Set(s.colFailedAt, squirrel.Expr("clock_timestamp()")).
+		Where(squirrel.Eq{s.colID: id})
+
+	return s.exec(ctx, q)
+}
+
+// Claim reserves the events to the relay for the ttl, no other relay lists pending events meanwhile.
+func (s *Outbox) Claim(ctx context.Context, ids []int64, ttl time.Duration) error {
+	q := s.storage.QueryBuilder().Update(OutboxTable).
+		Set(s.colClaimed, squirrel.Expr("clock_timestamp() + make_interval(secs => ?)", ttl.Seconds())).
+		Where(squirrel.Eq{s.colID: ids})
+
+	return s.exec(ctx, q)
+}
+
+// Release releases the events claimed by the relay, the events erased meanwhile are ignored.
+func (s *Outbox) Release(ctx context.Context, ids []int64) error {
+	q := s.storage.QueryBuilder().Update(OutboxTable).
+		Set(s.colClaimed, nil).
+		Where(squirrel.Eq{s.colID: ids})
+
+	_, err := s.storage.Exec(ctx, q)
+
+	return err
+}
+
+func (s *Outbox) exec(ctx context.Context, q sqluct.ToSQL) error {
+	res, err := s.storage.Exec(ctx, q)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return database.ErrNotFound
+	}
+
+	return nil
+}
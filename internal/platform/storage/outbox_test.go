@@ -0,0 +1,317 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutbox_NotifyUserAdded(t *testing.T) {
+	t.Parallel()
+
+	user := &model.User{
+		ID: uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2"),
+		UserState: model.UserState{
+			PasswordHash: "supersecurepassword",
+			Email:        "alice@bob.com",
+			FirstName:    "Alice",
+			LastName:     "Bob",
+			Nickname:     "AB123",
+			Country:      "UK",
+		},
+	}
+
+	payload := []byte(`{"ID":"26ef0140-c436-4838-a271-32652c72f6f2","PasswordHash":"","Email":"alice@bob.com","FirstName":"Alice","LastName":"Bob","Nickname":"AB123","Country":"UK","CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z"}`)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(`
+				INSERT INTO outbox (user_id,event_type,payload,country) VALUES ($1,$2,$3,(SELECT country FROM users WHERE id = $4))
+			`).
+			WithArgs(user.ID, storage.EventUserAdded, payload, user.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewOutbox(st)
+
+		err = repo.NotifyUserAdded(context.Background(), user)
+		require.NoError(t, err)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(`
+				INSERT INTO outbox (user_id,event_type,payload,country) VALUES ($1,$2,$3,(SELECT country FROM users WHERE id = $4))
+			`).
+			WithArgs(user.ID, storage.EventUserAdded, payload, user.ID).
+			WillReturnError(&pgconn.PgError{Code: pgerrcode.InternalError})
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewOutbox(st)
+
+		err = repo.NotifyUserAdded(context.Background(), user)
+		require.Error(t, err)
+	})
+}
+
+func TestOutbox_NotifyUserDeleted(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectExec(`
+			INSERT INTO outbox (user_id,event_type,payload,country) VALUES ($1,$2,$3,(SELECT country FROM users WHERE id = $4))
+		`).
+		WithArgs(
+			userID,
+			storage.EventUserDeleted,
+			[]byte(`{"ID":"26ef0140-c436-4838-a271-32652c72f6f2","PasswordHash":"","Email":"","FirstName":"","LastName":"","Nickname":"","Country":"","CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z"}`),
+			userID,
+		).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewOutbox(st)
+
+	err = repo.NotifyUserDeleted(context.Background(), userID)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutbox_ListPending(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectQuery(`
+			SELECT id, event_id, user_id, event_type, payload, country, attempts, last_error, created_at, next_attempt_at, claimed_until, published_at, failed_at FROM outbox WHERE failed_at IS NULL AND published_at IS NULL AND user_id NOT IN (SELECT user_id FROM outbox WHERE failed_at IS NULL AND published_at IS NULL AND next_attempt_at > clock_timestamp()) AND NOT EXISTS (SELECT 1 FROM outbox WHERE claimed_until > clock_timestamp()) ORDER BY id LIMIT 100
+		`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "event_type"}).
+			AddRow(1, uuid.New(), storage.EventUserAdded).
+			AddRow(2, uuid.New(), storage.EventUserDeleted),
+		)
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewOutbox(st)
+
+	events, err := repo.ListPending(context.Background(), 100)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutbox_MarkPublished(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(`
+				UPDATE outbox SET published_at = clock_timestamp(), attempts = attempts + 1 WHERE id = $1
+			`).
+			WithArgs(int64(1)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewOutbox(st)
+
+		err = repo.MarkPublished(context.Background(), 1)
+		require.NoError(t, err)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(`
+				UPDATE outbox SET published_at = clock_timestamp(), attempts = attempts + 1 WHERE id = $1
+			`).
+			WithArgs(int64(1)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewOutbox(st)
+
+		err = repo.MarkPublished(context.Background(), 1)
+		require.Error(t, err)
+		require.ErrorIs(t, err, database.ErrNotFound)
+	})
+}
+
+func TestOutbox_MarkRetry(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectExec(`
+			UPDATE outbox SET attempts = attempts + 1, last_error = $1, next_attempt_at = clock_timestamp() + make_interval(secs => $2) WHERE id = $3
+		`).
+		WithArgs("boom", float64(2), int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewOutbox(st)
+
+	err = repo.MarkRetry(context.Background(), 1, "boom", 2*time.Second)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutbox_Claim(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectExec(`
+			UPDATE outbox SET claimed_until = clock_timestamp() + make_interval(secs => $1) WHERE id IN ($2,$3)
+		`).
+		WithArgs(float64(60), int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	mock.ExpectExec(`
+			UPDATE outbox SET claimed_until = $1 WHERE id IN ($2,$3)
+		`).
+		WithArgs(nil, int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewOutbox(st)
+
+	require.NoError(t, repo.Claim(context.Background(), []int64{1, 2}, time.Minute))
+	require.NoError(t, repo.Release(context.Background(), []int64{1, 2}))
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutbox_ListPublishedAfter(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	after := storage.OutboxPosition{PublishedAt: time.Date(2024, 12, 16, 10, 0, 0, 0, time.UTC), ID: 1}
+
+	mock.ExpectQuery(`
+			SELECT id, event_id, user_id, event_type, payload, country, attempts, last_error, created_at, next_attempt_at, claimed_until, published_at, failed_at FROM outbox WHERE published_at IS NOT NULL AND (published_at, id) > ($1, $2) ORDER BY published_at, id LIMIT 100
+		`).
+		WithArgs(after.PublishedAt, after.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "event_type", "published_at"}).
+			AddRow(3, uuid.New(), storage.EventUserAdded, after.PublishedAt).
+			AddRow(2, uuid.New(), storage.EventUserDeleted, after.PublishedAt.Add(time.Second)),
+		)
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewOutbox(st)
+
+	events, err := repo.ListPublishedAfter(context.Background(), after, 100)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	require.Equal(t, storage.OutboxPosition{PublishedAt: after.PublishedAt.Add(time.Second), ID: 2}, events[1].Position())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutbox_LastPublished(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		SELECT id, event_id, user_id, event_type, payload, country, attempts, last_error, created_at, next_attempt_at, claimed_until, published_at, failed_at FROM outbox WHERE published_at IS NOT NULL ORDER BY published_at DESC, id DESC LIMIT 1
+	`
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		publishedAt := time.Date(2024, 12, 16, 10, 0, 0, 0, time.UTC)
+
+		mock.ExpectQuery(query).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "published_at"}).AddRow(7, publishedAt))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewOutbox(st)
+
+		pos, err := repo.LastPublished(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, storage.OutboxPosition{PublishedAt: publishedAt, ID: 7}, pos)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("none published", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"id", "published_at"}))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewOutbox(st)
+
+		pos, err := repo.LastPublished(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, storage.OutboxPosition{}, pos)
+	})
+}
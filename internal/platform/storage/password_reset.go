@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/go-grpc-service/database"
+)
+
+// PasswordResetTokenTable is the table name for password reset tokens.
+const PasswordResetTokenTable = "password_reset_tokens"
+
+// PasswordResetToken represents a token issued to reset the password of a user.
+//
+// Only the hash of the token is stored.
+type PasswordResetToken struct {
+	TokenHash string       `db:"token_hash"`
+	UserID    model.UserID `db:"user_id"`
+
+	CreatedAt time.Time    `db:"created_at"`
+	ExpiresAt time.Time    `db:"expires_at"`
+	UsedAt    sql.NullTime `db:"used_at"`
+}
+
+// PasswordReset represents a PasswordReset repository, storing the password reset tokens.
+type PasswordReset struct {
+	storage *sqluct.Storage
+
+	// col names for password reset tokens table search
+	colTokenHash string
+	colUserID    string
+	colUsedAt    string
+}
+
+// NewPasswordReset returns instance of PasswordReset repository.
+func NewPasswordReset(storage *sqluct.Storage) *PasswordReset {
+	var t PasswordResetToken
+
+	return &PasswordReset{
+		storage:      storage,
+		colTokenHash: storage.Mapper.Col(&t, &t.TokenHash),
+		colUserID:    storage.Mapper.Col(&t, &t.UserID),
+		colUsedAt:    storage.Mapper.Col(&t, &t.UsedAt),
+	}
+}
+
+// InTx runs the function within a transaction.
+func (s *PasswordReset) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return s.storage.InTx(ctx, fn)
+}
+
+// AddPasswordResetToken stores the password reset token.
+func (s *PasswordReset) AddPasswordResetToken(ctx context.Context, t *PasswordResetToken) error {
+	q := s.storage.InsertStmt(PasswordResetTokenTable, t)
+
+	res, err := s.storage.Exec(ctx, q)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.New("no rows affected")
+	}
+
+	return nil
+}
+
+// DeletePendingPasswordResetTokens deletes the password reset tokens of the user not used yet.
+func (s *PasswordReset) DeletePendingPasswordResetTokens(ctx context.Context, id model.UserID) error {
+	q := s.storage.DeleteStmt(PasswordResetTokenTable).
+		Where(squirrel.Eq{s.colUserID: id, s.colUsedAt: nil})
+
+	_, err := s.storage.Exec(ctx, q)
+
+	return err
+}
+
+// FindPasswordResetToken finds the password reset token by hash.
+func (s *PasswordReset) FindPasswordResetToken(ctx context.Context, hash string) (*PasswordResetToken, error) {
+	q := s.storage.SelectStmt(PasswordResetTokenTable, PasswordResetToken{}).
+		Where(squirrel.Eq{s.colTokenHash: hash})
+
+	var t PasswordResetToken
+
+	err := s.storage.Select(ctx, q, &t)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, database.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// UsePasswordResetToken marks the password reset token as used.
+//
+// Returns false when the token was already used, so two concurrent resets with a token can not both succeed.
+func (s *PasswordReset) UsePasswordResetToken(ctx context.Context, hash string, at time.Time) (bool, error) {
+	q := s.storage.QueryBuilder().Update(PasswordResetTokenTable).
+		Set(s.colUsedAt, at).
+		Where(squirrel.Eq{s.colTokenHash: hash, s.colUsedAt: nil})
+
+	res, err := s.storage.Exec(ctx, q)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,174 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordReset_AddPasswordResetToken(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	tok := &storage.PasswordResetToken{
+		TokenHash: "3f0a",
+		UserID:    uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2"),
+		CreatedAt: time.Date(2024, 12, 22, 9, 0, 0, 0, time.UTC),
+		ExpiresAt: time.Date(2024, 12, 22, 10, 0, 0, 0, time.UTC),
+	}
+
+	mock.ExpectExec(`
+			INSERT INTO password_reset_tokens (token_hash,user_id,created_at,expires_at,used_at) VALUES ($1,$2,$3,$4,$5)
+		`).
+		WithArgs(tok.TokenHash, tok.UserID, tok.CreatedAt, tok.ExpiresAt, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewPasswordReset(st)
+
+	err = repo.AddPasswordResetToken(context.Background(), tok)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPasswordReset_DeletePendingPasswordResetTokens(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+	mock.ExpectExec(`
+			DELETE FROM password_reset_tokens WHERE used_at IS NULL AND user_id = $1
+		`).
+		WithArgs(userID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewPasswordReset(st)
+
+	err = repo.DeletePendingPasswordResetTokens(context.Background(), userID)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPasswordReset_FindPasswordResetToken(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		SELECT token_hash, user_id, created_at, expires_at, used_at FROM password_reset_tokens WHERE token_hash = $1
+	`
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+		mock.ExpectQuery(query).
+			WithArgs("3f0a").
+			WillReturnRows(sqlmock.NewRows([]string{"token_hash", "user_id"}).AddRow("3f0a", userID))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewPasswordReset(st)
+
+		tok, err := repo.FindPasswordResetToken(context.Background(), "3f0a")
+		require.NoError(t, err)
+		require.Equal(t, &storage.PasswordResetToken{TokenHash: "3f0a", UserID: userID}, tok)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(query).
+			WithArgs("3f0a").
+			WillReturnRows(sqlmock.NewRows([]string{"token_hash"}))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewPasswordReset(st)
+
+		tok, err := repo.FindPasswordResetToken(context.Background(), "3f0a")
+		require.ErrorIs(t, err, database.ErrNotFound)
+		require.Nil(t, tok)
+	})
+}
+
+func TestPasswordReset_UsePasswordResetToken(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, 12, 22, 9, 0, 0, 0, time.UTC)
+
+	query := `
+		UPDATE password_reset_tokens SET used_at = $1 WHERE token_hash = $2 AND used_at IS NULL
+	`
+
+	t.Run("used", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(query).
+			WithArgs(at, "3f0a").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewPasswordReset(st)
+
+		used, err := repo.UsePasswordResetToken(context.Background(), "3f0a", at)
+		require.NoError(t, err)
+		require.True(t, used)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already used", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(query).
+			WithArgs(at, "3f0a").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewPasswordReset(st)
+
+		used, err := repo.UsePasswordResetToken(context.Background(), "3f0a", at)
+		require.NoError(t, err)
+		require.False(t, used)
+	})
+}
@@ -0,0 +1,490 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+)
+
+// ErasureCertificateTable is the table name for erasure certificates.
+const ErasureCertificateTable = "erasure_certificates"
+
+// passwordResetEmailKeyPrefix prefixes the rate limit keys of the password resets requested for an email.
+const passwordResetEmailKeyPrefix = "password_reset:email:"
+
+// outboxPersonalKeys are the keys of the outbox payloads holding personal data, removed on erasure.
+const outboxPersonalKeys = "'{Email,FirstName,LastName,Nickname,StatusReason}'::text[]"
+
+// auditRedactedChanges redacts the values of every change of the audit entries, keeping the attributes changed.
+const auditRedactedChanges = "(SELECT COALESCE(jsonb_object_agg(key, '{\"before\":\"" + model.RedactedValue +
+	"\",\"after\":\"" + model.RedactedValue + "\"}'::jsonb), '{}') FROM jsonb_each(changes))"
+
+// ErasureCertificate represents a stored erasure certificate.
+type ErasureCertificate struct {
+	ID       uuid.UUID    `db:"id,omitempty"`
+	UserID   model.UserID `db:"user_id"`
+	ErasedBy string       `db:"erased_by"`
+	ErasedAt time.Time    `db:"erased_at,omitempty"`
+	Records  []byte       `db:"records"` // JSON encoded number of records erased or anonymized, by table
+}
+
+// PersonalData represents a PersonalData repository, gathering and erasing the personal data of the users across
+// the tables.
+type PersonalData struct {
+	storage *sqluct.Storage
+
+	// col names for users table search
+	colUsersID              string
+	colUsersEmail           string
+	colUsersPasswordHash    string
+	colUsersFirstName       string
+	colUsersLastName        string
+	colUsersNickname        string
+	colUsersStatusReason    string
+	colUsersEmailVerifiedAt string
+	colUsersDeletedAt       string
+	colUsersErasedAt        string
+
+	// col names for user data tables search, all of them reference the user by the same column
+	colUserID    string
+	colCreatedAt string
+
+	// col names for outbox table search
+	colOutboxID      string
+	colOutboxPayload string
+
+	// col names for login attempts table search
+	colLoginEmail string
+
+	// col names for rate limits table search
+	colRateLimitKey string
+
+	// col names for audit events table search
+	colAuditID       string
+	colAuditChanges  string
+	colAuditSourceIP string
+
+	// col names for erasure certificates table search
+	colCertificateID       string
+	colCertificateErasedAt string
+}
+
+// NewPersonalData returns instance of PersonalData repository.
+func NewPersonalData(storage *sqluct.Storage) *PersonalData {
+	var (
+		u model.User
+		r UserRole
+		e OutboxEvent
+		a LoginAttempts
+		l RateLimit
+		v AuditEvent
+		c ErasureCertificate
+	)
+
+	return &PersonalData{
+		storage:                 storage,
+		colUsersID:              storage.Mapper.Col(&u, &u.ID),
+		colUsersEmail:           storage.Mapper.Col(&u, &u.Email),
+		colUsersPasswordHash:    storage.Mapper.Col(&u, &u.PasswordHash),
+		colUsersFirstName:       storage.Mapper.Col(&u, &u.FirstName),
+		colUsersLastName:        storage.Mapper.Col(&u, &u.LastName),
+		colUsersNickname:        storage.Mapper.Col(&u, &u.Nickname),
+		colUsersStatusReason:    storage.Mapper.Col(&u, &u.StatusReason),
+		colUsersEmailVerifiedAt: storage.Mapper.Col(&u, &u.EmailVerifiedAt),
+		colUsersDeletedAt:       storage.Mapper.Col(&u, &u.DeletedAt),
+		colUsersErasedAt:        storage.Mapper.Col(&u, &u.ErasedAt),
+
+		colUserID:    storage.Mapper.Col(&r, &r.UserID),
+		colCreatedAt: storage.Mapper.Col(&r, &r.CreatedAt),
+
+		colOutboxID:      storage.Mapper.Col(&e, &e.ID),
+		colOutboxPayload: storage.Mapper.Col(&e, &e.Payload),
+
+		colLoginEmail: storage.Mapper.Col(&a, &a.Email),
+
+		colRateLimitKey: storage.Mapper.Col(&l, &l.Key),
+
+		colAuditID:       storage.Mapper.Col(&v, &v.ID),
+		colAuditChanges:  storage.Mapper.Col(&v, &v.Changes),
+		colAuditSourceIP: storage.Mapper.Col(&v, &v.SourceIP),
+
+		colCertificateID:       storage.Mapper.Col(&c, &c.ID),
+		colCertificateErasedAt: storage.Mapper.Col(&c, &c.ErasedAt),
+	}
+}
+
+// ExportPersonalData gathers every record stored about the user, deleted or not.
+//
+// The password hash and the token hashes are left out. Returns database.ErrNotFound when the user is not found.
+func (s *PersonalData) ExportPersonalData(ctx context.Context, id model.UserID) (*model.PersonalData, error) {
+	var (
+		d   model.PersonalData
+		err error
+	)
+
+	q := s.storage.SelectStmt(UserTable, model.User{}).
+		Where(squirrel.Eq{s.colUsersID: id})
+
+	if err = s.storage.Select(ctx, q, &d.User); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, database.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	d.User.PasswordHash = ""
+
+	if d.Roles, err = s.roles(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if d.Sessions, err = s.sessions(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if d.Verifications, err = s.verifications(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if d.PasswordResets, err = s.passwordResets(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if d.LoginAttempts, err = s.loginAttempts(ctx, d.User.Email); err != nil {
+		return nil, err
+	}
+
+	if d.Events, err = s.events(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if d.AuditEntries, err = s.auditEntries(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if d.IdempotencyKeys, err = s.idempotencyKeys(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func (s *PersonalData) roles(ctx context.Context, id model.UserID) ([]model.RoleAssignment, error) {
+	q := s.storage.SelectStmt(UserRoleTable, UserRole{}).
+		Where(squirrel.Eq{s.colUserID: id}).
+		OrderBy(s.colCreatedAt)
+
+	var rows []UserRole
+
+	if err := s.storage.Select(ctx, q, &rows); err != nil {
+		return nil, err
+	}
+
+	roles := make([]model.RoleAssignment, 0, len(rows))
+
+	for _, r := range rows {
+		roles = append(roles, model.RoleAssignment{Role: r.Role, AssignedAt: r.CreatedAt})
+	}
+
+	return roles, nil
+}
+
+func (s *PersonalData) sessions(ctx context.Context, id model.UserID) ([]model.SessionRecord, error) {
+	q := s.storage.SelectStmt(RefreshTokenTable, RefreshToken{}).
+		Where(squirrel.Eq{s.colUserID: id}).
+		OrderBy(s.colCreatedAt)
+
+	var rows []RefreshToken
+
+	if err := s.storage.Select(ctx, q, &rows); err != nil {
+		return nil, err
+	}
+
+	sessions := make([]model.SessionRecord, 0, len(rows))
+
+	for _, t := range rows {
+		sessions = append(sessions, model.SessionRecord{
+			FamilyID:  t.FamilyID,
+			CreatedAt: t.CreatedAt,
+			ExpiresAt: t.ExpiresAt,
+			RevokedAt: nullTime(t.RevokedAt),
+		})
+	}
+
+	return sessions, nil
+}
+
+func (s *PersonalData) verifications(ctx context.Context, id model.UserID) ([]model.TokenRecord, error) {
+	q := s.storage.SelectStmt(VerificationTokenTable, VerificationToken{}).
+		Where(squirrel.Eq{s.colUserID: id}).
+		OrderBy(s.colCreatedAt)
+
+	var rows []VerificationToken
+
+	if err := s.storage.Select(ctx, q, &rows); err != nil {
+		return nil, err
+	}
+
+	tokens := make([]model.TokenRecord, 0, len(rows))
+
+	for _, t := range rows {
+		tokens = append(tokens, model.TokenRecord{
+			Email:     t.Email,
+			CreatedAt: t.CreatedAt,
+			ExpiresAt: t.ExpiresAt,
+			UsedAt:    nullTime(t.UsedAt),
+		})
+	}
+
+	return tokens, nil
+}
+
+func (s *PersonalData) passwordResets(ctx context.Context, id model.UserID) ([]model.TokenRecord, error) {
+	q := s.storage.SelectStmt(PasswordResetTokenTable, PasswordResetToken{}).
+		Where(squirrel.Eq{s.colUserID: id}).
+		OrderBy(s.colCreatedAt)
+
+	var rows []PasswordResetToken
+
+	if err := s.storage.Select(ctx, q, &rows); err != nil {
+		return nil, err
+	}
+
+	tokens := make([]model.TokenRecord, 0, len(rows))
+
+	for _, t := range rows {
+		tokens = append(tokens, model.TokenRecord{
+			CreatedAt: t.CreatedAt,
+			ExpiresAt: t.ExpiresAt,
+			UsedAt:    nullTime(t.UsedAt),
+		})
+	}
+
+	return tokens, nil
+}
+
+// loginAttempts finds the failed logins of the email, they are recorded by the lower-cased email.
+func (s *PersonalData) loginAttempts(ctx context.Context, email string) (*model.LoginAttemptsRecord, error) {
+	if email == "" {
+		return nil, nil //nolint:nilnil // Erased users have no email, so no failed logins.
+	}
+
+	q := s.storage.SelectStmt(LoginAttemptTable, LoginAttempts{}).
+		Where(squirrel.Eq{s.colLoginEmail: strings.ToLower(email)})
+
+	var a LoginAttempts
+
+	if err := s.storage.Select(ctx, q, &a); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil // No failed logins.
+		}
+
+		return nil, err
+	}
+
+	return &model.LoginAttemptsRecord{
+		Failures:    a.Failures,
+		LockedUntil: nullTime(a.LockedUntil),
+		UpdatedAt:   a.UpdatedAt,
+	}, nil
+}
+
+func (s *PersonalData) events(ctx context.Context, id model.UserID) ([]model.EventRecord, error) {
+	q := s.storage.SelectStmt(OutboxTable, OutboxEvent{}).
+		Where(squirrel.Eq{s.colUserID: id}).
+		OrderBy(s.colOutboxID)
+
+	var rows []OutboxEvent
+
+	if err := s.storage.Select(ctx, q, &rows); err != nil {
+		return nil, err
+	}
+
+	events := make([]model.EventRecord, 0, len(rows))
+
+	for _, e := range rows {
+		events = append(events, model.EventRecord{
+			ID:          e.EventID,
+			Type:        e.Type,
+			Payload:     e.Payload,
+			CreatedAt:   e.CreatedAt,
+			PublishedAt: nullTime(e.PublishedAt),
+		})
+	}
+
+	return events, nil
+}
+
+func (s *PersonalData) auditEntries(ctx context.Context, id model.UserID) ([]*model.AuditEntry, error) {
+	q := s.storage.SelectStmt(AuditTable, AuditEvent{}).
+		Where(squirrel.Eq{s.colUserID: id}).
+		OrderBy(s.colAuditID)
+
+	var rows []AuditEvent
+
+	if err := s.storage.Select(ctx, q, &rows); err != nil {
+		return nil, err
+	}
+
+	entries := make([]*model.AuditEntry, 0, len(rows))
+
+	for _, r := range rows {
+		e, err := r.entry()
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+// idempotencyKeys finds the mutations of the user replayed to their retries, their responses hold its personal data.
+func (s *PersonalData) idempotencyKeys(ctx context.Context, id model.UserID) ([]model.IdempotencyRecord, error) {
+	q := s.storage.SelectStmt(IdempotencyKeyTable, IdempotencyKey{}).
+		Where(squirrel.Eq{s.colUserID: id}).
+		OrderBy(s.colCreatedAt)
+
+	var rows []IdempotencyKey
+
+	if err := s.storage.Select(ctx, q, &rows); err != nil {
+		return nil, err
+	}
+
+	keys := make([]model.IdempotencyRecord, 0, len(rows))
+
+	for _, k := range rows {
+		keys = append(keys, model.IdempotencyRecord{
+			Key:       k.Key,
+			Method:    k.Method,
+			Response:  k.Response,
+			CreatedAt: k.CreatedAt,
+			ExpiresAt: k.ExpiresAt,
+		})
+	}
+
+	return keys, nil
+}
+
+// ErasePersonalData irreversibly erases the personal data of the user, deleted or not.
+//
+// The user is kept under its id with its personal attributes blanked, deleted when it was not, so the records
+// referencing it stay consistent. Its tokens, roles, failed logins, rate limits and the responses of its mutations
+// replayed with an idempotency key are deleted, the personal attributes are removed from its events and the values
+// of its audit entries are redacted. Returns database.ErrNotFound when the user is not found or was already erased.
+func (s *PersonalData) ErasePersonalData(ctx context.Context, id model.UserID) (*model.Erasure, error) {
+	q := s.storage.QueryBuilder().Select(s.colUsersEmail, s.colUsersDeletedAt).
+		From(UserTable).
+		Where(squirrel.Eq{s.colUsersID: id, s.colUsersErasedAt: nil}).
+		Suffix("FOR UPDATE")
+
+	var u model.User
+
+	if err := s.storage.Select(ctx, q, &u); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, database.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	email := strings.ToLower(u.Email)
+
+	stmts := []struct {
+		table string
+		q     sqluct.ToSQL
+	}{
+		{UserTable, s.storage.QueryBuilder().Update(UserTable).
+			Set(s.colUsersEmail, "").
+			Set(s.colUsersPasswordHash, "").
+			Set(s.colUsersFirstName, "").
+			Set(s.colUsersLastName, "").
+			Set(s.colUsersNickname, "").
+			Set(s.colUsersStatusReason, "").
+			Set(s.colUsersEmailVerifiedAt, nil).
+			Set(s.colUsersDeletedAt, squirrel.Expr("COALESCE("+s.colUsersDeletedAt+", NOW())")).
+			Set(s.colUsersErasedAt, squirrel.Expr("NOW()")).
+			Where(squirrel.Eq{s.colUsersID: id})},
+		{RefreshTokenTable, s.storage.DeleteStmt(RefreshTokenTable).Where(squirrel.Eq{s.colUserID: id})},
+		{VerificationTokenTable, s.storage.DeleteStmt(VerificationTokenTable).Where(squirrel.Eq{s.colUserID: id})},
+		{PasswordResetTokenTable, s.storage.DeleteStmt(PasswordResetTokenTable).Where(squirrel.Eq{s.colUserID: id})},
+		{UserRoleTable, s.storage.DeleteStmt(UserRoleTable).Where(squirrel.Eq{s.colUserID: id})},
+		{LoginAttemptTable, s.storage.DeleteStmt(LoginAttemptTable).Where(squirrel.Eq{s.colLoginEmail: email})},
+		{RateLimitTable, s.storage.DeleteStmt(RateLimitTable).
+			Where(squirrel.Eq{s.colRateLimitKey: passwordResetEmailKeyPrefix + email})},
+		{OutboxTable, s.storage.QueryBuilder().Update(OutboxTable).
+			Set(s.colOutboxPayload, squirrel.Expr(s.colOutboxPayload+" - "+outboxPersonalKeys)).
+			Where(squirrel.Eq{s.colUserID: id})},
+		{AuditTable, s.storage.QueryBuilder().Update(AuditTable).
+			Set(s.colAuditChanges, squirrel.Expr(auditRedactedChanges)).
+			Set(s.colAuditSourceIP, "").
+			Where(squirrel.Eq{s.colUserID: id})},
+		{IdempotencyKeyTable, s.storage.DeleteStmt(IdempotencyKeyTable).Where(squirrel.Eq{s.colUserID: id})},
+	}
+
+	erasure := &model.Erasure{
+		Deleted: u.DeletedAt != nil,
+		Records: make(map[string]int64, len(stmts)),
+	}
+
+	for _, stmt := range stmts {
+		res, err := s.storage.Exec(ctx, stmt.q)
+		if err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+
+		erasure.Records[stmt.table] = rowsAffected
+	}
+
+	return erasure, nil
+}
+
+// AddErasureCertificate stores the erasure certificate, setting its id and the time of the erasure.
+func (s *PersonalData) AddErasureCertificate(ctx context.Context, c *model.ErasureCertificate) error {
+	records, err := json.Marshal(c.Records)
+	if err != nil {
+		return err
+	}
+
+	row := ErasureCertificate{
+		UserID:   c.UserID,
+		ErasedBy: c.ErasedBy,
+		Records:  records,
+	}
+
+	q := s.storage.InsertStmt(ErasureCertificateTable, row).
+		Suffix("RETURNING " + s.colCertificateID + ", " + s.colCertificateErasedAt)
+
+	if err := s.storage.Select(ctx, q, &row); err != nil {
+		return err
+	}
+
+	c.ID = row.ID
+	c.ErasedAt = row.ErasedAt
+
+	return nil
+}
+
+func nullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.Time
+}
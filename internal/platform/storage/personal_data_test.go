@@ -0,0 +1,245 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersonalData_ExportPersonalData(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+	createdAt := time.Date(2024, 12, 25, 9, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(`SELECT id, email_verified_at, deleted_at, erased_at, version, created_at, updated_at, password_hash, email, first_name, last_name, nickname, country, status, status_reason, status_changed_by, status_changed_at, status_expires_at FROM users WHERE id = $1`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "password_hash", "email", "first_name"}).
+				AddRow(userID, "hash", "John@Example.com", "John"))
+		mock.ExpectQuery(`SELECT user_id, role, created_at FROM user_roles WHERE user_id = $1 ORDER BY created_at`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "role", "created_at"}).
+				AddRow(userID, "admin", createdAt))
+		mock.ExpectQuery(`SELECT token_hash, family_id, user_id, created_at, expires_at, revoked_at FROM refresh_tokens WHERE user_id = $1 ORDER BY created_at`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"token_hash", "family_id", "created_at", "expires_at", "revoked_at"}).
+				AddRow("3f0a", userID, createdAt, createdAt.Add(time.Hour), createdAt))
+		mock.ExpectQuery(`SELECT token_hash, user_id, email, created_at, expires_at, used_at FROM email_verification_tokens WHERE user_id = $1 ORDER BY created_at`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"token_hash", "email", "created_at", "expires_at"}))
+		mock.ExpectQuery(`SELECT token_hash, user_id, created_at, expires_at, used_at FROM password_reset_tokens WHERE user_id = $1 ORDER BY created_at`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"token_hash", "created_at", "expires_at"}))
+		mock.ExpectQuery(`SELECT email, failures, locked_until, updated_at FROM login_attempts WHERE email = $1`).
+			WithArgs("john@example.com").
+			WillReturnRows(sqlmock.NewRows([]string{"email", "failures", "updated_at"}).
+				AddRow("john@example.com", 2, createdAt))
+		mock.ExpectQuery(`SELECT id, event_id, user_id, event_type, payload, country, attempts, last_error, created_at, next_attempt_at, claimed_until, published_at, failed_at FROM outbox WHERE user_id = $1 ORDER BY id`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "event_id", "event_type", "payload", "created_at"}).
+				AddRow(1, userID, storage.EventUserAdded, []byte(`{"Email":"John@Example.com"}`), createdAt))
+		mock.ExpectQuery(`SELECT id, user_id, action, actor, request_id, source_ip, changes, created_at FROM audit_events WHERE user_id = $1 ORDER BY id`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "action", "actor", "changes", "created_at"}).
+				AddRow(1, userID, model.AuditUserAdded, "admin", []byte(`{"email":{"before":"","after":"John@Example.com"}}`), createdAt))
+		mock.ExpectQuery(`SELECT subject, key, method, fingerprint, response, header, user_id, created_at, expires_at, locked_until FROM idempotency_keys WHERE user_id = $1 ORDER BY created_at`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"subject", "key", "method", "response", "user_id", "created_at", "expires_at"}).
+				AddRow("admin", "add-john", "/api.faceit.FaceitService/AddUser", []byte("response"), userID, createdAt, createdAt.Add(24*time.Hour)))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewPersonalData(st)
+
+		d, err := repo.ExportPersonalData(context.Background(), userID)
+		require.NoError(t, err)
+
+		require.Equal(t, "John@Example.com", d.User.Email)
+		require.Empty(t, d.User.PasswordHash)
+		require.Equal(t, []model.RoleAssignment{{Role: model.RoleAdmin, AssignedAt: createdAt}}, d.Roles)
+		require.Len(t, d.Sessions, 1)
+		require.Equal(t, &createdAt, d.Sessions[0].RevokedAt)
+		require.Empty(t, d.Verifications)
+		require.Empty(t, d.PasswordResets)
+		require.Equal(t, &model.LoginAttemptsRecord{Failures: 2, UpdatedAt: createdAt}, d.LoginAttempts)
+		require.Len(t, d.Events, 1)
+		require.Equal(t, storage.EventUserAdded, d.Events[0].Type)
+		require.Len(t, d.AuditEntries, 1)
+		require.Equal(t, map[string]model.AuditChange{"email": {After: "John@Example.com"}}, d.AuditEntries[0].Changes)
+		require.Equal(t, []model.IdempotencyRecord{{
+			Key:       "add-john",
+			Method:    "/api.faceit.FaceitService/AddUser",
+			Response:  []byte("response"),
+			CreatedAt: createdAt,
+			ExpiresAt: createdAt.Add(24 * time.Hour),
+		}}, d.IdempotencyKeys)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(`SELECT id, email_verified_at, deleted_at, erased_at, version, created_at, updated_at, password_hash, email, first_name, last_name, nickname, country, status, status_reason, status_changed_by, status_changed_at, status_expires_at FROM users WHERE id = $1`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewPersonalData(st)
+
+		_, err = repo.ExportPersonalData(context.Background(), userID)
+		require.ErrorIs(t, err, database.ErrNotFound)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersonalData_ErasePersonalData(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+	query := `SELECT email, deleted_at FROM users WHERE erased_at IS NULL AND id = $1 FOR UPDATE`
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(query).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"email", "deleted_at"}).
+				AddRow("John@Example.com", nil))
+		mock.ExpectExec(`UPDATE users SET email = $1, password_hash = $2, first_name = $3, last_name = $4, nickname = $5, status_reason = $6, email_verified_at = $7, deleted_at = COALESCE(deleted_at, NOW()), erased_at = NOW() WHERE id = $8`).
+			WithArgs("", "", "", "", "", "", nil, userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM refresh_tokens WHERE user_id = $1`).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(`DELETE FROM email_verification_tokens WHERE user_id = $1`).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM password_reset_tokens WHERE user_id = $1`).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`DELETE FROM user_roles WHERE user_id = $1`).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM login_attempts WHERE email = $1`).
+			WithArgs("john@example.com").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM rate_limits WHERE key = $1`).
+			WithArgs("password_reset:email:john@example.com").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`UPDATE outbox SET payload = payload - '{Email,FirstName,LastName,Nickname,StatusReason}'::text[] WHERE user_id = $1`).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`UPDATE audit_events SET changes = (SELECT COALESCE(jsonb_object_agg(key, '{"before":"[REDACTED]","after":"[REDACTED]"}'::jsonb), '{}') FROM jsonb_each(changes)), source_ip = $1 WHERE user_id = $2`).
+			WithArgs("", userID).
+			WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectExec(`DELETE FROM idempotency_keys WHERE user_id = $1`).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewPersonalData(st)
+
+		erasure, err := repo.ErasePersonalData(context.Background(), userID)
+		require.NoError(t, err)
+
+		require.Equal(t, &model.Erasure{
+			Records: map[string]int64{
+				"users":                     1,
+				"refresh_tokens":            3,
+				"email_verification_tokens": 1,
+				"password_reset_tokens":     0,
+				"user_roles":                1,
+				"login_attempts":            1,
+				"rate_limits":               0,
+				"outbox":                    2,
+				"audit_events":              4,
+				"idempotency_keys":          2,
+			},
+		}, erasure)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(query).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"email", "deleted_at"}))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewPersonalData(st)
+
+		_, err = repo.ErasePersonalData(context.Background(), userID)
+		require.ErrorIs(t, err, database.ErrNotFound)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPersonalData_AddErasureCertificate(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+	certID := uuid.MustParse("0b9b2ac2-3f0c-4b8e-9d0a-6c8c2f1d5e7a")
+	erasedAt := time.Date(2024, 12, 25, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`INSERT INTO erasure_certificates (user_id,erased_by,records) VALUES ($1,$2,$3) RETURNING id, erased_at`).
+		WithArgs(userID, "admin", []byte(`{"users":1}`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "erased_at"}).AddRow(certID, erasedAt))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewPersonalData(st)
+
+	c := &model.ErasureCertificate{
+		UserID:   userID,
+		ErasedBy: "admin",
+		Records:  map[string]int64{"users": 1},
+	}
+
+	err = repo.AddErasureCertificate(context.Background(), c)
+	require.NoError(t, err)
+
+	require.Equal(t, certID, c.ID)
+	require.Equal(t, erasedAt, c.ErasedAt)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/sqluct"
+)
+
+// RateLimitTable is the table name for rate limits.
+const RateLimitTable = "rate_limits"
+
+// RateLimit represents the hits of a rate limited key within the current window.
+type RateLimit struct {
+	Key         string    `db:"key"`
+	Hits        int       `db:"hits"`
+	WindowStart time.Time `db:"window_start"`
+}
+
+// RateLimiter represents a RateLimiter repository, counting the hits of the rate limited keys in fixed windows.
+type RateLimiter struct {
+	storage *sqluct.Storage
+
+	// col names for rate limits table search
+	colKey         string
+	colHits        string
+	colWindowStart string
+}
+
+// NewRateLimiter returns instance of RateLimiter repository.
+func NewRateLimiter(storage *sqluct.Storage) *RateLimiter {
+	var l RateLimit
+
+	return &RateLimiter{
+		storage:        storage,
+		colKey:         storage.Mapper.Col(&l, &l.Key),
+		colHits:        storage.Mapper.Col(&l, &l.Hits),
+		colWindowStart: storage.Mapper.Col(&l, &l.WindowStart),
+	}
+}
+
+// Hit records a hit of the key, returning the number of hits within the window.
+//
+// The window of the key starts over at the given time when it started before windowStart.
+func (s *RateLimiter) Hit(ctx context.Context, key string, windowStart, at time.Time) (int, error) {
+	expired, args, err := squirrel.Expr(RateLimitTable+"."+s.colWindowStart+" < ?", windowStart).ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := s.storage.QueryBuilder().Insert(RateLimitTable).
+		Columns(s.colKey, s.colHits, s.colWindowStart).
+		Values(key, 1, at).
+		Suffix("ON CONFLICT ("+s.colKey+") DO UPDATE SET "+
+			s.colHits+" = CASE WHEN "+expired+" THEN 1 ELSE "+RateLimitTable+"."+s.colHits+" + 1 END, "+
+			s.colWindowStart+" = CASE WHEN "+expired+" THEN EXCLUDED."+s.colWindowStart+" ELSE "+
+			RateLimitTable+"."+s.colWindowStart+" END", append(args, args...)...).
+		Suffix("RETURNING " + s.colHits)
+
+	var hits int
+
+	if err := s.storage.Select(ctx, q, &hits); err != nil {
+		return 0, err
+	}
+
+	return hits, nil
+}
@@ -0,0 +1,40 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Hit(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	at := time.Date(2024, 12, 22, 9, 0, 0, 0, time.UTC)
+	windowStart := at.Add(-time.Hour)
+
+	mock.ExpectQuery(`
+			INSERT INTO rate_limits (key,hits,window_start) VALUES ($1,$2,$3) ON CONFLICT (key) DO UPDATE SET hits = CASE WHEN rate_limits.window_start < $4 THEN 1 ELSE rate_limits.hits + 1 END, window_start = CASE WHEN rate_limits.window_start < $5 THEN EXCLUDED.window_start ELSE rate_limits.window_start END RETURNING hits
+		`).
+		WithArgs("password_reset:email:alice@bob.com", 1, at, windowStart, windowStart).
+		WillReturnRows(sqlmock.NewRows([]string{"hits"}).AddRow(2))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewRateLimiter(st)
+
+	hits, err := repo.Hit(context.Background(), "password_reset:email:alice@bob.com", windowStart, at)
+	require.NoError(t, err)
+	require.Equal(t, 2, hits)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/domain/model"
+)
+
+// UserRoleTable is the table name for the role assignments.
+const UserRoleTable = "user_roles"
+
+// UserRole represents a role assigned to a user.
+type UserRole struct {
+	UserID    model.UserID `db:"user_id"`
+	Role      model.Role   `db:"role"`
+	CreatedAt time.Time    `db:"created_at"`
+}
+
+// Role represents a Role repository, storing the role assignments.
+type Role struct {
+	storage *sqluct.Storage
+
+	// col names for user roles table search
+	colUserID string
+	colRole   string
+}
+
+// NewRole returns instance of Role repository.
+func NewRole(storage *sqluct.Storage) *Role {
+	var r UserRole
+
+	return &Role{
+		storage:   storage,
+		colUserID: storage.Mapper.Col(&r, &r.UserID),
+		colRole:   storage.Mapper.Col(&r, &r.Role),
+	}
+}
+
+// FindUserRoles finds the roles assigned to the user, none when the user has no assignment.
+func (s *Role) FindUserRoles(ctx context.Context, id model.UserID) ([]model.Role, error) {
+	q := s.storage.QueryBuilder().Select(s.colRole).
+		From(UserRoleTable).
+		Where(squirrel.Eq{s.colUserID: id}).
+		OrderBy(s.colRole)
+
+	var roles []model.Role
+
+	if err := s.storage.Select(ctx, q, &roles); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
@@ -0,0 +1,36 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRole_FindUserRoles(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	id := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+	mock.ExpectQuery(`SELECT role FROM user_roles WHERE user_id = $1 ORDER BY role`).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("admin").AddRow("support"))
+
+	repo := storage.NewRole(sqluct.NewStorage(sqlx.NewDb(db, "sqlmock")))
+
+	roles, err := repo.FindUserRoles(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, []model.Role{model.RoleAdmin, model.RoleSupport}, roles)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,143 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+)
+
+// RefreshTokenTable is the table name for refresh tokens.
+const RefreshTokenTable = "refresh_tokens"
+
+// RefreshToken represents a refresh token issued to a user.
+//
+// Only the hash of the token is stored. Tokens rotated from the same login share the family, so the whole session
+// can be revoked at once.
+type RefreshToken struct {
+	TokenHash string       `db:"token_hash"`
+	FamilyID  uuid.UUID    `db:"family_id"`
+	UserID    model.UserID `db:"user_id"`
+
+	CreatedAt time.Time    `db:"created_at"`
+	ExpiresAt time.Time    `db:"expires_at"`
+	RevokedAt sql.NullTime `db:"revoked_at"`
+}
+
+// Session represents a Session repository, storing the refresh tokens.
+type Session struct {
+	storage *sqluct.Storage
+
+	// col names for refresh tokens table search
+	colTokenHash string
+	colFamilyID  string
+	colUserID    string
+	colRevokedAt string
+}
+
+// NewSession returns instance of Session repository.
+func NewSession(storage *sqluct.Storage) *Session {
+	var t RefreshToken
+
+	return &Session{
+		storage:      storage,
+		colTokenHash: storage.Mapper.Col(&t, &t.TokenHash),
+		colFamilyID:  storage.Mapper.Col(&t, &t.FamilyID),
+		colUserID:    storage.Mapper.Col(&t, &t.UserID),
+		colRevokedAt: storage.Mapper.Col(&t, &t.RevokedAt),
+	}
+}
+
+// InTx runs the function within a transaction.
+func (s *Session) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return s.storage.InTx(ctx, fn)
+}
+
+// AddRefreshToken stores the refresh token.
+func (s *Session) AddRefreshToken(ctx context.Context, t *RefreshToken) error {
+	q := s.storage.InsertStmt(RefreshTokenTable, t)
+
+	res, err := s.storage.Exec(ctx, q)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.New("no rows affected")
+	}
+
+	return nil
+}
+
+// FindRefreshToken finds the refresh token by hash.
+func (s *Session) FindRefreshToken(ctx context.Context, hash string) (*RefreshToken, error) {
+	q := s.storage.SelectStmt(RefreshTokenTable, RefreshToken{}).
+		Where(squirrel.Eq{s.colTokenHash: hash})
+
+	var t RefreshToken
+
+	err := s.storage.Select(ctx, q, &t)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, database.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// RevokeRefreshToken revokes the refresh token.
+//
+// Returns false when the token was already revoked, so two concurrent rotations of a token can not both succeed.
+func (s *Session) RevokeRefreshToken(ctx context.Context, hash string, at time.Time) (bool, error) {
+	q := s.storage.QueryBuilder().Update(RefreshTokenTable).
+		Set(s.colRevokedAt, at).
+		Where(squirrel.Eq{s.colTokenHash: hash, s.colRevokedAt: nil})
+
+	res, err := s.storage.Exec(ctx, q)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// RevokeRefreshTokenFamily revokes all the refresh tokens of the family not revoked yet.
+func (s *Session) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID, at time.Time) error {
+	q := s.storage.QueryBuilder().Update(RefreshTokenTable).
+		Set(s.colRevokedAt, at).
+		Where(squirrel.Eq{s.colFamilyID: familyID, s.colRevokedAt: nil})
+
+	_, err := s.storage.Exec(ctx, q)
+
+	return err
+}
+
+// RevokeUserRefreshTokens revokes all the refresh tokens of the user not revoked yet, all its sessions.
+func (s *Session) RevokeUserRefreshTokens(ctx context.Context, id model.UserID, at time.Time) error {
+	q := s.storage.QueryBuilder().Update(RefreshTokenTable).
+		Set(s.colRevokedAt, at).
+		Where(squirrel.Eq{s.colUserID: id, s.colRevokedAt: nil})
+
+	_, err := s.storage.Exec(ctx, q)
+
+	return err
+}
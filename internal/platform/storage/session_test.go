@@ -0,0 +1,176 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSession_AddRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	tok := &storage.RefreshToken{
+		TokenHash: "3f0a",
+		FamilyID:  uuid.MustParse("9a4d1c9e-5a0e-4a4c-9d0e-6b1f2a3c4d5e"),
+		UserID:    uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2"),
+		CreatedAt: time.Date(2024, 12, 19, 9, 0, 0, 0, time.UTC),
+		ExpiresAt: time.Date(2025, 1, 18, 9, 0, 0, 0, time.UTC),
+	}
+
+	mock.ExpectExec(`
+			INSERT INTO refresh_tokens (token_hash,family_id,user_id,created_at,expires_at,revoked_at) VALUES ($1,$2,$3,$4,$5,$6)
+		`).
+		WithArgs(tok.TokenHash, tok.FamilyID, tok.UserID, tok.CreatedAt, tok.ExpiresAt, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewSession(st)
+
+	err = repo.AddRefreshToken(context.Background(), tok)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSession_FindRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		SELECT token_hash, family_id, user_id, created_at, expires_at, revoked_at FROM refresh_tokens WHERE token_hash = $1
+	`
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+		mock.ExpectQuery(query).
+			WithArgs("3f0a").
+			WillReturnRows(sqlmock.NewRows([]string{"token_hash", "user_id"}).AddRow("3f0a", userID))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewSession(st)
+
+		tok, err := repo.FindRefreshToken(context.Background(), "3f0a")
+		require.NoError(t, err)
+		require.Equal(t, &storage.RefreshToken{TokenHash: "3f0a", UserID: userID}, tok)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(query).
+			WithArgs("3f0a").
+			WillReturnRows(sqlmock.NewRows([]string{"token_hash"}))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewSession(st)
+
+		tok, err := repo.FindRefreshToken(context.Background(), "3f0a")
+		require.ErrorIs(t, err, database.ErrNotFound)
+		require.Nil(t, tok)
+	})
+}
+
+func TestSession_RevokeRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, 12, 19, 9, 0, 0, 0, time.UTC)
+
+	query := `
+		UPDATE refresh_tokens SET revoked_at = $1 WHERE revoked_at IS NULL AND token_hash = $2
+	`
+
+	t.Run("revoked", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(query).
+			WithArgs(at, "3f0a").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewSession(st)
+
+		revoked, err := repo.RevokeRefreshToken(context.Background(), "3f0a", at)
+		require.NoError(t, err)
+		require.True(t, revoked)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already revoked", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(query).
+			WithArgs(at, "3f0a").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewSession(st)
+
+		revoked, err := repo.RevokeRefreshToken(context.Background(), "3f0a", at)
+		require.NoError(t, err)
+		require.False(t, revoked)
+	})
+}
+
+func TestSession_RevokeUserRefreshTokens(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	at := time.Date(2024, 12, 22, 9, 0, 0, 0, time.UTC)
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+	mock.ExpectExec(`
+			UPDATE refresh_tokens SET revoked_at = $1 WHERE revoked_at IS NULL AND user_id = $2
+		`).
+		WithArgs(at, userID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewSession(st)
+
+	err = repo.RevokeUserRefreshTokens(context.Background(), userID, at)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
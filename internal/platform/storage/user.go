@@ -2,12 +2,18 @@ package storage
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"slices"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/bool64/ctxd"
 	"github.com/bool64/sqluct"
 	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
+	"github.com/dohernandez/faceit/internal/platform/filter"
 	"github.com/dohernandez/go-grpc-service/database"
 	"github.com/dohernandez/go-grpc-service/database/pgx"
 )
@@ -20,8 +26,28 @@ type User struct {
 	storage *sqluct.Storage
 
 	// col names for users table search
-	colID      string
-	colCountry string
+	colID              string
+	colCountry         string
+	colCreatedAt       string
+	colUpdatedAt       string
+	colEmail           string
+	colEmailVerifiedAt string
+	colPasswordHash    string
+	colStatus          string
+	colStatusReason    string
+	colStatusChangedBy string
+	colStatusChangedAt string
+	colStatusExpiresAt string
+	colDeletedAt       string
+	colErasedAt        string
+	colVersion         string
+
+	// columns of the fields of the user state set by the updates
+	fieldCols map[model.UserField]string
+
+	// columns allowed in list filters and ordering, and the names of the API fields aliasing them
+	filterCols    filter.Columns
+	filterAliases filter.Aliases
 }
 
 // NewUser returns instance of User repository.
@@ -29,15 +55,161 @@ func NewUser(storage *sqluct.Storage) *User {
 	var user model.User
 
 	return &User{
-		storage:    storage,
-		colID:      storage.Mapper.Col(&user, &user.ID),
-		colCountry: storage.Mapper.Col(&user, &user.Country),
+		storage:            storage,
+		colID:              storage.Mapper.Col(&user, &user.ID),
+		colCountry:         storage.Mapper.Col(&user, &user.Country),
+		colCreatedAt:       storage.Mapper.Col(&user, &user.CreatedAt),
+		colUpdatedAt:       storage.Mapper.Col(&user, &user.UpdatedAt),
+		colEmail:           storage.Mapper.Col(&user, &user.Email),
+		colEmailVerifiedAt: storage.Mapper.Col(&user, &user.EmailVerifiedAt),
+		colPasswordHash:    storage.Mapper.Col(&user, &user.PasswordHash),
+		colStatus:          storage.Mapper.Col(&user, &user.Status),
+		colStatusReason:    storage.Mapper.Col(&user, &user.StatusReason),
+		colStatusChangedBy: storage.Mapper.Col(&user, &user.StatusChangedBy),
+		colStatusChangedAt: storage.Mapper.Col(&user, &user.StatusChangedAt),
+		colStatusExpiresAt: storage.Mapper.Col(&user, &user.StatusExpiresAt),
+		colDeletedAt:       storage.Mapper.Col(&user, &user.DeletedAt),
+		colErasedAt:        storage.Mapper.Col(&user, &user.ErasedAt),
+		colVersion:         storage.Mapper.Col(&user, &user.Version),
+		fieldCols: map[model.UserField]string{
+			model.UserFieldEmail:     storage.Mapper.Col(&user, &user.Email),
+			model.UserFieldFirstName: storage.Mapper.Col(&user, &user.FirstName),
+			model.UserFieldLastName:  storage.Mapper.Col(&user, &user.LastName),
+			model.UserFieldNickname:  storage.Mapper.Col(&user, &user.Nickname),
+			model.UserFieldCountry:   storage.Mapper.Col(&user, &user.Country),
+			model.UserFieldPassword:  storage.Mapper.Col(&user, &user.PasswordHash),
+		},
+		// Only the public fields are searchable: the password hash would allow guessing the credentials, the deleted
+		// and erased users are never listed.
+		filterCols: filter.Columns{
+			storage.Mapper.Col(&user, &user.ID):              filter.TypeUUID,
+			storage.Mapper.Col(&user, &user.Email):           filter.TypeText,
+			storage.Mapper.Col(&user, &user.FirstName):       filter.TypeText,
+			storage.Mapper.Col(&user, &user.LastName):        filter.TypeText,
+			storage.Mapper.Col(&user, &user.Nickname):        filter.TypeNullableText,
+			storage.Mapper.Col(&user, &user.Country):         filter.TypeNullableText,
+			storage.Mapper.Col(&user, &user.Status):          filter.TypeText,
+			storage.Mapper.Col(&user, &user.StatusReason):    filter.TypeText,
+			storage.Mapper.Col(&user, &user.StatusChangedBy): filter.TypeText,
+			storage.Mapper.Col(&user, &user.CreatedAt):       filter.TypeTime,
+			storage.Mapper.Col(&user, &user.UpdatedAt):       filter.TypeTime,
+		},
+		filterAliases: filter.Aliases{
+			"create_time": storage.Mapper.Col(&user, &user.CreatedAt),
+			"update_time": storage.Mapper.Col(&user, &user.UpdatedAt),
+		},
 	}
 }
 
-// AddUser store the user data.
+// AddUser store the user data, setting the status, the version and the timestamps of the user stored.
 func (s *User) AddUser(ctx context.Context, u *model.User) error {
-	q := s.storage.InsertStmt(UserTable, u, sqluct.SkipZeroValues)
+	q := s.storage.InsertStmt(UserTable, u, sqluct.SkipZeroValues).
+		Suffix("RETURNING " + s.colStatus + ", " + s.colVersion + ", " + s.colCreatedAt + ", " + s.colUpdatedAt)
+
+	if err := s.storage.Select(ctx, q, u); err != nil {
+		if pgx.IsUniqueViolation(err) {
+			return ctxd.LabeledError(database.ErrAlreadyExists, err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// UpdateUser updates the fields of the user data to their value in the state, zero values included, provided the user
+// is at the version, whatever its version when 0.
+//
+// Changing the email resets its verification, the new email is not verified. Returns usecase.ErrVersionMismatch when
+// the version is given and the user is not at it.
+func (s *User) UpdateUser(ctx context.Context, id model.UserID, state model.UserState, fields model.UserFields, version int64) error {
+	cols := make([]string, 0, len(fields))
+
+	for _, f := range fields {
+		col, ok := s.fieldCols[f]
+		if !ok {
+			return fmt.Errorf("unknown user field %q", f)
+		}
+
+		cols = append(cols, col)
+	}
+
+	q := s.storage.UpdateStmt(UserTable, state, sqluct.Columns(cols...), sqluct.IgnoreOmitEmpty).
+		Set(s.colVersion, s.nextVersion()).
+		Where(squirrel.Eq{s.colID: id, s.colDeletedAt: nil})
+
+	if version != 0 {
+		q = q.Where(squirrel.Eq{s.colVersion: version})
+	}
+
+	if fields.Has(model.UserFieldEmail) {
+		// The right-hand side expressions of the SET clause see the email before the update.
+		q = q.Set(s.colEmailVerifiedAt, squirrel.Expr(
+			"CASE WHEN "+s.colEmail+" = ? THEN "+s.colEmailVerifiedAt+" END", state.Email,
+		))
+	}
+
+	return s.execVersioned(ctx, q, version)
+}
+
+// DeleteUser deletes the user softly, provided the user is at the version, whatever its version when 0. The user is
+// tombstoned until purged.
+//
+// The time of the deletion is set by the database. Deleted users are left out of the other queries. Returns
+// usecase.ErrVersionMismatch when the version is given and the user is not at it.
+func (s *User) DeleteUser(ctx context.Context, id model.UserID, version int64) error {
+	q := s.storage.QueryBuilder().Update(UserTable).
+		Set(s.colDeletedAt, squirrel.Expr("NOW()")).
+		Set(s.colVersion, s.nextVersion()).
+		Where(squirrel.Eq{s.colID: id, s.colDeletedAt: nil})
+
+	if version != 0 {
+		q = q.Where(squirrel.Eq{s.colVersion: version})
+	}
+
+	return s.execVersioned(ctx, q, version)
+}
+
+// nextVersion is the expression of the version of the user after an update.
+func (s *User) nextVersion() squirrel.Sqlizer {
+	return squirrel.Expr(s.colVersion + " + 1")
+}
+
+// execVersioned executes the update of a user conditioned on its version, whatever its version when 0.
+//
+// The user is found, a user not updated is not at the version.
+func (s *User) execVersioned(ctx context.Context, q squirrel.UpdateBuilder, version int64) error {
+	res, err := s.storage.Exec(ctx, q)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	if version != 0 {
+		return usecase.ErrVersionMismatch
+	}
+
+	return database.ErrNotFound
+}
+
+// RestoreUser restores the user deleted within the grace period.
+//
+// Returns database.ErrNotFound when the user is not deleted, was deleted before the grace period or its personal data
+// was erased, and database.ErrAlreadyExists when its email was registered again in between.
+func (s *User) RestoreUser(ctx context.Context, id model.UserID, grace time.Duration) error {
+	q := s.storage.QueryBuilder().Update(UserTable).
+		Set(s.colDeletedAt, nil).
+		Set(s.colVersion, s.nextVersion()).
+		Where(squirrel.Eq{s.colID: id, s.colErasedAt: nil}).
+		Where(squirrel.Expr(s.colDeletedAt+" > NOW() - make_interval(secs => ?)", grace.Seconds()))
 
 	res, err := s.storage.Exec(ctx, q)
 	if err != nil {
@@ -54,15 +226,39 @@ func (s *User) AddUser(ctx context.Context, u *model.User) error {
 	}
 
 	if rowsAffected == 0 {
-		return errors.New("no rows affected")
+		return database.ErrNotFound
+	}
+
+	return nil
+}
+
+// ListDeletedUsers lists the users deleted at or before the given time, the earliest deleted first.
+//
+// The users whose personal data was erased are left out, they are kept for good.
+func (s *User) ListDeletedUsers(ctx context.Context, before time.Time, limit uint64) ([]*model.User, error) {
+	q := s.storage.SelectStmt(UserTable, model.User{}).
+		Where(squirrel.LtOrEq{s.colDeletedAt: before}).
+		Where(squirrel.Eq{s.colErasedAt: nil}).
+		OrderBy(s.colDeletedAt, s.colID).
+		Limit(limit)
+
+	var users []*model.User
+
+	if err := s.storage.Select(ctx, q, &users); err != nil {
+		return nil, err
 	}
 
-	return err
+	return users, nil
 }
 
-// UpdateUser updates the user data.
-func (s *User) UpdateUser(ctx context.Context, id model.UserID, state model.UserState) error {
-	q := s.storage.UpdateStmt(UserTable, state).Where(squirrel.Eq{s.colID: id})
+// PurgeUser deletes the user deleted at or before the given time for good.
+//
+// Returns database.ErrNotFound when the user is not deleted, e.g. restored in between, was deleted after the given
+// time or its personal data was erased.
+func (s *User) PurgeUser(ctx context.Context, id model.UserID, before time.Time) error {
+	q := s.storage.DeleteStmt(UserTable).
+		Where(squirrel.Eq{s.colID: id, s.colErasedAt: nil}).
+		Where(squirrel.LtOrEq{s.colDeletedAt: before})
 
 	res, err := s.storage.Exec(ctx, q)
 	if err != nil {
@@ -81,9 +277,49 @@ func (s *User) UpdateUser(ctx context.Context, id model.UserID, state model.User
 	return nil
 }
 
-// DeleteUser deletes the user data.
-func (s *User) DeleteUser(ctx context.Context, id model.UserID) error {
-	q := s.storage.DeleteStmt(UserTable).Where(squirrel.Eq{s.colID: id})
+// FindByID finds the user by id.
+func (s *User) FindByID(ctx context.Context, id model.UserID) (*model.User, error) {
+	q := s.storage.SelectStmt(UserTable, model.User{}).
+		Where(squirrel.Eq{s.colID: id, s.colDeletedAt: nil})
+
+	var u model.User
+
+	err := s.storage.Select(ctx, q, &u)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, database.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+// FindByEmail finds the user by email, including the password hash.
+func (s *User) FindByEmail(ctx context.Context, email string) (*model.User, error) {
+	q := s.storage.SelectStmt(UserTable, model.User{}).
+		Where(squirrel.Eq{s.colEmail: email, s.colDeletedAt: nil})
+
+	var u model.User
+
+	err := s.storage.Select(ctx, q, &u)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, database.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+// UpdatePasswordHash replaces the password hash of the user.
+func (s *User) UpdatePasswordHash(ctx context.Context, id model.UserID, hash string) error {
+	q := s.storage.UpdateStmt(UserTable, model.UserState{PasswordHash: hash}, sqluct.Columns(s.colPasswordHash)).
+		Set(s.colVersion, s.nextVersion()).
+		Where(squirrel.Eq{s.colID: id, s.colDeletedAt: nil})
 
 	res, err := s.storage.Exec(ctx, q)
 	if err != nil {
@@ -102,18 +338,151 @@ func (s *User) DeleteUser(ctx context.Context, id model.UserID) error {
 	return nil
 }
 
-// ListByCountry lists users by country.
-func (s *User) ListByCountry(ctx context.Context, country string, limit, offset uint64) ([]*model.User, error) {
+// ChangeStatus changes the status of the user, provided its last status change is still the one it changes from.
+//
+// The time of the change is set by the database. A user whose status changed in between is left as is.
+func (s *User) ChangeStatus(ctx context.Context, id model.UserID, from, to model.UserStatusChange) error {
+	q := s.storage.UpdateStmt(UserTable, to,
+		sqluct.Columns(s.colStatus, s.colStatusReason, s.colStatusChangedBy, s.colStatusExpiresAt),
+	).
+		Set(s.colStatusChangedAt, squirrel.Expr("NOW()")).
+		Set(s.colVersion, s.nextVersion()).
+		Where(squirrel.Eq{s.colID: id, s.colStatus: from.Status, s.colDeletedAt: nil})
+
+	if from.StatusChangedAt == nil {
+		q = q.Where(squirrel.Eq{s.colStatusChangedAt: nil})
+	} else {
+		q = q.Where(squirrel.Eq{s.colStatusChangedAt: *from.StatusChangedAt})
+	}
+
+	res, err := s.storage.Exec(ctx, q)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: status changed concurrently", usecase.ErrInvalidStatusTransition)
+	}
+
+	return nil
+}
+
+// ListExpiredStatuses lists the suspended and banned users whose status expired at the given time, the earliest
+// expired first.
+func (s *User) ListExpiredStatuses(ctx context.Context, at time.Time, limit uint64) ([]*model.User, error) {
 	q := s.storage.SelectStmt(UserTable, model.User{}).
-		Where(squirrel.Eq{s.colCountry: country}).
-		Limit(limit).Offset(offset)
+		Where(squirrel.Eq{
+			s.colStatus:    []string{string(model.StatusSuspended), string(model.StatusBanned)},
+			s.colDeletedAt: nil,
+		}).
+		Where(squirrel.LtOrEq{s.colStatusExpiresAt: at}).
+		OrderBy(s.colStatusExpiresAt, s.colID).
+		Limit(limit)
 
 	var users []*model.User
 
-	err := s.storage.Select(ctx, q, &users)
-	if err != nil {
+	if err := s.storage.Select(ctx, q, &users); err != nil {
 		return nil, err
 	}
 
 	return users, nil
 }
+
+// ListByCountry lists users by country, sorted by creation time, the page of users after the cursor.
+func (s *User) ListByCountry(ctx context.Context, country string, limit uint64, after model.Cursor) (model.UserPage, error) {
+	q := s.storage.SelectStmt(UserTable, model.User{}).
+		Where(squirrel.Eq{s.colCountry: country, s.colDeletedAt: nil})
+
+	return s.page(ctx, q, []filter.Order{{Field: s.colCreatedAt}}, limit, after)
+}
+
+// List lists the users matching the AIP-160 filter, sorted by the AIP-132 order by, the page of users after the
+// cursor.
+//
+// The fields are the columns of the users, or the create_time and update_time of the API for their timestamps.
+func (s *User) List(ctx context.Context, filterExpr, orderBy string, limit uint64, after model.Cursor) (model.UserPage, error) {
+	q := s.storage.SelectStmt(UserTable, model.User{}).
+		Where(squirrel.Eq{s.colDeletedAt: nil})
+
+	e, err := filter.Parse(filterExpr)
+	if err != nil {
+		return model.UserPage{}, fmt.Errorf("%w: %w", usecase.ErrInvalidFilter, err)
+	}
+
+	if e != nil {
+		where, err := filter.Where(s.filterAliases.Expr(e), s.filterCols)
+		if err != nil {
+			return model.UserPage{}, fmt.Errorf("%w: %w", usecase.ErrInvalidFilter, err)
+		}
+
+		q = q.Where(where)
+	}
+
+	orders, err := filter.ParseOrderBy(orderBy)
+	if err != nil {
+		return model.UserPage{}, fmt.Errorf("%w: %w", usecase.ErrInvalidOrderBy, err)
+	}
+
+	orders = s.filterAliases.Orders(orders)
+
+	if _, err = filter.OrderBy(orders, s.filterCols); err != nil {
+		return model.UserPage{}, fmt.Errorf("%w: %w", usecase.ErrInvalidOrderBy, err)
+	}
+
+	return s.page(ctx, q, orders, limit, after)
+}
+
+// page selects the page of users after the cursor, sorted by the orders.
+//
+// Users are sorted by id after the orders, so the cursor is a unique position. The page is selected by the keyset of
+// the cursor rather than an offset, it does not slow down with the depth and does not skip nor duplicate users added
+// or removed in between.
+func (s *User) page(ctx context.Context, q squirrel.SelectBuilder, orders []filter.Order, limit uint64, after model.Cursor) (model.UserPage, error) {
+	if limit == 0 {
+		return model.UserPage{}, nil
+	}
+
+	if !slices.ContainsFunc(orders, func(o filter.Order) bool { return o.Field == s.colID }) {
+		orders = append(orders, filter.Order{Field: s.colID})
+	}
+
+	clauses, err := filter.OrderBy(orders, s.filterCols)
+	if err != nil {
+		return model.UserPage{}, err
+	}
+
+	if after != nil {
+		where, err := filter.After(orders, s.filterCols, after)
+		if err != nil {
+			return model.UserPage{}, fmt.Errorf("%w: %w", usecase.ErrInvalidPageToken, err)
+		}
+
+		q = q.Where(where)
+	}
+
+	// One more user tells whether there is a next page.
+	q = q.OrderBy(clauses...).Limit(limit + 1)
+
+	var users []*model.User
+
+	err = s.storage.Select(ctx, q, &users)
+	if err != nil {
+		return model.UserPage{}, err
+	}
+
+	if uint64(len(users)) <= limit {
+		return model.UserPage{Users: users}, nil
+	}
+
+	users = users[:limit]
+
+	return model.UserPage{
+		Users: users,
+		Next:  filter.Values(users[limit-1], orders),
+	}, nil
+}
@@ -3,10 +3,12 @@ package storage_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/bool64/sqluct"
 	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/faceit/internal/domain/usecase"
 	"github.com/dohernandez/faceit/internal/platform/storage"
 	"github.com/dohernandez/go-grpc-service/database"
 	"github.com/google/uuid"
@@ -38,9 +40,11 @@ func TestUser_AddUser(t *testing.T) {
 		require.NoError(t, err)
 		defer db.Close() //nolint:errcheck
 
-		mock.ExpectExec(`
+		createdAt := time.Date(2024, 12, 30, 9, 0, 0, 0, time.UTC)
+
+		mock.ExpectQuery(`
 				INSERT INTO users (id,password_hash,email,first_name,last_name,nickname,country) 
-				VALUES ($1,$2,$3,$4,$5,$6,$7)
+				VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING status, version, created_at, updated_at
 			`).
 			WithArgs(
 				user.ID,
@@ -51,14 +55,20 @@ func TestUser_AddUser(t *testing.T) {
 				user.Nickname,
 				user.Country,
 			).
-			WillReturnResult(sqlmock.NewResult(0, 1))
+			WillReturnRows(sqlmock.NewRows([]string{"status", "version", "created_at", "updated_at"}).AddRow("active", 1, createdAt, createdAt))
 
 		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
 
 		repo := storage.NewUser(st)
 
-		err = repo.AddUser(context.Background(), user)
+		added := *user
+
+		err = repo.AddUser(context.Background(), &added)
 		require.NoError(t, err)
+		require.Equal(t, model.StatusActive, added.Status)
+		require.Equal(t, int64(1), added.Version)
+		require.Equal(t, createdAt, added.CreatedAt)
+		require.Equal(t, createdAt, added.UpdatedAt)
 
 		require.NoError(t, mock.ExpectationsWereMet())
 	})
@@ -70,9 +80,9 @@ func TestUser_AddUser(t *testing.T) {
 		require.NoError(t, err)
 		defer db.Close() //nolint:errcheck
 
-		meQuery := mock.ExpectExec(`
+		meQuery := mock.ExpectQuery(`
 				INSERT INTO users (id,password_hash,email,first_name,last_name,nickname,country)
-				VALUES ($1,$2,$3,$4,$5,$6,$7)
+				VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING status, version, created_at, updated_at
 			`).
 			WithArgs(
 				user.ID,
@@ -104,7 +114,7 @@ func TestUser_AddUser(t *testing.T) {
 
 		meQuery := mock.ExpectQuery(`
 				INSERT INTO users (id,password_hash,email,first_name,last_name,nickname,country)
-				VALUES ($1,$2,$3,$4,$5,$6,$7)
+				VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING status, version, created_at, updated_at
 			`).
 			WithArgs(
 				user.ID,
@@ -137,6 +147,8 @@ func TestUser_UpdateUser(t *testing.T) {
 		Country:   "UK",
 	}
 
+	fields := model.UserFields{model.UserFieldFirstName, model.UserFieldLastName, model.UserFieldNickname, model.UserFieldCountry}
+
 	userID := uuid.New()
 
 	t.Run("success, partial update 4", func(t *testing.T) {
@@ -147,7 +159,7 @@ func TestUser_UpdateUser(t *testing.T) {
 		defer db.Close() //nolint:errcheck
 
 		meQuery := mock.ExpectExec(`
-				UPDATE users SET first_name = $1, last_name = $2, nickname = $3, country = $4 WHERE id = $5
+				UPDATE users SET first_name = $1, last_name = $2, nickname = $3, country = $4, version = version + 1 WHERE deleted_at IS NULL AND id = $5
 			`).
 			WithArgs(
 				userIfo.FirstName,
@@ -163,13 +175,13 @@ func TestUser_UpdateUser(t *testing.T) {
 
 		repo := storage.NewUser(st)
 
-		err = repo.UpdateUser(context.Background(), userID, userIfo)
+		err = repo.UpdateUser(context.Background(), userID, userIfo, fields, 0)
 		require.NoError(t, err)
 
 		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("success, partial update 2", func(t *testing.T) {
+	t.Run("success, nickname cleared", func(t *testing.T) {
 		t.Parallel()
 
 		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
@@ -177,7 +189,7 @@ func TestUser_UpdateUser(t *testing.T) {
 		defer db.Close() //nolint:errcheck
 
 		meQuery := mock.ExpectExec(`
-				UPDATE users SET first_name = $1, last_name = $2, nickname = $3 WHERE id = $4
+				UPDATE users SET first_name = $1, last_name = $2, nickname = $3, version = version + 1 WHERE deleted_at IS NULL AND id = $4
 			`).
 			WithArgs(
 				userIfo.FirstName,
@@ -195,7 +207,59 @@ func TestUser_UpdateUser(t *testing.T) {
 		err = repo.UpdateUser(context.Background(), userID, model.UserState{
 			FirstName: userIfo.FirstName,
 			LastName:  userIfo.LastName,
-		})
+		}, model.UserFields{model.UserFieldFirstName, model.UserFieldLastName, model.UserFieldNickname}, 0)
+		require.NoError(t, err)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success, email changed", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		meQuery := mock.ExpectExec(`
+				UPDATE users SET email = $1, version = version + 1, email_verified_at = CASE WHEN email = $2 THEN email_verified_at END WHERE deleted_at IS NULL AND id = $3
+			`).
+			WithArgs(
+				"alice@bob.com",
+				"alice@bob.com",
+				userID,
+			)
+
+		meQuery.WillReturnResult(sqlmock.NewResult(0, 1))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		err = repo.UpdateUser(context.Background(), userID, model.UserState{Email: "alice@bob.com"}, model.UserFields{model.UserFieldEmail}, 0)
+		require.NoError(t, err)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success, password changed", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		meQuery := mock.ExpectExec(`
+				UPDATE users SET password_hash = $1, version = version + 1 WHERE deleted_at IS NULL AND id = $2
+			`).
+			WithArgs("hash", userID)
+
+		meQuery.WillReturnResult(sqlmock.NewResult(0, 1))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		err = repo.UpdateUser(context.Background(), userID, model.UserState{PasswordHash: "hash"}, model.UserFields{model.UserFieldPassword}, 0)
 		require.NoError(t, err)
 
 		require.NoError(t, mock.ExpectationsWereMet())
@@ -209,7 +273,7 @@ func TestUser_UpdateUser(t *testing.T) {
 		defer db.Close() //nolint:errcheck
 
 		meQuery := mock.ExpectExec(`
-				UPDATE users SET first_name = $1, last_name = $2, nickname = $3, country = $4 WHERE id = $5
+				UPDATE users SET first_name = $1, last_name = $2, nickname = $3, country = $4, version = version + 1 WHERE deleted_at IS NULL AND id = $5
 			`).
 			WithArgs(
 				userIfo.FirstName,
@@ -225,7 +289,7 @@ func TestUser_UpdateUser(t *testing.T) {
 
 		repo := storage.NewUser(st)
 
-		err = repo.UpdateUser(context.Background(), userID, userIfo)
+		err = repo.UpdateUser(context.Background(), userID, userIfo, fields, 0)
 		require.Error(t, err)
 		require.ErrorIs(t, err, database.ErrNotFound)
 	})
@@ -238,7 +302,7 @@ func TestUser_UpdateUser(t *testing.T) {
 		defer db.Close() //nolint:errcheck
 
 		meQuery := mock.ExpectExec(`
-				UPDATE users SET first_name = $1, last_name = $2, nickname = $3, country = $4 WHERE id = $5
+				UPDATE users SET first_name = $1, last_name = $2, nickname = $3, country = $4, version = version + 1 WHERE deleted_at IS NULL AND id = $5
 			`).
 			WithArgs(
 				userIfo.FirstName,
@@ -254,9 +318,40 @@ func TestUser_UpdateUser(t *testing.T) {
 
 		repo := storage.NewUser(st)
 
-		err = repo.UpdateUser(context.Background(), userID, userIfo)
+		err = repo.UpdateUser(context.Background(), userID, userIfo, fields, 0)
 		require.Error(t, err)
 	})
+
+	t.Run("version mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		meQuery := mock.ExpectExec(`
+				UPDATE users SET first_name = $1, last_name = $2, nickname = $3, country = $4, version = version + 1 WHERE deleted_at IS NULL AND id = $5 AND version = $6
+			`).
+			WithArgs(
+				userIfo.FirstName,
+				userIfo.LastName,
+				userIfo.Nickname,
+				userIfo.Country,
+				userID,
+				int64(3),
+			)
+
+		meQuery.WillReturnResult(sqlmock.NewResult(0, 0))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		err = repo.UpdateUser(context.Background(), userID, userIfo, fields, 3)
+		require.ErrorIs(t, err, usecase.ErrVersionMismatch)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 
 func TestUser_DeleteUser(t *testing.T) {
@@ -272,7 +367,7 @@ func TestUser_DeleteUser(t *testing.T) {
 		defer db.Close() //nolint:errcheck
 
 		meQuery := mock.ExpectExec(`
-				DELETE FROM users WHERE id = $1
+				UPDATE users SET deleted_at = NOW(), version = version + 1 WHERE deleted_at IS NULL AND id = $1
 			`).
 			WithArgs(userID)
 
@@ -282,7 +377,7 @@ func TestUser_DeleteUser(t *testing.T) {
 
 		repo := storage.NewUser(st)
 
-		err = repo.DeleteUser(context.Background(), userID)
+		err = repo.DeleteUser(context.Background(), userID, 0)
 		require.NoError(t, err)
 
 		require.NoError(t, mock.ExpectationsWereMet())
@@ -296,7 +391,7 @@ func TestUser_DeleteUser(t *testing.T) {
 		defer db.Close() //nolint:errcheck
 
 		meQuery := mock.ExpectExec(`
-				DELETE FROM users WHERE id = $1
+				UPDATE users SET deleted_at = NOW(), version = version + 1 WHERE deleted_at IS NULL AND id = $1
 			`).
 			WithArgs(userID)
 
@@ -306,7 +401,7 @@ func TestUser_DeleteUser(t *testing.T) {
 
 		repo := storage.NewUser(st)
 
-		err = repo.DeleteUser(context.Background(), userID)
+		err = repo.DeleteUser(context.Background(), userID, 0)
 		require.Error(t, err)
 		require.ErrorIs(t, err, database.ErrNotFound)
 	})
@@ -319,7 +414,7 @@ func TestUser_DeleteUser(t *testing.T) {
 		defer db.Close() //nolint:errcheck
 
 		meQuery := mock.ExpectExec(`
-				DELETE FROM users WHERE id = $1
+				UPDATE users SET deleted_at = NOW(), version = version + 1 WHERE deleted_at IS NULL AND id = $1
 			`).
 			WithArgs(userID)
 
@@ -329,8 +424,106 @@ func TestUser_DeleteUser(t *testing.T) {
 
 		repo := storage.NewUser(st)
 
-		err = repo.DeleteUser(context.Background(), userID)
+		err = repo.DeleteUser(context.Background(), userID, 0)
+		require.Error(t, err)
+	})
+
+	t.Run("version mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		meQuery := mock.ExpectExec(`
+				UPDATE users SET deleted_at = NOW(), version = version + 1 WHERE deleted_at IS NULL AND id = $1 AND version = $2
+			`).
+			WithArgs(userID, int64(3))
+
+		meQuery.WillReturnResult(sqlmock.NewResult(0, 0))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		err = repo.DeleteUser(context.Background(), userID, 3)
+		require.ErrorIs(t, err, usecase.ErrVersionMismatch)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestUser_FindByID(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+	query := `
+		SELECT id, email_verified_at, deleted_at, erased_at, version, created_at, updated_at, password_hash, email, first_name, last_name, nickname, country, status, status_reason, status_changed_by, status_changed_at, status_expires_at FROM users WHERE deleted_at IS NULL AND id = $1
+	`
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(query).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "country"}).AddRow(userID, "Alice", "UK"))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		u, err := repo.FindByID(context.Background(), userID)
+		require.NoError(t, err)
+
+		require.Equal(t, &model.User{ID: userID, UserState: model.UserState{FirstName: "Alice", Country: "UK"}}, u)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(query).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		u, err := repo.FindByID(context.Background(), userID)
 		require.Error(t, err)
+		require.ErrorIs(t, err, database.ErrNotFound)
+		require.Nil(t, u)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(query).
+			WithArgs(userID).
+			WillReturnError(&pgconn.PgError{Code: pgerrcode.InternalError})
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		u, err := repo.FindByID(context.Background(), userID)
+		require.Error(t, err)
+		require.Nil(t, u)
 	})
 }
 
@@ -345,7 +538,7 @@ func TestUser_ListByCountry(t *testing.T) {
 		defer db.Close() //nolint:errcheck
 
 		meQuery := mock.ExpectQuery(`
-				SELECT id, created_at, updated_at, password_hash, email, first_name, last_name, nickname, country FROM users WHERE country = $1 LIMIT 100 OFFSET 0
+				SELECT id, email_verified_at, deleted_at, erased_at, version, created_at, updated_at, password_hash, email, first_name, last_name, nickname, country, status, status_reason, status_changed_by, status_changed_at, status_expires_at FROM users WHERE country = $1 AND deleted_at IS NULL ORDER BY created_at ASC, id ASC LIMIT 101
 			`).
 			WithArgs("UK")
 
@@ -359,14 +552,65 @@ func TestUser_ListByCountry(t *testing.T) {
 
 		repo := storage.NewUser(st)
 
-		users, err := repo.ListByCountry(context.Background(), "UK", 100, 0)
+		page, err := repo.ListByCountry(context.Background(), "UK", 100, nil)
 		require.NoError(t, err)
 
-		require.Len(t, users, 2)
+		require.Len(t, page.Users, 2)
+		require.Nil(t, page.Next)
 
 		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("next page", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		createdAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+		lastID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+		meQuery := mock.ExpectQuery(`
+				SELECT id, email_verified_at, deleted_at, erased_at, version, created_at, updated_at, password_hash, email, first_name, last_name, nickname, country, status, status_reason, status_changed_by, status_changed_at, status_expires_at FROM users WHERE country = $1 AND deleted_at IS NULL AND ((created_at > $2) OR (created_at = $3 AND id > $4)) ORDER BY created_at ASC, id ASC LIMIT 3
+			`).
+			WithArgs("UK", createdAt, createdAt, lastID.String())
+
+		rows := sqlmock.NewRows([]string{"id", "created_at"}).
+			AddRow(uuid.MustParse("29d7fe1d-6d03-4c52-9880-d39788f9c227"), createdAt).
+			AddRow(uuid.MustParse("f1ec4c49-2166-45d2-988f-cb632bd380f9"), createdAt.Add(time.Second)).
+			AddRow(uuid.New(), createdAt.Add(2*time.Second))
+
+		meQuery.WillReturnRows(rows)
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		page, err := repo.ListByCountry(context.Background(), "UK", 2, model.Cursor{"2024-01-01T10:00:00Z", lastID.String()})
+		require.NoError(t, err)
+
+		require.Len(t, page.Users, 2)
+		require.Equal(t, model.Cursor{"2024-01-01T10:00:01Z", "f1ec4c49-2166-45d2-988f-cb632bd380f9"}, page.Next)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		t.Parallel()
+
+		db, _, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		_, err = repo.ListByCountry(context.Background(), "UK", 2, model.Cursor{"2024-01-01T10:00:00Z"})
+		require.ErrorIs(t, err, usecase.ErrInvalidPageToken)
+	})
+
 	t.Run("error", func(t *testing.T) {
 		t.Parallel()
 
@@ -375,7 +619,7 @@ func TestUser_ListByCountry(t *testing.T) {
 		defer db.Close() //nolint:errcheck
 
 		meQuery := mock.ExpectQuery(`
-				SELECT id, created_at, updated_at, password_hash, email, first_name, last_name, nickname, country FROM users WHERE country = $1 LIMIT 100 OFFSET 0
+				SELECT id, email_verified_at, deleted_at, erased_at, version, created_at, updated_at, password_hash, email, first_name, last_name, nickname, country, status, status_reason, status_changed_by, status_changed_at, status_expires_at FROM users WHERE country = $1 AND deleted_at IS NULL ORDER BY created_at ASC, id ASC LIMIT 101
 			`).
 			WithArgs("UK")
 
@@ -385,8 +629,448 @@ func TestUser_ListByCountry(t *testing.T) {
 
 		repo := storage.NewUser(st)
 
-		users, err := repo.ListByCountry(context.Background(), "UK", 100, 0)
+		page, err := repo.ListByCountry(context.Background(), "UK", 100, nil)
 		require.Error(t, err)
-		require.Nil(t, users)
+		require.Nil(t, page.Users)
+	})
+}
+
+func TestUser_List(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		meQuery := mock.ExpectQuery(`
+				SELECT id, email_verified_at, deleted_at, erased_at, version, created_at, updated_at, password_hash, email, first_name, last_name, nickname, country, status, status_reason, status_changed_by, status_changed_at, status_expires_at FROM users WHERE deleted_at IS NULL AND (country = $1 AND nickname LIKE $2) ORDER BY created_at DESC, id ASC LIMIT 101
+			`).
+			WithArgs("UK", "pro%")
+
+		rows := sqlmock.NewRows([]string{"id"}).
+			AddRow(uuid.New()).
+			AddRow(uuid.New())
+
+		meQuery.WillReturnRows(rows)
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		page, err := repo.List(context.Background(), `country = "UK" AND nickname:"pro*"`, "created_at desc", 100, nil)
+		require.NoError(t, err)
+
+		require.Len(t, page.Users, 2)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no filter", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(`
+				SELECT id, email_verified_at, deleted_at, erased_at, version, created_at, updated_at, password_hash, email, first_name, last_name, nickname, country, status, status_reason, status_changed_by, status_changed_at, status_expires_at FROM users WHERE deleted_at IS NULL ORDER BY id DESC LIMIT 11
+			`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		page, err := repo.List(context.Background(), "", "id desc", 10, nil)
+		require.NoError(t, err)
+
+		require.Empty(t, page.Users)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("update time", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(`
+				SELECT id, email_verified_at, deleted_at, erased_at, version, created_at, updated_at, password_hash, email, first_name, last_name, nickname, country, status, status_reason, status_changed_by, status_changed_at, status_expires_at FROM users WHERE deleted_at IS NULL AND updated_at >= $1 ORDER BY updated_at ASC, id ASC LIMIT 11
+			`).
+			WithArgs(time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		page, err := repo.List(context.Background(), `update_time >= "2024-12-01T00:00:00Z"`, "update_time", 10, nil)
+		require.NoError(t, err)
+
+		require.Empty(t, page.Users)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		t.Parallel()
+
+		db, _, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		_, err = repo.List(context.Background(), `password_hash = "x"`, "", 100, nil)
+		require.ErrorIs(t, err, usecase.ErrInvalidFilter)
+
+		_, err = repo.List(context.Background(), `country = `, "", 100, nil)
+		require.ErrorIs(t, err, usecase.ErrInvalidFilter)
+	})
+
+	t.Run("invalid order by", func(t *testing.T) {
+		t.Parallel()
+
+		db, _, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		_, err = repo.List(context.Background(), "", "password_hash", 100, nil)
+		require.ErrorIs(t, err, usecase.ErrInvalidOrderBy)
+	})
+}
+
+func TestUser_FindByEmail(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+	email := "alice@bob.com"
+
+	query := `
+		SELECT id, email_verified_at, deleted_at, erased_at, version, created_at, updated_at, password_hash, email, first_name, last_name, nickname, country, status, status_reason, status_changed_by, status_changed_at, status_expires_at FROM users WHERE deleted_at IS NULL AND email = $1
+	`
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(query).
+			WithArgs(email).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "email", "password_hash"}).AddRow(userID, email, "$argon2id$hash"))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		u, err := repo.FindByEmail(context.Background(), email)
+		require.NoError(t, err)
+
+		require.Equal(t, &model.User{ID: userID, UserState: model.UserState{Email: email, PasswordHash: "$argon2id$hash"}}, u)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(query).
+			WithArgs(email).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		u, err := repo.FindByEmail(context.Background(), email)
+		require.Error(t, err)
+		require.ErrorIs(t, err, database.ErrNotFound)
+		require.Nil(t, u)
+	})
+}
+
+func TestUser_UpdatePasswordHash(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	query := `
+		UPDATE users SET password_hash = $1, version = version + 1 WHERE deleted_at IS NULL AND id = $2
+	`
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(query).
+			WithArgs("$argon2id$hash", userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		err = repo.UpdatePasswordHash(context.Background(), userID, "$argon2id$hash")
+		require.NoError(t, err)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(query).
+			WithArgs("$argon2id$hash", userID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewUser(st)
+
+		err = repo.UpdatePasswordHash(context.Background(), userID, "$argon2id$hash")
+		require.Error(t, err)
+		require.ErrorIs(t, err, database.ErrNotFound)
+	})
+}
+
+func TestUser_ChangeStatus(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	changedAt := time.Date(2024, 12, 22, 9, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 12, 30, 9, 0, 0, 0, time.UTC)
+
+	to := model.UserStatusChange{
+		Status:          model.StatusSuspended,
+		StatusReason:    "cheating",
+		StatusChangedBy: "admin",
+		StatusExpiresAt: &until,
+	}
+
+	t.Run("never changed", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(`UPDATE users SET status = $1, status_reason = $2, status_changed_by = $3, status_expires_at = $4, status_changed_at = NOW(), version = version + 1 WHERE deleted_at IS NULL AND id = $5 AND status = $6 AND status_changed_at IS NULL`).
+			WithArgs(model.StatusSuspended, "cheating", "admin", &until, userID, model.StatusActive).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		repo := storage.NewUser(sqluct.NewStorage(sqlx.NewDb(db, "sqlmock")))
+
+		err = repo.ChangeStatus(context.Background(), userID, model.UserStatusChange{Status: model.StatusActive}, to)
+		require.NoError(t, err)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("changed in between", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(`UPDATE users SET status = $1, status_reason = $2, status_changed_by = $3, status_expires_at = $4, status_changed_at = NOW(), version = version + 1 WHERE deleted_at IS NULL AND id = $5 AND status = $6 AND status_changed_at = $7`).
+			WithArgs(model.StatusSuspended, "cheating", "admin", &until, userID, model.StatusSuspended, changedAt).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		repo := storage.NewUser(sqluct.NewStorage(sqlx.NewDb(db, "sqlmock")))
+
+		from := model.UserStatusChange{Status: model.StatusSuspended, StatusChangedAt: &changedAt}
+
+		err = repo.ChangeStatus(context.Background(), userID, from, to)
+		require.ErrorIs(t, err, usecase.ErrInvalidStatusTransition)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestUser_ListExpiredStatuses(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	now := time.Date(2024, 12, 23, 9, 0, 0, 0, time.UTC)
+	expired := now.Add(-time.Minute)
+	userID := uuid.New()
+
+	mock.ExpectQuery(`SELECT id, email_verified_at, deleted_at, erased_at, version, created_at, updated_at, password_hash, email, first_name, last_name, nickname, country, status, status_reason, status_changed_by, status_changed_at, status_expires_at FROM users WHERE deleted_at IS NULL AND status IN ($1,$2) AND status_expires_at <= $3 ORDER BY status_expires_at, id LIMIT 100`).
+		WithArgs("suspended", "banned", now).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "status_expires_at"}).
+			AddRow(userID, "suspended", expired))
+
+	repo := storage.NewUser(sqluct.NewStorage(sqlx.NewDb(db, "sqlmock")))
+
+	users, err := repo.ListExpiredStatuses(context.Background(), now, 100)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, userID, users[0].ID)
+	require.Equal(t, model.StatusSuspended, users[0].Status)
+	require.Equal(t, &expired, users[0].StatusExpiresAt)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUser_RestoreUser(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(`UPDATE users SET deleted_at = $1, version = version + 1 WHERE erased_at IS NULL AND id = $2 AND deleted_at > NOW() - make_interval(secs => $3)`).
+			WithArgs(nil, userID, float64(3600)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		repo := storage.NewUser(sqluct.NewStorage(sqlx.NewDb(db, "sqlmock")))
+
+		err = repo.RestoreUser(context.Background(), userID, time.Hour)
+		require.NoError(t, err)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(`UPDATE users SET deleted_at = $1, version = version + 1 WHERE erased_at IS NULL AND id = $2 AND deleted_at > NOW() - make_interval(secs => $3)`).
+			WithArgs(nil, userID, float64(3600)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		repo := storage.NewUser(sqluct.NewStorage(sqlx.NewDb(db, "sqlmock")))
+
+		err = repo.RestoreUser(context.Background(), userID, time.Hour)
+		require.ErrorIs(t, err, database.ErrNotFound)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("email registered again", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(`UPDATE users SET deleted_at = $1, version = version + 1 WHERE erased_at IS NULL AND id = $2 AND deleted_at > NOW() - make_interval(secs => $3)`).
+			WithArgs(nil, userID, float64(3600)).
+			WillReturnError(&pgconn.PgError{Code: pgerrcode.UniqueViolation})
+
+		repo := storage.NewUser(sqluct.NewStorage(sqlx.NewDb(db, "sqlmock")))
+
+		err = repo.RestoreUser(context.Background(), userID, time.Hour)
+		require.ErrorIs(t, err, database.ErrAlreadyExists)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestUser_ListDeletedUsers(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	before := time.Date(2024, 12, 24, 9, 0, 0, 0, time.UTC)
+	deletedAt := before.Add(-time.Minute)
+	userID := uuid.New()
+
+	mock.ExpectQuery(`SELECT id, email_verified_at, deleted_at, erased_at, version, created_at, updated_at, password_hash, email, first_name, last_name, nickname, country, status, status_reason, status_changed_by, status_changed_at, status_expires_at FROM users WHERE deleted_at <= $1 AND erased_at IS NULL ORDER BY deleted_at, id LIMIT 100`).
+		WithArgs(before).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_at"}).
+			AddRow(userID, deletedAt))
+
+	repo := storage.NewUser(sqluct.NewStorage(sqlx.NewDb(db, "sqlmock")))
+
+	users, err := repo.ListDeletedUsers(context.Background(), before, 100)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, userID, users[0].ID)
+	require.Equal(t, &deletedAt, users[0].DeletedAt)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUser_PurgeUser(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	before := time.Date(2024, 12, 24, 9, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(`DELETE FROM users WHERE erased_at IS NULL AND id = $1 AND deleted_at <= $2`).
+			WithArgs(userID, before).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		repo := storage.NewUser(sqluct.NewStorage(sqlx.NewDb(db, "sqlmock")))
+
+		err = repo.PurgeUser(context.Background(), userID, before)
+		require.NoError(t, err)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("restored in between", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(`DELETE FROM users WHERE erased_at IS NULL AND id = $1 AND deleted_at <= $2`).
+			WithArgs(userID, before).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		repo := storage.NewUser(sqluct.NewStorage(sqlx.NewDb(db, "sqlmock")))
+
+		err = repo.PurgeUser(context.Background(), userID, before)
+		require.ErrorIs(t, err, database.ErrNotFound)
+
+		require.NoError(t, mock.ExpectationsWereMet())
 	})
 }
@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/domain/model"
+	"github.com/dohernandez/go-grpc-service/database"
+)
+
+// VerificationTokenTable is the table name for email verification tokens.
+const VerificationTokenTable = "email_verification_tokens"
+
+// VerificationToken represents a token issued to verify the email of a user.
+//
+// Only the hash of the token is stored. The token verifies the email it was issued for, once the user changes its
+// email the token is not valid anymore.
+type VerificationToken struct {
+	TokenHash string       `db:"token_hash"`
+	UserID    model.UserID `db:"user_id"`
+	Email     string       `db:"email"`
+
+	CreatedAt time.Time    `db:"created_at"`
+	ExpiresAt time.Time    `db:"expires_at"`
+	UsedAt    sql.NullTime `db:"used_at"`
+}
+
+// Verification represents a Verification repository, storing the email verification tokens.
+type Verification struct {
+	storage *sqluct.Storage
+
+	// col names for email verification tokens table search
+	colTokenHash string
+	colUserID    string
+	colUsedAt    string
+
+	// col names for users table search
+	colUsersID              string
+	colUsersEmail           string
+	colUsersEmailVerifiedAt string
+	colUsersDeletedAt       string
+	colUsersVersion         string
+}
+
+// NewVerification returns instance of Verification repository.
+func NewVerification(storage *sqluct.Storage) *Verification {
+	var (
+		t VerificationToken
+		u model.User
+	)
+
+	return &Verification{
+		storage:      storage,
+		colTokenHash: storage.Mapper.Col(&t, &t.TokenHash),
+		colUserID:    storage.Mapper.Col(&t, &t.UserID),
+		colUsedAt:    storage.Mapper.Col(&t, &t.UsedAt),
+
+		colUsersID:              storage.Mapper.Col(&u, &u.ID),
+		colUsersEmail:           storage.Mapper.Col(&u, &u.Email),
+		colUsersEmailVerifiedAt: storage.Mapper.Col(&u, &u.EmailVerifiedAt),
+		colUsersDeletedAt:       storage.Mapper.Col(&u, &u.DeletedAt),
+		colUsersVersion:         storage.Mapper.Col(&u, &u.Version),
+	}
+}
+
+// InTx runs the function within a transaction.
+func (s *Verification) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return s.storage.InTx(ctx, fn)
+}
+
+// AddVerificationToken stores the verification token.
+func (s *Verification) AddVerificationToken(ctx context.Context, t *VerificationToken) error {
+	q := s.storage.InsertStmt(VerificationTokenTable, t)
+
+	res, err := s.storage.Exec(ctx, q)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.New("no rows affected")
+	}
+
+	return nil
+}
+
+// DeletePendingVerificationTokens deletes the verification tokens of the user not used yet.
+func (s *Verification) DeletePendingVerificationTokens(ctx context.Context, id model.UserID) error {
+	q := s.storage.DeleteStmt(VerificationTokenTable).
+		Where(squirrel.Eq{s.colUserID: id, s.colUsedAt: nil})
+
+	_, err := s.storage.Exec(ctx, q)
+
+	return err
+}
+
+// FindVerificationToken finds the verification token by hash.
+func (s *Verification) FindVerificationToken(ctx context.Context, hash string) (*VerificationToken, error) {
+	q := s.storage.SelectStmt(VerificationTokenTable, VerificationToken{}).
+		Where(squirrel.Eq{s.colTokenHash: hash})
+
+	var t VerificationToken
+
+	err := s.storage.Select(ctx, q, &t)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, database.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// UseVerificationToken marks the verification token as used.
+//
+// Returns false when the token was already used, so two concurrent verifications with a token can not both succeed.
+func (s *Verification) UseVerificationToken(ctx context.Context, hash string, at time.Time) (bool, error) {
+	q := s.storage.QueryBuilder().Update(VerificationTokenTable).
+		Set(s.colUsedAt, at).
+		Where(squirrel.Eq{s.colTokenHash: hash, s.colUsedAt: nil})
+
+	res, err := s.storage.Exec(ctx, q)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// MarkEmailVerified marks the email of the user as verified, as long as it is still the email of the user.
+//
+// Returns database.ErrNotFound when the user is not found or changed its email.
+func (s *Verification) MarkEmailVerified(ctx context.Context, id model.UserID, email string, at time.Time) error {
+	q := s.storage.QueryBuilder().Update(UserTable).
+		Set(s.colUsersEmailVerifiedAt, at).
+		Set(s.colUsersVersion, squirrel.Expr(s.colUsersVersion+" + 1")).
+		Where(squirrel.Eq{s.colUsersID: id, s.colUsersEmail: email, s.colUsersDeletedAt: nil})
+
+	res, err := s.storage.Exec(ctx, q)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return database.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/faceit/internal/platform/storage"
+	"github.com/dohernandez/go-grpc-service/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerification_AddVerificationToken(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	tok := &storage.VerificationToken{
+		TokenHash: "3f0a",
+		UserID:    uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2"),
+		Email:     "alice@bob.com",
+		CreatedAt: time.Date(2024, 12, 21, 9, 0, 0, 0, time.UTC),
+		ExpiresAt: time.Date(2024, 12, 22, 9, 0, 0, 0, time.UTC),
+	}
+
+	mock.ExpectExec(`
+			INSERT INTO email_verification_tokens (token_hash,user_id,email,created_at,expires_at,used_at) VALUES ($1,$2,$3,$4,$5,$6)
+		`).
+		WithArgs(tok.TokenHash, tok.UserID, tok.Email, tok.CreatedAt, tok.ExpiresAt, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewVerification(st)
+
+	err = repo.AddVerificationToken(context.Background(), tok)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestVerification_DeletePendingVerificationTokens(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+	mock.ExpectExec(`
+			DELETE FROM email_verification_tokens WHERE used_at IS NULL AND user_id = $1
+		`).
+		WithArgs(userID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	repo := storage.NewVerification(st)
+
+	err = repo.DeletePendingVerificationTokens(context.Background(), userID)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestVerification_FindVerificationToken(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		SELECT token_hash, user_id, email, created_at, expires_at, used_at FROM email_verification_tokens WHERE token_hash = $1
+	`
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+		mock.ExpectQuery(query).
+			WithArgs("3f0a").
+			WillReturnRows(sqlmock.NewRows([]string{"token_hash", "user_id", "email"}).AddRow("3f0a", userID, "alice@bob.com"))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewVerification(st)
+
+		tok, err := repo.FindVerificationToken(context.Background(), "3f0a")
+		require.NoError(t, err)
+		require.Equal(t, &storage.VerificationToken{TokenHash: "3f0a", UserID: userID, Email: "alice@bob.com"}, tok)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(query).
+			WithArgs("3f0a").
+			WillReturnRows(sqlmock.NewRows([]string{"token_hash"}))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewVerification(st)
+
+		tok, err := repo.FindVerificationToken(context.Background(), "3f0a")
+		require.ErrorIs(t, err, database.ErrNotFound)
+		require.Nil(t, tok)
+	})
+}
+
+func TestVerification_UseVerificationToken(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, 12, 21, 9, 0, 0, 0, time.UTC)
+
+	query := `
+		UPDATE email_verification_tokens SET used_at = $1 WHERE token_hash = $2 AND used_at IS NULL
+	`
+
+	t.Run("used", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(query).
+			WithArgs(at, "3f0a").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewVerification(st)
+
+		used, err := repo.UseVerificationToken(context.Background(), "3f0a", at)
+		require.NoError(t, err)
+		require.True(t, used)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already used", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(query).
+			WithArgs(at, "3f0a").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewVerification(st)
+
+		used, err := repo.UseVerificationToken(context.Background(), "3f0a", at)
+		require.NoError(t, err)
+		require.False(t, used)
+	})
+}
+
+func TestVerification_MarkEmailVerified(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, 12, 21, 9, 0, 0, 0, time.UTC)
+	userID := uuid.MustParse("26ef0140-c436-4838-a271-32652c72f6f2")
+
+	query := `
+		UPDATE users SET email_verified_at = $1, version = version + 1 WHERE deleted_at IS NULL AND email = $2 AND id = $3
+	`
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(query).
+			WithArgs(at, "alice@bob.com", userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewVerification(st)
+
+		err = repo.MarkEmailVerified(context.Background(), userID, "alice@bob.com", at)
+		require.NoError(t, err)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("email changed", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(query).
+			WithArgs(at, "alice@bob.com", userID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+		repo := storage.NewVerification(st)
+
+		err = repo.MarkEmailVerified(context.Background(), userID, "alice@bob.com", at)
+		require.ErrorIs(t, err, database.ErrNotFound)
+	})
+}
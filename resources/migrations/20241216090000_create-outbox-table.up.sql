@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS outbox
+(
+    id              BIGSERIAL PRIMARY KEY,
+    event_id        UUID        NOT NULL UNIQUE DEFAULT uuid_generate_v4(),
+    user_id         UUID        NOT NULL,
+    event_type      VARCHAR(64) NOT NULL,
+    payload         JSONB       NOT NULL,
+    attempts        INT         NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    created_at      TIMESTAMP   NOT NULL DEFAULT NOW(),
+    next_attempt_at TIMESTAMP   NOT NULL DEFAULT NOW(),
+    claimed_until   TIMESTAMP,
+    published_at    TIMESTAMP,
+    failed_at       TIMESTAMP
+);
+
+-- idx_outbox_pending is an index use to get the events pending to be relayed, in insertion order.
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox (id) WHERE published_at IS NULL AND failed_at IS NULL;
\ No newline at end of file
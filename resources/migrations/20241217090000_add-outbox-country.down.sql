@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_outbox_published;
+
+ALTER TABLE outbox DROP COLUMN IF EXISTS country;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS country CHAR(2);
+
+-- idx_outbox_published is an index use to get the events relayed, in publication order.
+CREATE INDEX IF NOT EXISTS idx_outbox_published ON outbox (published_at, id) WHERE published_at IS NOT NULL;
\ No newline at end of file
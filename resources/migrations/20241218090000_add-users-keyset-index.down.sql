@@ -0,0 +1,4 @@
+-- idx_users_country is an index use to get users by country.
+CREATE INDEX IF NOT EXISTS idx_users_country ON users (country);
+
+DROP INDEX IF EXISTS idx_users_country_created_at;
\ No newline at end of file
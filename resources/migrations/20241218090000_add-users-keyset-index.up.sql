@@ -0,0 +1,4 @@
+-- idx_users_country_created_at is an index use to get the pages of users by country, in creation order.
+CREATE INDEX IF NOT EXISTS idx_users_country_created_at ON users (country, created_at, id);
+
+DROP INDEX IF EXISTS idx_users_country;
\ No newline at end of file
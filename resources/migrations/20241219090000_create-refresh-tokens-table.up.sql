@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS refresh_tokens
+(
+    token_hash CHAR(64) PRIMARY KEY, -- hex encoded SHA-256 of the token, the token itself is never stored.
+    family_id  UUID      NOT NULL,
+    user_id    UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP
+);
+
+-- idx_refresh_tokens_family_id is an index use to revoke all the refresh tokens of a session.
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+
+-- idx_refresh_tokens_user_id is an index use to delete the refresh tokens of a user.
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens (user_id);
\ No newline at end of file
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS login_attempts
+(
+    email        VARCHAR(255) PRIMARY KEY, -- lower-cased, emails are not required to be registered.
+    failures     INT          NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP,
+    updated_at   TIMESTAMP    NOT NULL DEFAULT NOW()
+);
\ No newline at end of file
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS user_roles
+(
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role       VARCHAR(32) NOT NULL CHECK (role IN ('admin', 'support', 'user')),
+    created_at TIMESTAMP   NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, role)
+);
\ No newline at end of file
@@ -0,0 +1,14 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS email_verification_tokens
+(
+    token_hash CHAR(64) PRIMARY KEY, -- hex encoded SHA-256 of the token, the token itself is never stored.
+    user_id    UUID         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    email      VARCHAR(255) NOT NULL, -- email the token verifies, a token of a previous email is not valid.
+    created_at TIMESTAMP    NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP    NOT NULL,
+    used_at    TIMESTAMP
+);
+
+-- idx_email_verification_tokens_user_id is an index use to discard the pending tokens of a user.
+CREATE INDEX IF NOT EXISTS idx_email_verification_tokens_user_id ON email_verification_tokens (user_id);
\ No newline at end of file
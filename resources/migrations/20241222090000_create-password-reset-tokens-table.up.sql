@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS password_reset_tokens
+(
+    token_hash CHAR(64) PRIMARY KEY, -- hex encoded SHA-256 of the token, the token itself is never stored.
+    user_id    UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP NOT NULL,
+    used_at    TIMESTAMP
+);
+
+-- idx_password_reset_tokens_user_id is an index use to discard the pending tokens of a user.
+CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_user_id ON password_reset_tokens (user_id);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS rate_limits
+(
+    key          VARCHAR(320) PRIMARY KEY, -- limited subject prefixed with its kind, e.g. password_reset:email:alice@bob.com.
+    hits         INT          NOT NULL DEFAULT 0,
+    window_start TIMESTAMP    NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,10 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS status            VARCHAR(20)  NOT NULL DEFAULT 'active'
+        CHECK (status IN ('active', 'suspended', 'banned')),
+    ADD COLUMN IF NOT EXISTS status_reason     VARCHAR(500) NOT NULL DEFAULT '', -- reason of the last status change.
+    ADD COLUMN IF NOT EXISTS status_changed_by VARCHAR(255) NOT NULL DEFAULT '', -- subject of the actor of the last status change.
+    ADD COLUMN IF NOT EXISTS status_changed_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS status_expires_at TIMESTAMP; -- time a temporary suspension or ban is lifted.
+
+-- idx_users_status_expires_at is an index use to find the suspensions and bans to lift.
+CREATE INDEX IF NOT EXISTS idx_users_status_expires_at ON users (status_expires_at) WHERE status_expires_at IS NOT NULL;
\ No newline at end of file
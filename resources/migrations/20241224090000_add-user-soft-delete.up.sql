@@ -0,0 +1,9 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP; -- tombstone of the deleted users, purged after the retention period.
+
+-- The emails of the deleted users can be registered again, while they are not purged.
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_email_key;
+-- idx_users_email is an index use to keep the emails of the users unique and find the users by email.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email) WHERE deleted_at IS NULL;
+
+-- idx_users_deleted_at is an index use to find the deleted users to purge.
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at) WHERE deleted_at IS NOT NULL;
\ No newline at end of file
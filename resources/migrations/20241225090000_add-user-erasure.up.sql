@@ -0,0 +1,13 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS erased_at TIMESTAMP; -- personal data erased, the user is kept under its id and never purged.
+
+CREATE TABLE IF NOT EXISTS erasure_certificates
+(
+    id        UUID PRIMARY KEY   DEFAULT uuid_generate_v4(),
+    user_id   UUID      NOT NULL REFERENCES users (id),
+    erased_by TEXT      NOT NULL,              -- subject of the actor who requested the erasure.
+    erased_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    records   JSONB     NOT NULL               -- number of records erased or anonymized, by table.
+);
+
+-- idx_erasure_certificates_user_id is an index use to find the certificates of a user.
+CREATE INDEX IF NOT EXISTS idx_erasure_certificates_user_id ON erasure_certificates (user_id);
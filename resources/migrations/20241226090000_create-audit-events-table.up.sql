@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS audit_events
+(
+    id         BIGSERIAL PRIMARY KEY,
+    user_id    UUID        NOT NULL,               -- not referencing users, the entries outlive the purge of the user.
+    action     VARCHAR(32) NOT NULL,
+    actor      TEXT        NOT NULL,               -- subject of the actor of the mutation.
+    request_id TEXT        NOT NULL,
+    source_ip  TEXT        NOT NULL,
+    changes    JSONB       NOT NULL DEFAULT '{}',  -- before and after values of the changed attributes, by attribute.
+    created_at TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+-- idx_audit_events_user_id is an index use to list the audit entries of a user, latest first.
+CREATE INDEX IF NOT EXISTS idx_audit_events_user_id ON audit_events (user_id, id);
+
+-- idx_audit_events_actor is an index use to list the audit entries of an actor, latest first.
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor ON audit_events (actor, id);
@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1; -- incremented on every update, the etag of the user.
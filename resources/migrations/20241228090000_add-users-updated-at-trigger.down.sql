@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_users_updated_at;
+
+DROP TRIGGER IF EXISTS trg_users_updated_at ON users;
+DROP FUNCTION IF EXISTS set_updated_at();
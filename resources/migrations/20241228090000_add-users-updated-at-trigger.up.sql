@@ -0,0 +1,13 @@
+-- set_updated_at sets the time of the last update of the rows, whatever the statement updating them.
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_users_updated_at ON users;
+CREATE TRIGGER trg_users_updated_at BEFORE UPDATE ON users FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- idx_users_updated_at is an index use to get the users updated since a time, in update order.
+CREATE INDEX IF NOT EXISTS idx_users_updated_at ON users (updated_at, id);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys
+(
+    subject      TEXT      NOT NULL, -- subject of the actor, the keys are scoped by actor.
+    key          TEXT      NOT NULL,
+    method       TEXT      NOT NULL, -- full gRPC method of the mutation.
+    fingerprint  CHAR(64)  NOT NULL, -- hex encoded SHA-256 of the request.
+    response     BYTEA,              -- response of the mutation, NULL while in progress.
+    header       JSONB,              -- header metadata of the response, by key.
+    user_id      UUID,               -- user the mutation acted on, set once completed.
+    created_at   TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP NOT NULL, -- end of the lock of the mutation in progress, taken over once lapsed.
+    PRIMARY KEY (subject, key)
+);
+
+-- idx_idempotency_keys_expires_at is an index use to purge the expired keys.
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
+
+-- idx_idempotency_keys_user_id is an index use to export and erase the responses holding the personal data of a user.
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_user_id ON idempotency_keys (user_id) WHERE user_id IS NOT NULL;